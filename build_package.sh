@@ -24,12 +24,18 @@ echo "Using GTK version ${GTK_VERSION}"
 echo "Version is ${BUILD_TAG}"
 
 go build -tags gtk_${GTK_VERSION} -ldflags "-X main.Version=${BUILD_TAG}"
+# The daemon is built without the GUI, so it runs without the GTK libraries
+go build -tags nogui -o proxychanger-daemon -ldflags "-X main.Version=${BUILD_TAG}"
+go build -o proxychanger-helper/proxychanger-helper ./proxychanger-helper
 
-mkdir -p .local/share/icons .local/share/applications .config/autostart .local/bin .proxychanger
+mkdir -p .local/share/icons .local/share/applications .config/autostart .config/systemd/user .local/bin .proxychanger
 cp proxychanger.png .local/share/icons/
 cp proxychanger.desktop .local/share/applications/
 cp proxychanger.desktop .config/autostart/
-cp proxychanger .local/bin
+cp proxychanger.service .config/systemd/user/
+cp proxychanger proxychanger-daemon .local/bin
+mkdir -p .proxychanger/system
+cp proxychanger-helper/proxychanger-helper com.github.okelet.proxychanger.policy .proxychanger/system/
 
 for i in $(find locale -name "*.po") ; do
     SRC_DIR=$(dirname "${i}")
@@ -0,0 +1,24 @@
+module github.com/okelet/proxychanger
+
+go 1.21
+
+// github.com/okelet/goutils, github.com/okelet/ssh_config, github.com/jamesadney/appindicator and
+// github.com/esiqveland/notify are not pinned yet; "go mod tidy" adds them, with the indirect requirements
+// and go.sum
+require (
+	github.com/beevik/etree v1.1.0
+	github.com/fsnotify/fsnotify v1.4.9
+	github.com/go-ini/ini v1.42.0
+	github.com/godbus/dbus v4.1.0+incompatible
+	github.com/gosexy/gettext v0.0.0-20160830220431-74466a0a0c4a
+	github.com/gosimple/slug v1.9.0
+	github.com/gotk3/gotk3 v0.6.2
+	github.com/juju/loggo v0.0.0-20170605014607-8232ab8918d9
+	github.com/olekukonko/tablewriter v0.0.5
+	github.com/pkg/errors v0.9.1
+	github.com/robfig/cron v1.2.0
+	github.com/zalando/go-keyring v0.2.3
+	golang.org/x/crypto v0.14.0
+	gopkg.in/alecthomas/kingpin.v2 v2.2.6
+	gopkg.in/natefinch/lumberjack.v2 v2.0.0
+)
@@ -142,6 +142,21 @@ if [ -n "${AUTOSTART_OPTS}" ]; then
 fi
 
 # Ensure executable permissions
-chmod +x ${APP_PATH}
+chmod +x ${APP_PATH} ${APP_PATH}-daemon
+
+# Install the privileged helper, used to change the system files (APT, Yum/Dnf, Docker service, /etc/environment
+# and /etc/profile.d), and its polkit policy; it is optional and needs sudo
+if [[ "${INSTALL_HELPER}" == "1" ]]; then
+    echo "Installing privileged helper..."
+    OUT=$(sudo install -D -o root -g root -m 0755 "${HOME}/.proxychanger/system/proxychanger-helper" /usr/libexec/proxychanger/proxychanger-helper 2>&1 && \
+        sudo install -D -o root -g root -m 0644 "${HOME}/.proxychanger/system/com.github.okelet.proxychanger.policy" /usr/share/polkit-1/actions/com.github.okelet.proxychanger.policy 2>&1)
+    RET=$?
+    if [ ${RET} -ne 0 ]; then
+        echo "Error installing privileged helper (${RET}): ${OUT}"
+        exit 1
+    fi
+else
+    echo "To change the system settings, install the privileged helper re-running this script with INSTALL_HELPER=1."
+fi
 
 echo "Installation OK (version ${VERSION})."
@@ -103,7 +103,7 @@ fi
 [ ! -e ${HOME}/.proxychanger ] && mkdir ${HOME}/.proxychanger
 
 echo "Generating assets..."
-OUT=$(go-bindata -prefix proxychangerlib -pkg proxychangerlib -o proxychangerlib/assets.go proxychangerlib/assets/... 2>&1)
+OUT=$(go-bindata -prefix proxychangergui -pkg proxychangergui -o proxychangergui/assets.go proxychangergui/assets/... 2>&1)
 RET=$?
 if [ ${RET} -ne 0 ]; then
     echo "Error generating assets (${RET}): ${OUT}"
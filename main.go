@@ -4,16 +4,22 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"os/exec"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"syscall"
 
 	"github.com/juju/loggo"
 	kingpin "gopkg.in/alecthomas/kingpin.v2"
 
 	"github.com/godbus/dbus"
 	"github.com/gosexy/gettext"
-	"github.com/gotk3/gotk3/gtk"
 	"github.com/okelet/goutils"
 	"github.com/okelet/proxychanger/proxychangerlib"
 	"github.com/olekukonko/tablewriter"
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/ssh/terminal"
 )
 
 var Version = "master"
@@ -51,17 +57,71 @@ func main() {
 	testMode := indicatorCommand.Flag("test", proxychangerlib.MyGettextv("Test mode")).Short('t').Bool()
 	indicatorCommand.Default()
 
+	daemonCommand := app.Command("daemon", proxychangerlib.MyGettextv("Start as a daemon, without GUI; the proxy is changed according to the IPs, and the notifications are logged"))
+
 	listCommand := app.Command("list", proxychangerlib.MyGettextv("List proxies"))
 	// TODO: output format
 	includePasswords := listCommand.Flag("include-passwords", proxychangerlib.MyGettextv("Include passwords")).Bool()
 
 	applyActiveCommand := app.Command("apply", proxychangerlib.MyGettextv("Apply current current active proxy"))
+	applyActiveCommandApps := applyActiveCommand.Flag("app", proxychangerlib.MyGettextv("Apply only to this application; can be repeated")).Strings()
+
+	clearCommand := app.Command("clear", proxychangerlib.MyGettextv("Remove the proxy settings from applications"))
+	clearCommandApps := clearCommand.Flag("app", proxychangerlib.MyGettextv("Application to clear; can be repeated")).Required().Strings()
 
 	getActiveCommand := app.Command("get", proxychangerlib.MyGettextv("Get current active proxy slug; returns empty if no active proxy"))
 
+	envCommand := app.Command("env", proxychangerlib.MyGettextv("Print the shell commands that set the environment variables of the active proxy"))
+	envCommandShell := envCommand.Flag("shell", proxychangerlib.MyGettextv("Shell syntax; defaults to the login shell")).Enum(proxychangerlib.SHELLS...)
+	envCommandUnset := envCommand.Flag("unset", proxychangerlib.MyGettextv("Print the commands that remove the proxy variables")).Bool()
+
+	hookCommand := app.Command("hook", proxychangerlib.MyGettextv("Print the shell code that updates the proxy variables before each prompt when the active proxy changes"))
+	hookCommandShell := hookCommand.Arg("shell", proxychangerlib.MyGettextv("Shell")).Required().Enum(proxychangerlib.SHELLS...)
+
+	runCommand := app.Command("run", proxychangerlib.MyGettextv("Run a command using a proxy, without changing the active proxy"))
+	runCommandProxy := runCommand.Flag("proxy", proxychangerlib.MyGettextv("Slug of the proxy; use 'none' to run without proxy; defaults to the active proxy")).Short('p').String()
+	runCommandArgs := runCommand.Arg("command", proxychangerlib.MyGettextv("Command and arguments; use -- before the command if it has flags")).Required().Strings()
+
 	setActiveCommand := app.Command("set", proxychangerlib.MyGettextv("Set active proxy"))
 	setActiveCommandSlug := setActiveCommand.Arg("slug", proxychangerlib.MyGettextv("New active proxy slug; use 'none' to unset the proxy")).Required().String()
 
+	appsCommand := app.Command("apps", proxychangerlib.MyGettextv("Manage applications"))
+	appsListCommand := appsCommand.Command("list", proxychangerlib.MyGettextv("List applications"))
+	appsListCommandId := appsListCommand.Arg("id", proxychangerlib.MyGettextv("Show only the application with this id")).String()
+	appsEnableCommand := appsCommand.Command("enable", proxychangerlib.MyGettextv("Enable an application"))
+	appsEnableCommandId := appsEnableCommand.Arg("id", proxychangerlib.MyGettextv("Application id")).Required().String()
+	appsEnableCommandApply := appsEnableCommand.Flag("apply", proxychangerlib.MyGettextv("Apply the active proxy to the application now")).Bool()
+	appsDisableCommand := appsCommand.Command("disable", proxychangerlib.MyGettextv("Disable an application"))
+	appsDisableCommandId := appsDisableCommand.Arg("id", proxychangerlib.MyGettextv("Application id")).Required().String()
+	appsDisableCommandClean := appsDisableCommand.Flag("clean", proxychangerlib.MyGettextv("Remove the proxy settings from the application now")).Bool()
+
+	secretsCommand := app.Command("secrets", proxychangerlib.MyGettextv("Manage the passwords storage"))
+	secretsMigrateCommand := secretsCommand.Command("migrate", proxychangerlib.MyGettextv("Move the passwords to another secret store, and use it from now on"))
+	secretsMigrateCommandType := secretsMigrateCommand.Arg("type", proxychangerlib.MyGettextv("New secret store type")).Required().Enum(proxychangerlib.SECRET_STORES...)
+	secretsMigrateCommandOptions := secretsMigrateCommand.Flag("option", proxychangerlib.MyGettextv("Secret store option, in the format key=value; can be repeated")).Short('o').StringMap()
+
+	configCommand := app.Command("config", proxychangerlib.MyGettextv("Manage the configuration file"))
+	configValidateCommand := configCommand.Command("validate", proxychangerlib.MyGettextv("Report all the problems of a configuration file"))
+	configValidateCommandFile := configValidateCommand.Arg("file", proxychangerlib.MyGettextv("Configuration file")).Required().String()
+
+	importCommand := app.Command("import", proxychangerlib.MyGettextv("Import the proxies of a configuration file"))
+	importCommandFile := importCommand.Arg("file", proxychangerlib.MyGettextv("Configuration file")).Required().String()
+	importCommandMerge := importCommand.Flag("merge", proxychangerlib.MyGettextv("Add and update the proxies of the file, matching them by UUID or slug, and keep the rest of the configuration (default)")).Bool()
+	importCommandReplace := importCommand.Flag("replace", proxychangerlib.MyGettextv("Replace the whole configuration with the file")).Bool()
+	importCommandDryRun := importCommand.Flag("dry-run", proxychangerlib.MyGettextv("Show the changes without importing")).Bool()
+	importCommandResolutions := importCommand.Flag("resolve", proxychangerlib.MyGettextv("Resolution of a conflict, in the format slug=keep|replace|copy; can be repeated")).Short('r').StringMap()
+	importCommandSettings := importCommand.Flag("settings", proxychangerlib.MyGettextv("Import also the settings of the file when merging")).Bool()
+
+	exportCommand := app.Command("export", proxychangerlib.MyGettextv("Export the configuration"))
+	exportCommandIncludePasswords := exportCommand.Flag("include-passwords", proxychangerlib.MyGettextv("Include the passwords, encrypted with a passphrase; it is read from the %v environment variable, or asked", proxychangerlib.EXPORT_PASSPHRASE_ENV)).Bool()
+	exportCommandProxies := exportCommand.Flag("proxies", proxychangerlib.MyGettextv("Slugs of the proxies to export, separated by commas; defaults to all the proxies")).String()
+	exportCommandSettingsOnly := exportCommand.Flag("settings-only", proxychangerlib.MyGettextv("Export only the settings, without proxies")).Bool()
+	exportCommandOutput := exportCommand.Flag("output", proxychangerlib.MyGettextv("File to write; use - for the standard output")).Short('o').Default("-").String()
+
+	discoverCommand := app.Command("discover", proxychangerlib.MyGettextv("Show the proxies configured in the desktop and in the applications"))
+	discoverCommandAdd := discoverCommand.Flag("add", proxychangerlib.MyGettextv("Add the discovered proxies that are not configured yet; their passwords are moved to the secret store")).Bool()
+	discoverCommandProxies := discoverCommand.Flag("proxy", proxychangerlib.MyGettextv("Discovered proxy to add, as shown in the list; can be repeated, and defaults to all the proxies")).Short('p').Strings()
+
 	// TODO: add command
 
 	// TODO: edit command
@@ -89,35 +149,80 @@ func main() {
 	switch kingpin.MustParse(app.Parse(os.Args[1:])) {
 	case indicatorCommand.FullCommand():
 		os.Exit(runIndicator(sessionBus, *configFile, cmdLogLevelSet, *testMode))
+	case daemonCommand.FullCommand():
+		os.Exit(runDaemon(sessionBus, *configFile, cmdLogLevelSet))
 	case listCommand.FullCommand():
 		listProxies(sessionBus, *configFile, cmdLogLevelSet, *includePasswords)
 	case applyActiveCommand.FullCommand():
-		applyActiveProxyBySlug(sessionBus, *configFile, cmdLogLevelSet)
+		if len(*applyActiveCommandApps) > 0 {
+			os.Exit(applyToApplications(sessionBus, *applyActiveCommandApps, false, *configFile, cmdLogLevelSet))
+		} else {
+			applyActiveProxyBySlug(sessionBus, *configFile, cmdLogLevelSet)
+		}
+	case clearCommand.FullCommand():
+		os.Exit(applyToApplications(sessionBus, *clearCommandApps, true, *configFile, cmdLogLevelSet))
 	case getActiveCommand.FullCommand():
 		getActiveProxyBySlug(sessionBus, *configFile, cmdLogLevelSet)
+	case envCommand.FullCommand():
+		os.Exit(printEnvironment(sessionBus, *envCommandShell, *envCommandUnset, *configFile, cmdLogLevelSet))
+	case hookCommand.FullCommand():
+		os.Exit(printHook(*hookCommandShell))
+	case runCommand.FullCommand():
+		os.Exit(runWithProxy(sessionBus, *runCommandProxy, *runCommandArgs, *configFile, cmdLogLevelSet))
 	case setActiveCommand.FullCommand():
 		setActiveProxyBySlug(sessionBus, *setActiveCommandSlug, *configFile, cmdLogLevelSet)
+	case secretsMigrateCommand.FullCommand():
+		os.Exit(migrateSecrets(sessionBus, *secretsMigrateCommandType, *secretsMigrateCommandOptions, *configFile, cmdLogLevelSet))
+	case appsListCommand.FullCommand():
+		os.Exit(listApplications(sessionBus, *appsListCommandId, *configFile, cmdLogLevelSet))
+	case appsEnableCommand.FullCommand():
+		os.Exit(setApplicationEnabled(sessionBus, *appsEnableCommandId, true, *appsEnableCommandApply, *configFile, cmdLogLevelSet))
+	case appsDisableCommand.FullCommand():
+		os.Exit(setApplicationEnabled(sessionBus, *appsDisableCommandId, false, *appsDisableCommandClean, *configFile, cmdLogLevelSet))
+	case importCommand.FullCommand():
+		if *importCommandMerge && *importCommandReplace {
+			fmt.Println(proxychangerlib.MyGettextv("The options --merge and --replace can't be used at the same time."))
+			os.Exit(1)
+		}
+		mode := proxychangerlib.IMPORT_MODE_MERGE
+		if *importCommandReplace {
+			mode = proxychangerlib.IMPORT_MODE_REPLACE
+		}
+		os.Exit(importConfiguration(sessionBus, *importCommandFile, mode, *importCommandSettings, *importCommandDryRun, *importCommandResolutions, *configFile, cmdLogLevelSet))
+	case exportCommand.FullCommand():
+		slugs := []string{}
+		for _, s := range strings.Split(*exportCommandProxies, ",") {
+			if s = strings.TrimSpace(s); s != "" {
+				slugs = append(slugs, s)
+			}
+		}
+		os.Exit(exportConfiguration(sessionBus, *exportCommandIncludePasswords, slugs, *exportCommandSettingsOnly, *exportCommandOutput, *configFile, cmdLogLevelSet))
+	case configValidateCommand.FullCommand():
+		os.Exit(validateConfiguration(*configValidateCommandFile))
+	case discoverCommand.FullCommand():
+		if len(*discoverCommandProxies) > 0 && !*discoverCommandAdd {
+			fmt.Println(proxychangerlib.MyGettextv("The option --proxy can only be used with --add."))
+			os.Exit(1)
+		}
+		os.Exit(discoverProxies(sessionBus, *discoverCommandAdd, *discoverCommandProxies, *configFile, cmdLogLevelSet))
 	}
 
 }
 
-func runIndicator(sessionBus *dbus.Conn, configFile string, cmdLogLevelSet bool, testMode bool) int {
+func runDaemon(sessionBus *dbus.Conn, configFile string, cmdLogLevelSet bool) int {
 
 	config, err := proxychangerlib.NewConfig(configFile, false)
 	if err != nil {
-		fmt.Println(proxychangerlib.MyGettextv("Error loading configuration: %v.", err))
-		goutils.ShowZenityError(proxychangerlib.MyGettextv("Error"), proxychangerlib.MyGettextv("Error loading configuration: %v.", err))
+		fmt.Fprintln(os.Stderr, proxychangerlib.MyGettextv("Error loading configuration: %v.", err))
 		return 1
 	}
 
 	err = config.Lock(sessionBus)
 	if err == proxychangerlib.ApplicationAlreadyRunningError {
-		fmt.Println(proxychangerlib.MyGettextv("Application already running."))
-		goutils.ShowZenityError(proxychangerlib.MyGettextv("Error"), proxychangerlib.MyGettextv("Application already running."))
+		fmt.Fprintln(os.Stderr, proxychangerlib.MyGettextv("Application already running."))
 		return 1
 	} else if err != nil {
-		fmt.Println(proxychangerlib.MyGettextv("Error locking configuration: %v.", err))
-		goutils.ShowZenityError(proxychangerlib.MyGettextv("Error"), proxychangerlib.MyGettextv("Error locking configuration: %v.", err))
+		fmt.Fprintln(os.Stderr, proxychangerlib.MyGettextv("Error locking configuration: %v.", err))
 		return 1
 	}
 
@@ -125,23 +230,22 @@ func runIndicator(sessionBus *dbus.Conn, configFile string, cmdLogLevelSet bool,
 		proxychangerlib.Log.SetLogLevel(config.LogLevel)
 	}
 
-	gtk.Init(nil)
-
-	i, err := proxychangerlib.NewIndicator(sessionBus, config, Version, cmdLogLevelSet, testMode)
+	s := proxychangerlib.NewService(sessionBus, config, Version, cmdLogLevelSet, false)
+	err = s.Start(true)
 	if err != nil {
-		fmt.Println(proxychangerlib.MyGettextv("Error creating indicator: %v.", err))
-		goutils.ShowZenityError(proxychangerlib.MyGettextv("Error"), proxychangerlib.MyGettextv("Error creating indicator: %v.", err))
-		return 1
-	}
-	err = i.Run(true)
-	if err == nil {
-		gtk.Main()
-		return 0
-	} else {
-		fmt.Println("Error running indicator", err)
+		fmt.Fprintln(os.Stderr, proxychangerlib.MyGettextv("Error starting daemon: %v.", err))
+		s.Stop()
 		return 1
 	}
 
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGINT, syscall.SIGTERM)
+	sig := <-signals
+	proxychangerlib.Log.Infof("Received signal %v; stopping daemon", sig)
+	s.Stop()
+
+	return 0
+
 }
 
 func getConfigService(dbusConnection *dbus.Conn, configFile string, cmdLogLevelSet bool) (proxychangerlib.ConfigService, error) {
@@ -208,6 +312,7 @@ func listProxies(dbusConnection *dbus.Conn, configFile string, cmdLogLevelSet bo
 		proxychangerlib.MyGettextv("Protocol"),
 		proxychangerlib.MyGettextv("Address"),
 		proxychangerlib.MyGettextv("Username"),
+		proxychangerlib.MyGettextv("System"),
 	}
 	if includePasswords {
 		header = append(header, proxychangerlib.MyGettextv("Password"))
@@ -222,6 +327,7 @@ func listProxies(dbusConnection *dbus.Conn, configFile string, cmdLogLevelSet bo
 			v.Protocol,
 			v.Address,
 			v.Username,
+			map[bool]string{true: proxychangerlib.MyGettextv("Yes"), false: proxychangerlib.MyGettextv("No")}[v.Locked],
 		}
 		if includePasswords {
 			row = append(row, v.Password)
@@ -259,6 +365,54 @@ func applyActiveProxyBySlug(dbusConnection *dbus.Conn, configFile string, cmdLog
 
 }
 
+func applyToApplications(dbusConnection *dbus.Conn, ids []string, clear bool, configFile string, cmdLogLevelSet bool) int {
+
+	c, err := getConfigService(dbusConnection, configFile, cmdLogLevelSet)
+	if err != nil {
+		fmt.Println("Error", err)
+		return 1
+	}
+
+	var responseData string
+	if clear {
+		responseData, err = c.ClearApplications(ids)
+	} else {
+		responseData, err = c.ApplyActiveProxyToApplications(ids)
+	}
+
+	var response proxychangerlib.ApplyToApplicationsResponse
+	err = json.Unmarshal([]byte(responseData), &response)
+	if err != nil {
+		panic(err)
+	}
+
+	if response.Error != "" {
+		if clear {
+			fmt.Println(proxychangerlib.MyGettextv("Error clearing proxy: %v.", response.Error))
+		} else {
+			fmt.Println(proxychangerlib.MyGettextv("Error applying active proxy: %v.", response.Error))
+		}
+		return 1
+	}
+
+	exitCode := 0
+	for _, r := range response.Results {
+		if r.SkippedMessage != "" {
+			fmt.Println(proxychangerlib.MyGettextv("%v: Skipped (%v)", r.ApplicationId, r.SkippedMessage))
+		} else if r.ErrorMessage != "" {
+			fmt.Println(proxychangerlib.MyGettextv("%v: ERROR (%v)", r.ApplicationId, r.ErrorMessage))
+			exitCode = 1
+		} else if r.WarningMessage != "" {
+			fmt.Println(proxychangerlib.MyGettextv("%v: WARNING (%v)", r.ApplicationId, r.WarningMessage))
+		} else {
+			fmt.Println(proxychangerlib.MyGettextv("%v: OK", r.ApplicationId))
+		}
+	}
+
+	return exitCode
+
+}
+
 func getActiveProxyBySlug(dbusConnection *dbus.Conn, configFile string, cmdLogLevelSet bool) int {
 
 	c, err := getConfigService(dbusConnection, configFile, cmdLogLevelSet)
@@ -286,6 +440,105 @@ func getActiveProxyBySlug(dbusConnection *dbus.Conn, configFile string, cmdLogLe
 
 }
 
+// Errors are printed to the error output, because the output is evaluated by the shell
+func printEnvironment(dbusConnection *dbus.Conn, shell string, unset bool, configFile string, cmdLogLevelSet bool) int {
+
+	syntax := proxychangerlib.GetShellSyntax(shell)
+
+	if unset {
+		for _, line := range proxychangerlib.ShellUnsetLines(syntax, proxychangerlib.ProxyEnvironmentVariableNames()) {
+			fmt.Println(line)
+		}
+		return 0
+	}
+
+	c, err := getConfigService(dbusConnection, configFile, cmdLogLevelSet)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "Error", err)
+		return 1
+	}
+
+	responseData, err := c.GetActiveProxyEnvironment()
+
+	var response proxychangerlib.ProxyEnvironmentResponse
+	err = json.Unmarshal([]byte(responseData), &response)
+	if err != nil {
+		panic(err)
+	}
+
+	if response.Error != "" {
+		fmt.Fprintln(os.Stderr, proxychangerlib.MyGettextv("Error getting the proxy environment: %v.", response.Error))
+		return 1
+	}
+
+	if response.Warning != "" {
+		fmt.Fprintln(os.Stderr, response.Warning)
+	}
+
+	for _, line := range proxychangerlib.ShellEnvironmentLines(syntax, response.Variables) {
+		fmt.Println(line)
+	}
+
+	return 0
+
+}
+
+func printHook(shell string) int {
+
+	executable, err := os.Executable()
+	if err != nil {
+		executable = "proxychanger"
+	}
+
+	fmt.Print(proxychangerlib.ShellHook(shell, executable))
+
+	return 0
+
+}
+
+// Replaces this process with the command, so its exit code is returned; only returns on error
+func runWithProxy(dbusConnection *dbus.Conn, slug string, args []string, configFile string, cmdLogLevelSet bool) int {
+
+	c, err := getConfigService(dbusConnection, configFile, cmdLogLevelSet)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "Error", err)
+		return 1
+	}
+
+	var responseData string
+	if slug == "" {
+		responseData, err = c.GetActiveProxyEnvironment()
+	} else {
+		responseData, err = c.GetProxyEnvironmentBySlug(slug)
+	}
+
+	var response proxychangerlib.ProxyEnvironmentResponse
+	err = json.Unmarshal([]byte(responseData), &response)
+	if err != nil {
+		panic(err)
+	}
+
+	if response.Error != "" {
+		fmt.Fprintln(os.Stderr, proxychangerlib.MyGettextv("Error getting the proxy environment: %v.", response.Error))
+		return 1
+	}
+
+	if response.Warning != "" {
+		fmt.Fprintln(os.Stderr, response.Warning)
+	}
+
+	commandPath, err := exec.LookPath(args[0])
+	if err != nil {
+		fmt.Fprintln(os.Stderr, proxychangerlib.MyGettextv("Command %v not found", args[0]))
+		return 127
+	}
+
+	err = syscall.Exec(commandPath, args, proxychangerlib.CommandEnvironment(response.Variables, os.Environ()))
+	fmt.Fprintln(os.Stderr, proxychangerlib.MyGettextv("Error running command %v: %v", args[0], err))
+	return 126
+
+}
+
 func setActiveProxyBySlug(dbusConnection *dbus.Conn, slug string, configFile string, cmdLogLevelSet bool) int {
 
 	c, err := getConfigService(dbusConnection, configFile, cmdLogLevelSet)
@@ -310,3 +563,397 @@ func setActiveProxyBySlug(dbusConnection *dbus.Conn, slug string, configFile str
 	return 0
 
 }
+
+func listApplications(dbusConnection *dbus.Conn, id string, configFile string, cmdLogLevelSet bool) int {
+
+	c, err := getConfigService(dbusConnection, configFile, cmdLogLevelSet)
+	if err != nil {
+		fmt.Println("Error", err)
+		return 1
+	}
+
+	responseData, err := c.ListApplications()
+
+	var response proxychangerlib.ListApplicationsResponse
+	err = json.Unmarshal([]byte(responseData), &response)
+	if err != nil {
+		panic(err)
+	}
+
+	if response.Error != "" {
+		fmt.Println(proxychangerlib.MyGettextv("Error listing applications: %v.", response.Error))
+		return 1
+	}
+
+	found := false
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{
+		proxychangerlib.MyGettextv("Id"),
+		proxychangerlib.MyGettextv("Name"),
+		proxychangerlib.MyGettextv("Enabled"),
+		proxychangerlib.MyGettextv("System"),
+		proxychangerlib.MyGettextv("Installed"),
+		proxychangerlib.MyGettextv("Version"),
+		proxychangerlib.MyGettextv("Status"),
+	})
+
+	for _, v := range response.Applications {
+		if id != "" && v.Id != id {
+			continue
+		}
+		found = true
+		table.Append([]string{
+			v.Id,
+			v.Name,
+			map[bool]string{true: proxychangerlib.MyGettextv("Yes"), false: proxychangerlib.MyGettextv("No")}[v.Enabled],
+			map[bool]string{true: proxychangerlib.MyGettextv("Yes"), false: proxychangerlib.MyGettextv("No")}[v.Locked],
+			map[bool]string{true: proxychangerlib.MyGettextv("Yes"), false: proxychangerlib.MyGettextv("No")}[v.Installed],
+			v.Version,
+			applicationStatus(v.Status),
+		})
+	}
+
+	if id != "" && !found {
+		fmt.Println(proxychangerlib.MyGettextv("Application with id %v not found", id))
+		return 1
+	}
+	table.Render()
+
+	return 0
+
+}
+
+// Returns the status of the settings of the application, or empty if it can't report it
+func applicationStatus(r *proxychangerlib.ApplicationResultStruct) string {
+	if r == nil {
+		return ""
+	} else if r.SkippedMessage != "" {
+		return proxychangerlib.MyGettextv("Skipped (%v)", r.SkippedMessage)
+	} else if r.ErrorMessage != "" {
+		return proxychangerlib.MyGettextv("ERROR (%v)", r.ErrorMessage)
+	} else if r.WarningMessage != "" {
+		return proxychangerlib.MyGettextv("WARNING (%v)", r.WarningMessage)
+	}
+	return proxychangerlib.MyGettextv("OK")
+}
+
+func setApplicationEnabled(dbusConnection *dbus.Conn, id string, enabled bool, applyNow bool, configFile string, cmdLogLevelSet bool) int {
+
+	c, err := getConfigService(dbusConnection, configFile, cmdLogLevelSet)
+	if err != nil {
+		fmt.Println("Error", err)
+		return 1
+	}
+
+	var responseData string
+	if enabled {
+		responseData, err = c.EnableApplicationById(id, applyNow)
+	} else {
+		responseData, err = c.DisableApplicationById(id, applyNow)
+	}
+
+	var response proxychangerlib.SetApplicationEnabledResponse
+	err = json.Unmarshal([]byte(responseData), &response)
+	if err != nil {
+		panic(err)
+	}
+
+	if response.Error != "" {
+		if enabled {
+			fmt.Println(proxychangerlib.MyGettextv("Error enabling application: %v.", response.Error))
+		} else {
+			fmt.Println(proxychangerlib.MyGettextv("Error disabling application: %v.", response.Error))
+		}
+		return 1
+	}
+
+	if response.Result != nil {
+		if response.Result.SkippedMessage != "" {
+			fmt.Println(proxychangerlib.MyGettextv("Skipped: %v.", response.Result.SkippedMessage))
+		} else if response.Result.ErrorMessage != "" {
+			fmt.Println(proxychangerlib.MyGettextv("Error: %v.", response.Result.ErrorMessage))
+			return 1
+		} else if response.Result.WarningMessage != "" {
+			fmt.Println(proxychangerlib.MyGettextv("Warning: %v.", response.Result.WarningMessage))
+		}
+	}
+
+	return 0
+
+}
+
+func migrateSecrets(dbusConnection *dbus.Conn, storeType string, options map[string]string, configFile string, cmdLogLevelSet bool) int {
+
+	c, err := getConfigService(dbusConnection, configFile, cmdLogLevelSet)
+	if err != nil {
+		fmt.Println("Error", err)
+		return 1
+	}
+
+	responseData, err := c.MigrateSecrets(storeType, options)
+
+	var response proxychangerlib.MigrateSecretsResponse
+	err = json.Unmarshal([]byte(responseData), &response)
+	if err != nil {
+		panic(err)
+	}
+
+	if response.Error != "" {
+		fmt.Println(proxychangerlib.MyGettextv("Error migrating passwords (%v migrated): %v.", response.Migrated, response.Error))
+		return 1
+	}
+
+	fmt.Println(proxychangerlib.MyGettextv("%v passwords migrated to secret store %v.", response.Migrated, storeType))
+
+	return 0
+
+}
+
+func importConfiguration(dbusConnection *dbus.Conn, filename string, mode string, withSettings bool, dryRun bool, resolutions map[string]string, configFile string, cmdLogLevelSet bool) int {
+
+	// The file is read by the running instance, that can have other working directory
+	filename, err := filepath.Abs(filename)
+	if err != nil {
+		fmt.Println("Error", err)
+		return 1
+	}
+
+	passphrase := ""
+	encrypted, err := proxychangerlib.IsEncryptedConfigurationFile(filename)
+	if err != nil {
+		fmt.Println("Error", err)
+		return 1
+	}
+	if encrypted {
+		passphrase, err = readPassphrase(false)
+		if err != nil {
+			fmt.Println("Error", err)
+			return 1
+		}
+	}
+
+	c, err := getConfigService(dbusConnection, configFile, cmdLogLevelSet)
+	if err != nil {
+		fmt.Println("Error", err)
+		return 1
+	}
+
+	responseData, err := c.ImportConfiguration(filename, passphrase, mode, withSettings, dryRun, resolutions)
+
+	var response proxychangerlib.ImportConfigurationResponse
+	err = json.Unmarshal([]byte(responseData), &response)
+	if err != nil {
+		panic(err)
+	}
+
+	if len(response.Entries) > 0 {
+		table := tablewriter.NewWriter(os.Stdout)
+		table.SetHeader([]string{
+			proxychangerlib.MyGettextv("Action"),
+			proxychangerlib.MyGettextv("Slug"),
+			proxychangerlib.MyGettextv("Name"),
+			proxychangerlib.MyGettextv("Details"),
+		})
+		for _, e := range response.Entries {
+			details := e.Message
+			if e.Action == proxychangerlib.IMPORT_ACTION_CONFLICT {
+				details = proxychangerlib.MyGettextv("%v (resolution: %v)", e.Message, e.Resolution)
+			}
+			table.Append([]string{e.Action, e.Key, e.Name, details})
+		}
+		table.Render()
+	}
+
+	if response.Error != "" {
+		fmt.Println(proxychangerlib.MyGettextv("Error importing configuration: %v.", response.Error))
+		return 1
+	}
+
+	if withSettings && mode == proxychangerlib.IMPORT_MODE_MERGE {
+		fmt.Println(proxychangerlib.MyGettextv("The settings are replaced by the ones of the file."))
+	}
+	if dryRun {
+		fmt.Println(proxychangerlib.MyGettextv("Dry run; nothing has been imported."))
+	} else {
+		fmt.Println(proxychangerlib.MyGettextv("Configuration imported."))
+	}
+
+	return 0
+
+}
+
+// Writes the configuration to the output file, or to the standard output if it is "-"; the messages are written
+// to the error output, so they are not mixed with the configuration
+func exportConfiguration(dbusConnection *dbus.Conn, includePasswords bool, slugs []string, settingsOnly bool, output string, configFile string, cmdLogLevelSet bool) int {
+
+	passphrase := ""
+	if includePasswords && !settingsOnly {
+		var err error
+		passphrase, err = readPassphrase(true)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "Error", err)
+			return 1
+		}
+	}
+
+	c, err := getConfigService(dbusConnection, configFile, cmdLogLevelSet)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "Error", err)
+		return 1
+	}
+
+	responseData, err := c.ExportConfiguration(includePasswords, passphrase, slugs, settingsOnly)
+
+	var response proxychangerlib.ExportConfigurationResponse
+	err = json.Unmarshal([]byte(responseData), &response)
+	if err != nil {
+		panic(err)
+	}
+
+	if response.Error != "" {
+		fmt.Fprintln(os.Stderr, proxychangerlib.MyGettextv("Error exporting configuration: %v.", response.Error))
+		return 1
+	}
+
+	if output == "-" {
+		os.Stdout.WriteString(response.Data)
+		return 0
+	}
+
+	if includePasswords {
+		err = proxychangerlib.SafeWriteCredentialsFile(output, []byte(response.Data))
+	} else {
+		err = proxychangerlib.SafeWriteFile(output, []byte(response.Data), 0644)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, proxychangerlib.MyGettextv("Error exporting configuration: %v.", err))
+		return 1
+	}
+	fmt.Fprintln(os.Stderr, proxychangerlib.MyGettextv("Configuration exported to %v.", output))
+	return 0
+
+}
+
+// Lists the proxies found in the settings of the applications and, with add, adds the ones with the keys (or all
+// of them, if no keys) to the configuration
+func discoverProxies(dbusConnection *dbus.Conn, add bool, keys []string, configFile string, cmdLogLevelSet bool) int {
+
+	c, err := getConfigService(dbusConnection, configFile, cmdLogLevelSet)
+	if err != nil {
+		fmt.Println("Error", err)
+		return 1
+	}
+
+	responseData, err := c.DiscoverProxies(add, keys)
+
+	var response proxychangerlib.DiscoverProxiesResponse
+	err = json.Unmarshal([]byte(responseData), &response)
+	if err != nil {
+		panic(err)
+	}
+
+	if len(response.Proxies) == 0 && response.Error == "" {
+		fmt.Println(proxychangerlib.MyGettextv("No proxies found."))
+		return 0
+	}
+
+	if len(response.Proxies) > 0 {
+		table := tablewriter.NewWriter(os.Stdout)
+		table.SetHeader([]string{
+			proxychangerlib.MyGettextv("Proxy"),
+			proxychangerlib.MyGettextv("Found in"),
+			proxychangerlib.MyGettextv("Exceptions"),
+			proxychangerlib.MyGettextv("Password"),
+			proxychangerlib.MyGettextv("Configured as"),
+		})
+		for _, d := range response.Proxies {
+			table.Append([]string{
+				d.Key,
+				strings.Join(d.Sources, ", "),
+				strings.Join(d.Exceptions, ", "),
+				map[bool]string{true: proxychangerlib.MyGettextv("Yes"), false: proxychangerlib.MyGettextv("No")}[d.HasPassword],
+				d.Existing,
+			})
+		}
+		table.Render()
+	}
+
+	if response.Error != "" {
+		fmt.Println(proxychangerlib.MyGettextv("Error adding discovered proxies: %v.", response.Error))
+		return 1
+	}
+
+	if add {
+		if len(response.Added) > 0 {
+			fmt.Println(proxychangerlib.MyGettextv("Proxies added: %v.", strings.Join(response.Added, ", ")))
+		} else {
+			fmt.Println(proxychangerlib.MyGettextv("All the discovered proxies are already configured."))
+		}
+	}
+
+	return 0
+
+}
+
+// Returns the passphrase of the exported passwords, from the environment or asking it in the terminal; when
+// exporting, it is asked twice
+func readPassphrase(confirm bool) (string, error) {
+
+	if passphrase := os.Getenv(proxychangerlib.EXPORT_PASSPHRASE_ENV); passphrase != "" {
+		return passphrase, nil
+	}
+
+	fd := int(os.Stdin.Fd())
+	if !terminal.IsTerminal(fd) {
+		return "", errors.New(proxychangerlib.MyGettextv("A passphrase is required; set the %v environment variable", proxychangerlib.EXPORT_PASSPHRASE_ENV))
+	}
+
+	fmt.Fprint(os.Stderr, proxychangerlib.MyGettextv("Passphrase: "))
+	passphrase, err := terminal.ReadPassword(fd)
+	fmt.Fprintln(os.Stderr)
+	if err != nil {
+		return "", err
+	}
+	if len(passphrase) == 0 {
+		return "", errors.New(proxychangerlib.MyGettextv("The passphrase can't be empty"))
+	}
+
+	if confirm {
+		fmt.Fprint(os.Stderr, proxychangerlib.MyGettextv("Repeat the passphrase: "))
+		repeated, err := terminal.ReadPassword(fd)
+		fmt.Fprintln(os.Stderr)
+		if err != nil {
+			return "", err
+		}
+		if string(repeated) != string(passphrase) {
+			return "", errors.New(proxychangerlib.MyGettextv("The passphrases don't match"))
+		}
+	}
+
+	return string(passphrase), nil
+
+}
+
+// Prints the problems of the configuration file; returns 1 if there is any
+func validateConfiguration(filename string) int {
+
+	proxychangerlib.RegisterPluginApplications()
+
+	problems, err := proxychangerlib.ValidateConfigurationFile(filename)
+	if err != nil {
+		fmt.Println(proxychangerlib.MyGettextv("Error validating configuration: %v.", err))
+		return 1
+	}
+
+	if len(problems) == 0 {
+		fmt.Println(proxychangerlib.MyGettextv("The configuration file %v is valid.", filename))
+		return 0
+	}
+
+	for _, p := range problems {
+		fmt.Println(p.String())
+	}
+	fmt.Println(proxychangerlib.MyGettextv("%v problems found.", len(problems)))
+	return 1
+
+}
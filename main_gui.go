@@ -0,0 +1,57 @@
+//go:build !nogui
+// +build !nogui
+
+package main
+
+import (
+	"fmt"
+
+	"github.com/godbus/dbus"
+	"github.com/gotk3/gotk3/gtk"
+	"github.com/okelet/goutils"
+	"github.com/okelet/proxychanger/proxychangergui"
+	"github.com/okelet/proxychanger/proxychangerlib"
+)
+
+func runIndicator(sessionBus *dbus.Conn, configFile string, cmdLogLevelSet bool, testMode bool) int {
+
+	config, err := proxychangerlib.NewConfig(configFile, false)
+	if err != nil {
+		fmt.Println(proxychangerlib.MyGettextv("Error loading configuration: %v.", err))
+		goutils.ShowZenityError(proxychangerlib.MyGettextv("Error"), proxychangerlib.MyGettextv("Error loading configuration: %v.", err))
+		return 1
+	}
+
+	err = config.Lock(sessionBus)
+	if err == proxychangerlib.ApplicationAlreadyRunningError {
+		fmt.Println(proxychangerlib.MyGettextv("Application already running."))
+		goutils.ShowZenityError(proxychangerlib.MyGettextv("Error"), proxychangerlib.MyGettextv("Application already running."))
+		return 1
+	} else if err != nil {
+		fmt.Println(proxychangerlib.MyGettextv("Error locking configuration: %v.", err))
+		goutils.ShowZenityError(proxychangerlib.MyGettextv("Error"), proxychangerlib.MyGettextv("Error locking configuration: %v.", err))
+		return 1
+	}
+
+	if !cmdLogLevelSet {
+		proxychangerlib.Log.SetLogLevel(config.LogLevel)
+	}
+
+	gtk.Init(nil)
+
+	i, err := proxychangergui.NewIndicator(sessionBus, config, Version, cmdLogLevelSet, testMode)
+	if err != nil {
+		fmt.Println(proxychangerlib.MyGettextv("Error creating indicator: %v.", err))
+		goutils.ShowZenityError(proxychangerlib.MyGettextv("Error"), proxychangerlib.MyGettextv("Error creating indicator: %v.", err))
+		return 1
+	}
+	err = i.Run(true)
+	if err == nil {
+		gtk.Main()
+		return 0
+	} else {
+		fmt.Println("Error running indicator", err)
+		return 1
+	}
+
+}
@@ -0,0 +1,18 @@
+//go:build nogui
+// +build nogui
+
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/godbus/dbus"
+	"github.com/okelet/proxychanger/proxychangerlib"
+)
+
+// Built with the tag nogui, for the daemon, so the binary doesn't link GTK
+func runIndicator(sessionBus *dbus.Conn, configFile string, cmdLogLevelSet bool, testMode bool) int {
+	fmt.Fprintln(os.Stderr, proxychangerlib.MyGettextv("This binary has been built without graphical interface; use the command daemon."))
+	return 1
+}
@@ -0,0 +1,321 @@
+// Privileged helper of proxychanger, run as root through pkexec, that changes the system files with the proxy
+// settings. It only depends on the standard library, and only accepts the files and services in the lists below.
+// The content written is checked against the grammar of each file, so it can only change the proxy settings:
+// otherwise, any process of the user could get root just by writing, for example, an ExecStart= in the Docker
+// drop-in or shell code in profile.d.
+//
+// Usage:
+//
+//	proxychanger-helper write <file>              (the content is read from the standard input)
+//	proxychanger-helper write-credentials <file>  (like write, but the file is only readable by root)
+//	proxychanger-helper remove <file>
+//	proxychanger-helper restart <service>
+package main
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"syscall"
+)
+
+// Maximum size of the files written
+const MAX_FILE_SIZE = 1024 * 1024
+
+// Files that can be written or removed, and the mode for the new ones; files holding passwords that only root
+// needs to read are private (APT also reads its configuration when run by other users, so it is only private
+// when written with write-credentials)
+var ALLOWED_FILES = map[string]os.FileMode{
+	"/etc/apt/apt.conf.d/90proxy": 0644,
+	"/etc/yum.conf":               0600,
+	"/etc/dnf/dnf.conf":           0600,
+	"/etc/systemd/system/docker.service.d/http-proxy.conf": 0600,
+	"/etc/environment":               0644,
+	"/etc/profile.d/proxychanger.sh": 0644,
+}
+
+// Checks the new content of each allowed file, given the current one (empty if it doesn't exist)
+var FILE_VALIDATORS = map[string]func(data []byte, current []byte) error{
+	"/etc/apt/apt.conf.d/90proxy": validateApt,
+	"/etc/yum.conf":               validateYumDnf,
+	"/etc/dnf/dnf.conf":           validateYumDnf,
+	"/etc/systemd/system/docker.service.d/http-proxy.conf": validateDockerDropIn,
+	"/etc/environment":               validateEtcEnvironment,
+	"/etc/profile.d/proxychanger.sh": validateProfileD,
+}
+
+// Services that can be restarted
+var ALLOWED_SERVICES = []string{"docker.service"}
+
+// Variables that can be set in the environment files
+const PROXY_VARIABLE = `(?:http_proxy|https_proxy|ftp_proxy|all_proxy|no_proxy|HTTP_PROXY|HTTPS_PROXY|FTP_PROXY|ALL_PROXY|NO_PROXY)`
+
+var APT_LINE_REGEXP = regexp.MustCompile(`^Acquire::(?:http|https|ftp)::proxy "[^"\\]*";$`)
+var YUM_DNF_PROXY_LINE_REGEXP = regexp.MustCompile(`^\s*(?:proxy|proxy_username|proxy_password)\s*=`)
+var DOCKER_DROP_IN_LINE_REGEXP = regexp.MustCompile(`^(?:\[Service\]|Environment=(?:HTTP_PROXY|HTTPS_PROXY|NO_PROXY)=[^\s"'\\]*)$`)
+var ETC_ENVIRONMENT_LINE_REGEXP = regexp.MustCompile(`^` + PROXY_VARIABLE + `=[^\s"'\\]*$`)
+
+// Single quoted values, where the single quotes are written as '\”
+var PROFILE_D_LINE_REGEXP = regexp.MustCompile(`^(?:#.*|export ` + PROXY_VARIABLE + `='[^']*(?:'\\''[^']*)*')$`)
+
+// Lines of the managed block of proxychanger in /etc/environment
+const BLOCK_BEGIN = "# BEGIN proxychanger"
+const BLOCK_END = "# END proxychanger"
+
+func main() {
+	if len(os.Args) != 3 {
+		fail("Usage: %v write|write-credentials|remove <file> | restart <service>", os.Args[0])
+	}
+	if os.Geteuid() != 0 {
+		fail("This helper must run as root")
+	}
+	action, target := os.Args[1], os.Args[2]
+	switch action {
+	case "write", "write-credentials":
+		mode, ok := ALLOWED_FILES[target]
+		if !ok {
+			fail("File %v not allowed", target)
+		}
+		data, err := ioutil.ReadAll(io.LimitReader(os.Stdin, MAX_FILE_SIZE+1))
+		if err != nil {
+			fail("Error reading the standard input: %v", err)
+		}
+		if len(data) > MAX_FILE_SIZE {
+			fail("Content bigger than %v bytes", MAX_FILE_SIZE)
+		}
+		current, err := ioutil.ReadFile(target)
+		if err != nil && !os.IsNotExist(err) {
+			fail("Error reading the file %v: %v", target, err)
+		}
+		err = FILE_VALIDATORS[target](data, current)
+		if err != nil {
+			fail("Content of the file %v not allowed: %v", target, err)
+		}
+		err = writeFile(target, data, mode, action == "write-credentials")
+		if err != nil {
+			fail("Error writing the file %v: %v", target, err)
+		}
+	case "remove":
+		if _, ok := ALLOWED_FILES[target]; !ok {
+			fail("File %v not allowed", target)
+		}
+		err := os.Remove(target)
+		if err != nil && !os.IsNotExist(err) {
+			fail("Error deleting file %v: %v", target, err)
+		}
+	case "restart":
+		allowed := false
+		for _, s := range ALLOWED_SERVICES {
+			allowed = allowed || s == target
+		}
+		if !allowed {
+			fail("Service %v not allowed", target)
+		}
+		for _, args := range [][]string{{"daemon-reload"}, {"restart", target}} {
+			out, err := exec.Command("systemctl", args...).CombinedOutput()
+			if err != nil {
+				fail("Error running systemctl %v: %v: %s", args, err, out)
+			}
+		}
+	default:
+		fail("Invalid action %v", action)
+	}
+}
+
+// Replaces the file atomically, keeping the mode and owner of the existing one; if credentials is true, the
+// mode is restricted to the owner
+func writeFile(filename string, data []byte, mode os.FileMode, credentials bool) error {
+	err := os.MkdirAll(filepath.Dir(filename), 0755)
+	if err != nil {
+		return err
+	}
+	uid, gid := 0, 0
+	info, err := os.Lstat(filename)
+	if err == nil {
+		if !info.Mode().IsRegular() {
+			return fmt.Errorf("%v is not a regular file", filename)
+		}
+		mode = info.Mode().Perm()
+		if stat, ok := info.Sys().(*syscall.Stat_t); ok {
+			uid, gid = int(stat.Uid), int(stat.Gid)
+		}
+	} else if !os.IsNotExist(err) {
+		return err
+	}
+	if credentials {
+		mode = mode & 0600
+	}
+	tmp, err := ioutil.TempFile(filepath.Dir(filename), "."+filepath.Base(filename)+".tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	_, err = tmp.Write(data)
+	if err == nil {
+		err = tmp.Chmod(mode)
+	}
+	if err == nil {
+		err = tmp.Chown(uid, gid)
+	}
+	if err == nil {
+		err = tmp.Sync()
+	}
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), filename)
+}
+
+// Splits the content in lines, rejecting the control characters (other than the line ends)
+func splitLines(data []byte) ([]string, error) {
+	if len(data) == 0 {
+		return []string{}, nil
+	}
+	lines := strings.Split(strings.TrimSuffix(string(data), "\n"), "\n")
+	for i, line := range lines {
+		for _, c := range line {
+			if (c < 0x20 && c != '\t') || c == 0x7f {
+				return nil, fmt.Errorf("control character in line %v", i+1)
+			}
+		}
+	}
+	return lines, nil
+}
+
+// Checks that all the lines of the content match the regular expression (empty lines are allowed)
+func validateLines(data []byte, re *regexp.Regexp) error {
+	lines, err := splitLines(data)
+	if err != nil {
+		return err
+	}
+	for i, line := range lines {
+		if line != "" && !re.MatchString(line) {
+			return fmt.Errorf("invalid line %v", i+1)
+		}
+	}
+	return nil
+}
+
+// Checks that the lines, other than the ones changed by proxychanger, are the same in both contents
+func validateSameLines(lines []string, currentLines []string) error {
+	trim := func(l []string) []string {
+		for len(l) > 0 && strings.TrimSpace(l[len(l)-1]) == "" {
+			l = l[:len(l)-1]
+		}
+		return l
+	}
+	lines, currentLines = trim(lines), trim(currentLines)
+	if len(lines) != len(currentLines) {
+		return fmt.Errorf("lines other than the proxy settings added or removed")
+	}
+	for i := range lines {
+		if lines[i] != currentLines[i] {
+			return fmt.Errorf("lines other than the proxy settings changed")
+		}
+	}
+	return nil
+}
+
+// The file is written entirely by proxychanger, and only has the proxy of each scheme
+func validateApt(data []byte, current []byte) error {
+	return validateLines(data, APT_LINE_REGEXP)
+}
+
+// Only the proxy keys of the section main can be added, changed or removed
+func validateYumDnf(data []byte, current []byte) error {
+	lines, err := splitLines(data)
+	if err != nil {
+		return err
+	}
+	currentLines, err := splitLines(current)
+	if err != nil {
+		return err
+	}
+	filter := func(lines []string, checkSection bool) ([]string, error) {
+		filtered := []string{}
+		section := ""
+		for i, line := range lines {
+			if trimmed := strings.TrimSpace(line); strings.HasPrefix(trimmed, "[") {
+				section = trimmed
+			}
+			if YUM_DNF_PROXY_LINE_REGEXP.MatchString(line) {
+				if checkSection && section != "[main]" {
+					return nil, fmt.Errorf("proxy outside the section main in line %v", i+1)
+				}
+				continue
+			}
+			filtered = append(filtered, line)
+		}
+		return filtered, nil
+	}
+	lines, err = filter(lines, true)
+	if err != nil {
+		return err
+	}
+	currentLines, _ = filter(currentLines, false)
+	return validateSameLines(lines, currentLines)
+}
+
+// The file is written entirely by proxychanger, and only has the proxy variables of the service
+func validateDockerDropIn(data []byte, current []byte) error {
+	return validateLines(data, DOCKER_DROP_IN_LINE_REGEXP)
+}
+
+// Only the managed block can change, and it can only have proxy variables
+func validateEtcEnvironment(data []byte, current []byte) error {
+	lines, err := splitLines(data)
+	if err != nil {
+		return err
+	}
+	currentLines, err := splitLines(current)
+	if err != nil {
+		return err
+	}
+	outside := func(lines []string, checkBlock bool) ([]string, error) {
+		filtered := []string{}
+		inBlock := false
+		for i, line := range lines {
+			switch {
+			case !inBlock && strings.TrimSpace(line) == BLOCK_BEGIN:
+				inBlock = true
+			case inBlock && strings.TrimSpace(line) == BLOCK_END:
+				inBlock = false
+			case inBlock:
+				if checkBlock && line != "" && !ETC_ENVIRONMENT_LINE_REGEXP.MatchString(line) {
+					return nil, fmt.Errorf("invalid line %v", i+1)
+				}
+			default:
+				filtered = append(filtered, line)
+			}
+		}
+		if inBlock && checkBlock {
+			return nil, fmt.Errorf("line \"%v\" not found", BLOCK_END)
+		}
+		return filtered, nil
+	}
+	lines, err = outside(lines, true)
+	if err != nil {
+		return err
+	}
+	currentLines, _ = outside(currentLines, false)
+	return validateSameLines(lines, currentLines)
+}
+
+// The file is written entirely by proxychanger, and only has comments and exports of the proxy variables with
+// single quoted values
+func validateProfileD(data []byte, current []byte) error {
+	return validateLines(data, PROFILE_D_LINE_REGEXP)
+}
+
+func fail(format string, args ...interface{}) {
+	fmt.Fprintf(os.Stderr, format+"\n", args...)
+	os.Exit(1)
+}
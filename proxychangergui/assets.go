@@ -0,0 +1,375 @@
+// Code generated by go-bindata.
+// sources:
+// proxychangergui/assets/config.glade
+// proxychangergui/assets/config.glade~
+// proxychangergui/assets/discover.glade
+// proxychangergui/assets/import.glade
+// proxychangergui/assets/passphrase.glade
+// proxychangergui/assets/proxy.glade
+// proxychangergui/assets/proxy.glade~
+// DO NOT EDIT!
+
+package proxychangergui
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+func bindataRead(data []byte, name string) ([]byte, error) {
+	gz, err := gzip.NewReader(bytes.NewBuffer(data))
+	if err != nil {
+		return nil, fmt.Errorf("Read %q: %v", name, err)
+	}
+
+	var buf bytes.Buffer
+	_, err = io.Copy(&buf, gz)
+	clErr := gz.Close()
+
+	if err != nil {
+		return nil, fmt.Errorf("Read %q: %v", name, err)
+	}
+	if clErr != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+type asset struct {
+	bytes []byte
+	info  os.FileInfo
+}
+
+type bindataFileInfo struct {
+	name    string
+	size    int64
+	mode    os.FileMode
+	modTime time.Time
+}
+
+func (fi bindataFileInfo) Name() string {
+	return fi.name
+}
+func (fi bindataFileInfo) Size() int64 {
+	return fi.size
+}
+func (fi bindataFileInfo) Mode() os.FileMode {
+	return fi.mode
+}
+func (fi bindataFileInfo) ModTime() time.Time {
+	return fi.modTime
+}
+func (fi bindataFileInfo) IsDir() bool {
+	return false
+}
+func (fi bindataFileInfo) Sys() interface{} {
+	return nil
+}
+
+var _assetsConfigGlade = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xed\x5d\x5b\x73\xdb\xba\x11\x7e\x3f\xbf\x82\xe5\xc3\x99\x76\x3a\xb2\x42\xd9\x4e\xd2\x1e\xdb\x19\xc7\x71\x32\x9e\x66\x92\x8c\xed\x9c\x3c\x72\x20\x12\x92\x50\x51\x04\x0f\x08\xf9\xd2\x5f\xdf\x25\x41\xc9\xba\xf0\x02\x80\x94\x4d\xca\x78\x12\x29\x12\x8b\xcb\x7e\xbb\xd8\x5d\x2c\xc0\x93\x0f\x0f\xb3\xc0\xba\xc3\x2c\x26\x34\x3c\xb5\x9d\x83\x37\xb6\x85\x43\x8f\xfa\x24\x1c\x9f\xda\x3f\x6f\x3f\xf7\xde\xdb\x1f\xce\x7e\x3b\xf9\x5b\xaf\x67\x7d\xc1\x21\x66\x88\x63\xdf\xba\x27\x7c\x62\x8d\x03\xe4\x63\xeb\xf0\xc0\x79\x7f\x70\x68\xf5\x7a\xf0\x12\x09\x39\x66\x23\xe4\x61\xcb\xa7\x33\x44\x80\x5e\xc4\xe8\xc3\xa3\x37\x41\xe1\x18\x33\xfb\xec\x37\xcb\x3a\x61\xf8\xaf\x39\x61\x38\xb6\x02\x32\x3c\xb5\xc7\x7c\xfa\x4f\xfb\xa9\x7a\x20\x36\xb0\xfb\xe9\x7b\x74\xf8\x5f\xec\x71\xcb\x0b\x50\x1c\x9f\xda\x5f\xf8\xf4\x2b\x89\xf9\x0d\xa7\x0c\xdb\x16\xf1\x4f\xed\x00\x6e\xe3\xe4\xd6\x45\x51\x14\xa7\xb4\xa1\x94\x47\x83\xf9\x2c\x8c\xc5\x1d\xdc\x27\xcd\x16\xff\xf5\x42\x34\xc3\xd6\x7c\x4e\xfc\xb4\xad\xd9\x73\xf1\xcc\xe2\x8f\x11\x86\xc6\x40\x43\x19\x62\x0c\x3d\x8a\x36\xe4\x52\xf0\x49\x1c\x05\xe8\xb1\x1e\x11\x1c\xa2\x61\x80\x4b\x5a\x32\xa4\x34\xc0\x28\x2c\x6b\x07\x8e\x3d\x46\x22\x0e\xe3\x56\xaf\x2d\x24\x8c\x39\x0a\x6a\xb6\x26\xe3\xa0\x42\x4b\x4e\xfa\x2b\xbc\x3a\xe9\x0b\x76\x2b\x72\x9e\x44\x6e\x48\xdd\x19\xe2\xde\x44\x16\x00\x8b\xf7\xdd\xba\x38\x58\x12\x52\x07\xc4\x5a\xd7\xe1\xd6\x47\x1c\x2d\xcb\x32\x7a\xbf\xb8\x16\x94\xd2\x3e\xbf\xb1\xcf\xa6\x18\x47\x69\xc9\x9c\xc7\x8e\x6d\x71\x86\xc2\x38\x00\x4a\x00\xac\x53\xfb\x11\x83\x48\xfc\x07\x4a\x58\xde\x9c\x31\x1c\x72\x2b\x95\xc4\xb5\xf2\x27\xfd\x95\xba\x8a\xeb\xf5\x31\xf2\x38\xb9\x03\xb9\x57\xab\xfd\xd3\xb2\x5c\x79\xdd\x27\xfd\x45\xff\x35\x51\x10\xd0\xb1\x1b\xe0\x3b\x1c\xc8\x62\x20\x7d\xb9\x36\x00\x04\x95\xe7\xe2\xfe\xcf\x6f\x37\x3f\x2e\x2f\xae\x3e\x5f\x5d\x7e\x52\x63\xc3\x79\x10\xe8\x70\xfd\xf6\xfa\xfc\xe2\x52\xad\xa6\x5b\x06\x9a\x5f\xa7\xae\x4f\x97\x1f\x7f\x7e\x51\x05\xd7\x70\x3e\xd6\xa9\xeb\xea\xdb\xe7\xef\x6a\x55\x5d\x85\x23\xaa\x53\xd3\xaf\xf3\xeb\x6f\x57\xdf\x14\xfb\xf5\x0b\xb1\x10\x66\x5e\x9d\xfa\x2e\xaf\xaf\xbf\x5f\xab\xd5\x76\xc9\x18\x65\x3a\x75\x5d\x5c\x5f\xdd\x5e\x5d\x9c\x7f\x55\xab\xee\x82\x11\x4e\x3c\x14\xec\x4c\x13\x24\x7a\x86\xe0\x56\x1b\x03\x0a\xd3\xde\x2d\x7e\xe0\x1f\xe7\xa3\x11\x58\x4e\x69\x3f\x39\xdc\x0f\xd3\xfb\xb4\xa3\x8f\xae\xb0\xab\x5c\x61\x07\x2c\x3a\x1d\x93\x71\x88\x02\x2b\x69\xde\xa9\x2d\xde\xf0\x6d\x0b\x7e\xfd\x00\xb3\x53\x9b\x86\x6e\x39\x1d\x77\x59\x26\xbe\x07\xcb\x0a\x43\xc5\x21\xcd\x6c\xb2\x7a\xcd\x7d\x9a\x48\xea\x37\x79\x8b\xd6\xee\x9a\xdd\x5c\xa3\x9b\x6a\xf2\x2f\x12\xfa\xf4\x5e\x34\x37\x62\x18\x2a\x00\x8b\x1d\xc7\xee\xbd\xf8\x3f\x6b\x20\xd4\x19\x61\xc6\x1f\x17\x4d\x44\xa1\x3b\xa2\xde\x1c\xa4\xe3\x33\x0a\x62\x50\xd4\x8b\x17\xf2\xdf\xe7\x84\x07\x38\x5f\x8c\x69\x38\x22\xe3\x39\x78\x02\x60\xf1\x6d\x91\x59\x1b\x17\x1f\x07\x98\xe3\x1e\xcc\x94\x21\x5f\x1f\x1c\xd1\x56\x57\xbc\x90\x37\x04\x89\x28\x4d\x48\xe0\x2f\x05\x6b\x6b\x18\x3e\xd2\x07\x31\x06\x43\xfa\x30\xb0\x57\xb4\xd0\x46\x4f\xee\x48\x4c\xa0\xf5\xc9\x04\x35\xdf\xea\xb6\xce\x50\xe5\x95\x99\x21\x36\x26\x21\xd8\x21\x23\x40\xc8\xb1\x42\x09\x46\xc6\x13\xc5\x22\x9c\x46\x6a\x05\x86\x94\x73\x3a\x93\x2c\x43\x19\x01\x6e\xa5\xcc\xb5\xcf\xc0\xae\xcf\x54\x76\x75\xc1\x38\x42\x1e\xcc\x5d\x45\xd5\xac\x71\x33\x9f\xa3\xc9\x54\xfb\x11\x65\x82\x48\xe0\x66\x88\xd8\x96\x79\xa7\xcd\xe6\xbc\x42\x00\x3a\x37\x02\x4f\x35\xc5\xb7\x5a\x51\x19\x94\x3c\x75\xdc\x4a\x7d\x63\x90\x8c\x5e\x7a\x0b\x35\x7b\xc9\x08\xbb\x88\x61\xb4\xd1\xb1\x5c\xac\xcf\x81\x83\xe1\x12\xf1\xd9\xd8\xf4\x56\x88\x38\x5b\x54\xf4\x9b\x5c\xca\xdd\xb7\x2a\xa5\x60\x72\xa4\x73\xee\xc6\xfc\x31\x19\x5e\x1c\xfa\xa5\x85\xb7\x20\xf2\x44\x36\x00\xdb\x72\x42\x03\x1f\xb3\x7e\x4e\xc1\x7e\x6e\xc9\x55\x2d\xba\x4e\x0d\x79\x53\xe8\x4b\x75\xf3\xf1\x43\x04\x3a\x4b\x63\xb4\x46\x24\x08\x34\x8a\x45\x34\x26\x42\xf0\xde\x14\x17\x83\x27\x79\xed\xcf\x1d\x84\x02\xf0\x79\x14\xfe\x08\xb9\x34\xfa\x36\x71\xb7\x5a\x7e\xd7\xc0\x9b\x2c\x98\x50\x26\x9c\xa5\x88\x75\xde\x6a\xa2\x6e\xdb\xf2\x44\x43\xd0\x43\xf9\xea\xc9\x0d\xd2\x87\x79\x84\x34\x95\x55\x13\xa3\x99\x2f\x94\x69\x2f\xf2\x9c\x45\x0b\xa8\xc2\x54\x61\x41\xaf\x84\x83\x0b\x93\x76\x6c\x0d\x31\x0e\xad\x18\x73\x6b\xc4\xe0\x19\x9f\x60\x30\x82\x67\x33\x60\x8a\x15\x90\x10\x1f\x58\xb7\xf0\xcf\x53\x89\xe4\xc5\x09\xd8\x24\xd6\x3d\x08\x01\x94\xbf\x4b\x82\x25\x16\x06\x43\xc8\xe3\x07\xaa\x6d\xbd\x67\x28\x92\x19\xa5\x22\x59\x2f\x97\xf7\x5a\x32\x5f\x2c\xf7\x3a\x4c\x95\x12\xfd\x12\xf1\x37\x7a\xb0\x78\x10\xf2\x06\x20\xbf\xf3\x5a\x1d\x57\x07\x81\x72\x7f\x73\xfa\xba\xd5\x4f\x19\x0b\xeb\x1b\xe5\x78\x48\xe9\x54\xe8\xb0\x30\xbb\x73\x76\x61\x5a\xad\x68\x29\x95\x62\x52\xea\x7e\xab\x81\x52\x85\xf2\x85\xa3\xd4\xaf\x38\x92\x98\xdc\xa4\x75\x79\x93\xb3\xa2\xb4\x95\xae\x33\xcd\x7d\x66\x50\x87\x18\x83\x51\x72\xe9\x74\x61\x56\xab\xf6\xc0\x24\x4a\x97\x79\x63\x12\xc5\x8b\x3d\x33\x89\xc2\xa5\x5e\x5a\xe5\x7c\xee\x3e\xa0\x00\x5c\xef\xca\xe9\x23\xc7\x4e\x9a\xa0\xc4\x15\x4f\x22\x56\xf6\x19\x09\x2b\x8b\x17\x02\x29\x1f\x4c\x5f\x18\xf1\x05\x96\xc6\x70\x55\x04\xa5\xda\x70\x6a\x02\x52\xf5\x61\xd5\x00\xb4\xaa\xe0\x35\xa8\x41\x40\x1a\x62\x79\x34\x18\x80\xa4\xdc\xc7\xaf\xe4\x47\x1a\xfa\x54\x25\x52\x8a\xb7\x4a\x3b\x3d\x15\x8e\xb7\x76\x59\xf9\x06\x90\xd7\x14\xfa\x72\x67\xc4\x4c\xb0\x2b\xbc\x67\x3d\x8b\xff\x86\x23\xc6\xc1\x3f\xf4\x61\xfe\xe0\x94\x89\x95\xfd\x18\xc7\x71\x4e\x6c\xaf\xa0\xae\x12\xcb\x5b\xce\x02\x2f\x6c\x35\xc8\x9c\x8b\x38\x47\xc9\xf2\xee\x1b\xdd\xbe\x83\xd0\xa8\x12\x29\x31\xb1\x2b\x4c\xed\x7a\xb0\xbd\x81\xd1\x87\x66\xa6\xb8\x8d\xd3\x6b\x97\xcd\x41\x5e\x12\x1e\xcd\xa3\x17\xc6\x70\x1d\x32\x0b\x08\xa7\x3d\x91\x26\xb2\x16\x4a\x86\xa2\x1c\xf7\xc0\xb7\x5c\x8f\x23\x6f\x0f\x93\x9b\xbe\xe9\xa6\x6f\xe6\x44\x95\x5f\x14\xc4\xce\x2b\x00\xf1\xa6\xee\x1d\x18\xdd\x5b\xa4\x7b\x27\xf4\x7e\x3d\x3f\xc3\x12\xa9\x25\xf8\x81\x5b\x9c\x5a\xc4\xdb\x47\x1d\xec\x74\x4e\x07\xc7\xc0\xa7\x6c\x09\x2f\xe9\x8e\xd1\xc3\xc5\x7a\x78\x63\xa8\xf6\x5e\x17\x3b\xdd\xd2\xc5\x87\x46\x17\x17\xe8\xe2\xaf\xdf\xbf\x88\xf0\xf5\xfe\x29\xdc\x41\xb7\x30\xfa\xce\x60\xb4\x68\x75\x66\x0e\x46\x81\x48\x1a\xc9\xcc\x85\x21\x8a\xb1\x6f\xd1\x30\x59\x8f\x89\xe6\x1c\x33\xeb\xea\x47\xbc\x7f\x08\x3e\xec\x9c\xc9\x20\x12\xbc\x5d\x04\x1c\xcb\xd2\x7c\x8c\xd5\x90\x63\x35\xe4\x0c\xd3\x7e\xda\x09\x87\xdd\xd2\xc1\x39\x99\xf5\x46\x1d\x6f\xe5\xea\x4e\x50\xea\xa6\xf9\xe0\xa9\x8d\x92\x25\xee\x74\xb8\xf6\x4f\xfb\x1e\xb5\x1b\xbb\x17\x74\x36\xa4\xcb\x35\x3b\x2f\xb9\x1b\xd2\x87\xc2\xb4\xb1\x6e\xe1\x57\x36\xf7\xa5\x34\xf6\x4f\xfd\x64\x14\x72\x36\x4c\xe8\x52\x24\xbe\x2b\x42\xf9\x2a\x08\xab\x4e\x98\xdd\xe6\x5d\x49\x8a\x6c\x49\x4d\x95\x20\x2a\x00\x12\x0e\x82\x6b\xd0\x14\x98\x61\x96\x64\x05\x67\x80\x82\x7f\x59\xf6\x6f\x92\xd1\xeb\x54\x55\x0f\xa4\x41\x72\x18\x19\x82\x3d\x16\x57\xbd\xba\xfa\xf2\x42\xf4\x92\xaa\x93\x59\x67\xf9\xa0\xb2\xbe\xbe\x6c\x85\x95\x02\xd8\xad\x59\x75\xd0\x49\xcd\xb4\x2f\x73\xeb\x4e\x74\xd3\xca\xe0\xb4\x55\x3b\xad\x34\xb1\x85\xfa\x69\x60\xf4\x53\x6b\xf4\xd3\x51\xe7\xfc\x56\x6f\x82\xbd\xa9\x3b\x8f\x7c\x70\xd7\x62\xe3\xb2\x16\x07\xba\xd7\x06\x4a\x43\x0d\x74\x09\xc6\xc7\xdd\x72\x5e\xdf\x1b\x8f\xb5\xc0\x63\xbd\x48\x40\x6b\x8d\x28\xb3\x32\xe0\xee\x9f\xb3\xfa\x6c\x58\x2d\x1f\x94\xd2\xc2\xd9\xa6\x0c\xb1\x45\xb4\x6c\xd7\x80\x0c\xda\x8f\xba\x96\x50\x07\x56\xa3\xaf\x99\x3f\x56\x8c\x6c\x71\x34\x89\x84\x43\xab\xcd\x36\xb3\xc1\xa0\x6c\x7c\xea\x64\x17\x0f\x4c\x76\xb1\x5c\xf1\x97\xc9\x2e\x56\xf2\xf3\xb4\x12\xf3\x4b\xc5\xbd\xb5\x79\xcd\xab\xdb\x04\x8e\x4d\x56\x73\xcd\xac\xe6\xe3\x97\xca\x6a\x56\x0e\x63\xd4\x82\x78\x2e\x4e\x77\x9e\x0e\x7d\xe3\x31\x9a\x9c\xf0\xb4\x7a\x70\x40\x9c\xfd\x27\x36\xe2\x3b\x5d\xf6\xf5\x9a\x08\x44\xdd\x35\x41\x44\x51\xe3\xd4\x0d\x08\xdd\x32\x8c\xff\x24\x38\x63\x28\x87\xbb\x3b\xb8\xdb\x38\x05\x65\xe7\x5c\x6d\x90\xb3\x52\x11\xc2\xac\x7b\x75\x68\x4e\x30\xf2\x31\x8b\xdd\x65\x7f\x95\x5c\xb6\x5c\x9b\x4e\x2c\x64\xc7\x18\xb1\xc4\x0b\x51\xa7\xb7\x16\x7a\x60\xf4\xbe\xb7\x38\x2c\x64\xf3\x50\x91\x0d\x2e\xbb\xc9\xae\x8c\x95\x77\x15\xe2\x90\x2b\xae\xc8\xe6\xfe\xf0\x18\x07\xd8\x13\xc6\x62\x25\x89\x22\x64\xde\x2c\x69\xe4\xc2\xd3\x55\xab\x43\xee\xc4\x95\xc2\x3a\xb4\x02\xb5\xf2\xde\xaf\x74\xf4\x52\x45\xe0\xcb\x85\xfe\x42\x84\xb8\xd7\xc6\x56\x84\xbd\x1d\xd9\x01\x95\x3e\xf1\xe5\xc7\x32\x37\x59\x05\xd3\x6a\x3d\xad\x13\xf3\x3e\x94\xe3\xa4\x72\xec\xbb\xc1\x18\xb8\x7a\x2c\x5c\x19\x55\x3b\x80\xaa\x1c\xc1\x17\x8d\xda\x2b\x79\xd3\xf5\xbd\xea\xda\xde\xf5\x4b\x47\x4b\x57\xdd\x96\x4e\x6f\x8b\xbb\xd3\x0a\xf1\xd7\xdd\x46\xdd\x9c\x21\x5f\xc7\xf2\x13\xc7\x01\x65\x7c\x4c\xaf\x17\x47\x8c\xf9\xbe\x86\xdd\x97\xc5\x20\xc7\x7c\xda\x03\x02\x75\xec\xa0\x36\x5a\x90\x0c\x7b\x98\xdc\x81\x35\xe0\xe3\x11\x9a\x07\xbc\x3e\xc5\x79\x9c\xec\x6c\xa0\xde\x54\x83\xd4\xba\x15\x13\x10\x6f\xba\x69\xc5\x6c\x32\xd4\x5d\xbe\xa5\x64\xbb\xc8\x4e\x06\x72\x6a\xb7\x31\xd5\xdb\x8c\xfa\x6d\x44\x05\x4b\xaa\x61\xe9\xf9\xb2\x71\x69\xc6\x3e\xe1\xf5\xc4\x39\xa1\xd0\x0a\x79\x8e\x71\x98\x70\xe7\xae\x09\x67\xcb\xe8\x86\x27\x78\x18\xe5\xa0\xaa\x1c\x9c\x3d\x51\x0e\x0c\xcf\xe8\x1d\xae\xa7\x1e\xc4\xf1\x9b\x46\x41\xec\xa9\x82\x10\x10\x31\x2a\x42\x55\x45\x0c\x5e\x42\x45\xbc\x22\x17\xd9\x79\x6d\x49\x1a\xc7\x26\x49\x23\x0b\x23\x4a\x45\xee\x4d\x92\x46\xa5\x8c\x34\x75\x0a\x64\xd9\xa9\xb1\x02\xfb\xc0\x41\x89\x23\x62\x37\x11\xef\xb4\xf5\xe0\xbc\x1a\x39\x44\xf5\xcf\xd1\x84\xea\x5c\xa9\x23\x31\xd5\xcf\xf8\x55\x3b\xd7\xd0\x31\xe7\x1a\xc2\xe5\x91\xc9\x3c\x32\x99\x47\xdd\xcb\x3c\xaa\x4e\xe7\x18\xbc\x90\xbd\xa1\x4b\xc2\xa4\x23\xb5\x28\x1d\x49\x3d\x89\x45\x67\x1d\xaa\x20\x79\x65\xe5\x63\x7e\x5d\xcc\x45\xda\xca\x56\x49\xfa\xa3\x4b\x8c\x61\xca\x7c\x30\x49\x6a\x77\xae\x4e\x76\xca\x76\x9c\x26\x21\xa2\xb1\xc3\x6e\x2b\x69\x9f\x06\x9c\x44\x4b\x42\x87\x6a\x6b\x66\x35\x52\x56\x94\xd2\x55\x7a\x4b\xba\x95\x49\x06\x3b\x0d\x03\x56\xa7\x7d\x1c\x6a\x84\x02\x63\xf2\xbf\x74\xc1\x32\x39\x80\x03\xae\x6b\x85\x4f\x8a\xd3\x47\x2e\xc5\xd7\x2e\x3f\xd4\x0a\xe9\x25\x31\x2c\xd4\x4c\xa4\x91\x32\xee\x2e\x4f\x3c\x6d\x88\x5e\x76\xb4\x2d\xf1\x95\xa2\x47\xf5\x52\x81\xd6\x92\x63\xe8\x78\x1c\xe0\x9c\xf4\x98\xf4\x7f\x47\x2b\xc7\x4a\x94\xdd\x08\x30\x82\x42\x0b\x92\x91\x23\xcb\xe3\x5b\x7c\x77\xf9\xe2\x0e\x93\xab\x34\x52\x77\xb6\xd2\x76\xd2\x3c\x39\x9c\x72\x48\x29\x71\x47\x2d\x69\xe7\xb9\x73\x6b\x76\xa9\x54\x06\x76\x93\x8a\xe0\xfc\x09\x3b\xca\xd9\x64\x1d\x56\x08\xce\x8b\x28\x84\xc2\x6c\xb9\xb7\x92\xa2\x59\x5b\xdc\xf4\xb2\xe4\x5e\xaf\xb0\x25\xba\xd5\x5d\x7e\x8e\xb9\xb5\xd3\xf9\xd5\xa2\x85\xaf\x65\x42\x3f\x6a\xdf\x84\xae\x0c\x95\xbc\x7e\x66\x69\xe3\x48\x2f\x05\xfe\xa5\x26\xef\x23\xa3\x4f\x54\xf4\x49\xf6\x65\xf6\x46\xe7\xf1\x3f\x05\xcd\x57\x22\xfe\xc7\xed\x9a\xbe\xd7\xb8\xfa\xac\x33\xf9\xf1\x5e\x49\x5e\x35\xa1\x8e\x2c\x2e\x3b\x87\x66\x75\xf9\x29\xca\xd0\xff\x44\xe2\xf4\xc2\x5a\xf1\x54\xcd\x9a\xb3\xd4\x9a\x73\x0b\xbf\x3c\x28\xb5\x20\xae\xf1\x45\xd4\x5a\x6b\xdb\x47\xdd\x5b\xdc\x3e\x97\x12\x86\x67\xe2\x57\x8b\x17\xc6\xff\xb5\x3f\x0b\xe3\x9b\x2b\x4b\x74\x46\xc7\x38\xc4\x54\x66\x8d\xa3\xce\xaa\xfa\xa1\x59\x55\x6f\xf3\xaa\x7a\xd7\xd7\xb6\x8f\xcc\xda\xb6\x59\xdb\x6e\xf9\xda\x36\xb8\x4b\x2b\x6b\xdb\x70\xb7\xdc\x95\xfe\x98\xed\x44\x77\x01\xd4\x24\xe2\x1d\x5e\xea\x8e\xc8\x03\x0e\x62\x17\x0d\x93\x14\xf3\xe4\x3b\xe0\xb1\xca\x22\x6e\x3e\x31\xd0\x4c\xc0\x9e\xba\xc4\xd2\x53\xe5\x04\x60\x6b\x50\x49\xc5\xad\x3e\x99\xe1\x7c\x34\xc2\xcc\x3e\x4b\x50\x20\xae\xf3\x70\xa0\x4b\x1d\x79\x1e\x8e\x78\xec\xa6\x86\xac\x42\x08\x6f\x7f\x9c\xdf\xb7\xc6\xf9\x85\xd6\x7c\xc4\x23\xca\x16\x5f\x85\xc9\x3e\x11\x23\x90\x65\xfd\xfd\xf7\x80\xff\x81\xac\x09\xc3\x23\x50\xc1\x9c\x47\xf1\xbf\xfb\xfd\x31\xe1\x93\xf9\xf0\xc0\xa3\xb3\x3e\x9d\x26\x9b\x80\xfa\x69\x51\x51\x92\xf5\xef\xc9\x94\xf4\x6f\xd2\xf2\xb1\xfd\xfb\x98\xff\x31\xc1\x41\x94\xd0\xe9\xa3\xe4\xee\x1f\x3a\x8d\x4f\xf6\xbd\x80\x34\x4d\x93\x0f\x59\xca\x8d\xbf\x71\xc9\x5b\x77\x56\xdf\xb1\xb1\xed\xdb\x6c\xdb\x9b\x8c\x59\x5d\xaf\xe2\xd0\x78\x15\xc6\xab\xe8\xb0\x57\xe1\xe3\xc5\x21\x60\xc6\xb3\x78\xed\x9e\xc5\x16\x16\x8c\x77\xa1\xeb\x5d\xbc\x33\xde\x05\xb4\xe6\x7b\x68\x3d\x61\x2a\x73\x32\x8c\x77\x61\x36\x99\x36\xee\x5d\x38\xc6\xbd\x30\xee\xc5\x5e\xba\x17\x8e\xf1\x2f\x8c\x7f\xd1\x61\xff\xc2\x78\x17\xc6\xbb\xc8\x45\x82\xf1\x2d\xb4\x7d\x0b\xc7\x38\x17\xc2\xb9\x30\xae\x85\x71\x2d\x9e\x35\x97\x70\xd0\x9a\x5c\xc2\x4c\x8b\xc6\xdd\xcb\x28\xcc\xa4\xec\x79\x92\x09\x07\x6d\x4d\x26\x8c\x02\xe4\xe1\x09\x0d\x7c\x50\x3e\x8d\x20\x46\x89\x60\xde\x10\xe7\x0f\xaf\x96\x7e\x50\xd7\x0b\xca\xfa\x20\x87\x05\x5b\xfd\xdc\x1e\xfa\xf2\x1c\xce\xcd\xb5\x1c\x2d\x41\xd2\x15\x22\x9d\xa4\xd3\x77\x6d\x95\x7e\x69\xdf\x47\xef\x28\x74\x95\x58\x51\xce\x39\xa8\xd0\x38\xca\xb8\xad\x10\x41\xc8\xdf\x38\x90\x92\x51\x0e\x67\x34\x14\x82\xd2\x29\xae\x77\x28\x69\xad\x6f\xe4\xca\x1f\x3d\x2a\xb8\xa2\x70\xe8\xa8\xc9\xd6\x68\x2a\x9e\x9a\x23\x23\x64\xd6\x88\x8c\x5c\xcd\x5e\x89\x8c\x48\xc3\x5c\x0c\xec\xeb\x84\x79\x0b\x6d\x7b\xe9\x31\xd1\x1b\x0f\x2d\x91\xdf\xf5\x5e\x95\xf7\xad\x35\x1b\xe4\x3e\x62\xdd\x29\x6b\xc3\x51\xd2\xa1\xe9\xe9\xea\x5e\x40\xd5\xe5\x73\x7f\xec\x0a\x89\x4f\x98\xd7\x3c\x1b\x5d\x5e\x5b\xa7\xac\x30\x36\xc9\x6b\x57\xd6\x8d\xec\x21\xed\x90\xd3\x3d\xd0\x75\xba\xd7\xfb\xb8\xf2\xf0\xe9\xc1\x49\x3f\x3d\x82\x6f\x84\x3c\x7c\xf6\xdb\xff\x01\xdc\x72\x9b\xa4\x47\xc2\x00\x00")
+
+func assetsConfigGladeBytes() ([]byte, error) {
+	return bindataRead(
+		_assetsConfigGlade,
+		"assets/config.glade",
+	)
+}
+
+func assetsConfigGlade() (*asset, error) {
+	bytes, err := assetsConfigGladeBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "assets/config.glade", size: 49735, mode: os.FileMode(436), modTime: time.Unix(1792416278, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _assetsConfigGlade2 = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xec\x5d\x5d\x73\xdb\xba\xd1\xbe\x3f\xbf\x82\xaf\x2e\xce\xbc\x9d\x8e\xac\xe3\xe4\x9c\x34\xed\xb1\x9d\x49\x1c\x27\xe3\x69\x26\xc9\xd8\x4e\x73\xc9\x81\x48\x48\x42\x45\x11\x2c\x08\xf9\xa3\xbf\xbe\x4b\x80\xfa\x24\x00\x02\xa0\x6c\x53\x16\xaf\x6c\x8a\xd8\x05\xb0\x78\x76\xb1\xbb\x5c\x82\x27\xef\xee\x67\x49\x70\x8b\x59\x4e\x68\x7a\xda\x3b\x3e\xfa\xad\x17\xe0\x34\xa2\x31\x49\xc7\xa7\xbd\x1f\x37\x9f\xfa\x6f\x7b\xef\xce\x7e\x39\xf9\xbf\x7e\x3f\xf8\x8c\x53\xcc\x10\xc7\x71\x70\x47\xf8\x24\x18\x27\x28\xc6\xc1\xeb\xa3\xe3\xb7\x47\xaf\x83\x7e\x1f\x1a\x91\x94\x63\x36\x42\x11\x0e\x62\x3a\x43\x04\xf8\x65\x8c\xde\x3f\x44\x13\x94\x8e\x31\xeb\x9d\xfd\x12\x04\x27\x0c\xff\x67\x4e\x18\xce\x83\x84\x0c\x4f\x7b\x63\x3e\xfd\x6b\x6f\xd5\x3d\x30\x7b\xd5\x1b\x88\x76\x74\xf8\x6f\x1c\xf1\x20\x4a\x50\x9e\x9f\xf6\x3e\xf3\xe9\x17\x92\xf3\x6b\x4e\x19\xee\x05\x24\x3e\xed\x25\x70\x99\x17\x97\x21\xca\xb2\x5c\xf0\x06\xaa\x88\x26\xf3\x59\x9a\xcb\x2b\xb8\x2e\x86\x2d\x7f\xeb\xa7\x68\x86\x83\xf9\x9c\xc4\x62\xac\xe5\x7d\x79\x2f\xe0\x0f\x19\x86\xc1\xc0\x40\x19\x62\x0c\x3d\xc8\x31\x28\x39\xc4\x24\xcf\x12\xf4\xd0\x8c\x09\x4e\xd1\x30\xc1\x86\x91\x0c\x29\x4d\x30\x4a\x4d\xe3\xc0\x79\xc4\x48\xc6\x41\x6e\x0e\x63\x39\x19\xac\x49\xe8\x64\x20\x85\xec\x28\x6f\x92\x85\x29\x0d\x67\x88\x47\x13\x5b\xb1\x2f\xda\x87\x4d\xa5\xbf\x64\xe4\xbe\x0c\x1b\x53\x87\xcb\x18\x71\xb4\xa4\x65\xf4\x6e\xf1\xbf\xe4\x24\xe6\xfc\x5b\xef\x6c\x8a\x71\x26\x28\x15\xb7\x8f\x7b\x01\x67\x28\xcd\x13\xe0\x04\xcb\x79\xda\x7b\xc0\x00\xc4\x7f\x02\x45\x10\xcd\x19\xc3\x29\x0f\x04\xfe\x37\xe8\x4f\x06\x6b\x7d\xe9\xfb\x8d\x31\x8a\x38\xb9\x05\x6d\x73\xeb\xfd\xe3\x92\xce\xdc\xf7\xc9\x60\x31\x7f\x4f\x14\x24\x74\x1c\x26\xf8\x16\x27\xb6\x18\x10\x8d\x1b\x03\x40\x72\x79\xaa\xd5\xff\xf1\xf5\xfa\xfb\xc5\xf9\xe5\xa7\xcb\x8b\x8f\x6e\xcb\xf0\x3e\x49\x7c\x56\xfd\xe6\xea\xfd\xf9\x85\x5b\x4f\x37\x0c\xec\xad\x4f\x5f\x1f\x2f\x3e\xfc\xf8\xec\x0a\xae\xe1\x7c\xec\xd3\xd7\xe5\xd7\x4f\xdf\xdc\xba\xba\x4c\x47\xd4\xa7\xa7\x9f\xef\xaf\xbe\x5e\x7e\x75\x9c\xd7\x4f\xc4\x52\xd8\xef\x7c\xfa\xbb\xb8\xba\xfa\x76\xe5\xd6\xdb\x05\x63\x94\xf9\xf4\x75\x7e\x75\x79\x73\x79\xfe\xfe\x8b\x5b\x77\xe7\x8c\x70\x12\x21\x2d\x1e\x1b\x5b\x82\xc2\xce\x10\xdc\xea\x2d\xd8\x61\xdb\xbb\xc1\xf7\xfc\xc3\x7c\x34\x02\x7f\x45\xcc\x93\xc3\xf5\x50\x5c\x8b\x89\x3e\x84\xd2\x9b\x09\xe5\xee\xbb\x98\x74\x4e\xc6\x29\x4a\x82\x62\x78\xa7\x3d\xd9\x22\xee\x05\xf0\x37\x4e\x30\x3b\xed\xd1\x34\x34\xf3\x09\x97\x34\xf9\x1d\xf8\x33\x18\x3a\x4e\x69\xe9\x09\x35\x1b\xee\x6a\x23\x69\x3e\xe4\x0a\x2f\xef\x61\xff\x24\x69\x4c\xef\xe4\x90\x33\x86\xa1\x0b\x70\x3a\x71\x1e\xde\xc9\xdf\xcb\x21\x42\xaf\x19\x66\xfc\x61\x31\x48\x94\x86\x23\x1a\xcd\x01\x6a\x9f\x50\x92\x83\xd5\x5b\x34\x50\xb7\xe7\x84\x27\x58\xad\x13\x34\x1d\x91\xf1\x1c\x9c\x59\x70\x9f\x2a\x6c\x36\x24\x13\xe3\x04\x73\xdc\x87\x6d\x27\xe5\x9b\xe2\x91\x63\x0d\x65\x03\x95\x08\x0a\x5c\x4e\x48\x12\x2f\x51\x5a\x11\xc3\x07\x7a\x2f\x65\x30\xa4\xf7\xaf\x7a\x6b\x2a\xbd\x35\x93\x5b\x92\x13\x18\x7d\x61\xed\xe7\x95\x69\xfb\x88\x4a\x45\x33\x43\x6c\x4c\x52\xd8\xd4\x47\x80\x91\x3f\x1c\x28\x18\x19\x4f\x1c\x49\x38\xcd\xdc\x08\x86\x94\x73\x3a\xb3\xa4\xa1\x8c\xc0\x6a\x89\xc5\xed\x9d\x41\x70\x51\xda\xbf\x7a\xc2\x3c\x43\x11\x6c\x04\xba\x6e\x36\x56\x53\xbd\xa2\xc5\xbe\xf5\x01\x95\xca\x48\xe0\x62\x88\x58\xc5\x57\xf2\x5e\x66\x15\x11\x80\x2e\xcc\x20\xd8\x12\xf8\x76\x23\xb5\x41\xc9\x6a\xe2\x81\x08\xef\x40\x33\xfa\xe2\x12\x7a\x8e\x0a\x09\x87\x88\x61\xb4\x35\x31\x25\xd6\xe7\xb0\x82\xe9\x12\xf1\xa5\x6c\xfa\x6b\x4c\x8e\x2b\x5c\xfc\x87\x6c\x5c\xdd\x37\x2e\x54\xb0\xd3\xd0\x39\x0f\x73\xfe\x50\x88\x17\xa7\xb1\x91\xb8\x02\x91\x15\xdb\x04\x1c\xb5\x09\x4d\x62\xcc\x06\x0a\xc2\x81\x92\x72\xdd\x8a\x6e\x72\x43\xd1\x14\xe6\x52\x3f\x7c\x7c\x9f\x81\xcd\xf2\x90\xd6\x88\x24\x89\x07\x59\x46\x73\x22\x15\xef\x37\x3d\x19\xdc\x51\x8d\x5f\x29\x04\x0d\xf8\x22\x0a\x3f\xa4\xdc\x1a\x7d\xdb\xb8\x5b\xa7\x7f\x6c\xe0\x4d\x16\x8b\x60\x52\x4e\x15\xe1\x12\xb1\xc7\x66\xc8\xea\x51\x57\x75\xe3\xd0\x10\xec\x90\xda\x3c\x85\x89\xb8\xa9\x62\xe4\x69\xac\x76\x21\x4d\x15\xbd\x1c\xa8\x32\xf2\x82\xd8\x1b\xfc\xd2\x59\x00\xb3\x92\xd1\x22\x6c\xda\x79\x30\xc4\x38\x0d\x72\xcc\x83\x11\x83\x7b\x7c\x82\xc1\xa3\x9c\xcd\x60\x51\x82\x84\xa4\xf8\x28\xb8\x81\x5f\x56\x14\x45\xc3\x09\xf8\x24\xc1\x1d\x28\x01\xd0\xdf\x16\x99\x87\x00\x83\x2b\x14\xf1\x23\xd7\xb1\xde\x31\x94\xd9\x48\x49\xa7\xeb\x92\xa7\x4e\xdf\x55\x3d\x5a\xeb\xbc\x8a\x58\xea\xbd\xcf\xa2\x5a\xa9\xbe\x9c\xa9\xde\x7c\x75\x76\x50\x29\x04\x95\x00\xd4\x93\xf7\x9a\xb8\x3b\x08\x9c\xe7\xab\x98\x6b\x65\x9e\x36\x1e\xd6\x57\xca\xf1\x90\xd2\xa9\xb4\x61\x69\x79\xb5\x6d\xc2\x77\xe2\x5a\xad\x59\x29\x17\x32\x2b\x73\x5f\x19\xa0\x15\x91\x5a\x39\x8c\x71\xc5\xef\x16\x9b\x9b\xb5\x2d\xdf\xe5\xae\x68\xed\xa5\x9b\x67\xaf\x96\xc0\x27\x06\x7d\x48\x19\x8c\x8a\x7f\x55\x5b\x7c\x23\x49\x34\x95\x86\x8a\xbe\x3e\x02\xb3\xa0\x36\x45\x63\x16\xe4\xfa\xc8\xcc\x82\xd8\x18\xa5\x99\xe8\xc5\x7e\x1e\xde\xa3\x04\x42\xef\xda\xed\x43\xe1\x27\x4d\x50\x11\x8a\x17\xe9\x9f\xde\x19\xa9\x44\xf4\x15\x72\x2d\x90\xc4\xdd\x0a\x98\x3e\x33\x12\x4b\x2c\x8d\xe1\x3f\x1d\x94\x54\x03\x73\x82\x93\x8a\x81\x2b\xa4\x54\x3c\xdc\x60\x65\xe0\x60\x0b\x2d\x03\x0b\x01\xaf\x57\x0d\x18\x58\x43\x4c\xc5\x83\x01\x48\xcc\x31\x7e\x1d\x07\x99\x47\x74\x65\x62\xc4\x9b\x68\x61\xf2\xd3\x85\x72\xbc\x31\xa0\x4e\x35\x50\x67\xe4\x29\x67\xeb\x81\x3e\x15\x9f\x49\xa9\xd8\x35\xd1\xb3\x89\x85\xde\xe3\xbf\xe6\x88\x71\x88\x0f\x63\xd8\x3f\x38\x65\xf2\xe1\x74\x8e\xf3\x5c\x91\xdb\xd3\xf4\x65\xf0\xbc\x57\xe3\x31\x79\xe0\xda\x51\x83\xce\x85\x88\x73\x54\x3c\x2b\xad\x35\x6b\x3a\x2e\xa0\x34\xae\x4c\x0c\x2e\xf6\xaa\x49\x0d\x2c\x7d\x60\x7b\x0d\xd2\x87\x61\x0a\xdc\xe6\xe2\xff\x90\xcd\x41\x5f\x8a\x35\x9a\x67\xcf\x8c\xe1\x26\x6c\x16\x10\x16\x33\xb1\x66\xb2\x91\x4a\x06\x52\x8e\xfb\x10\x5b\x6e\xe6\x91\xab\x62\x0a\x45\xcb\x50\xb4\x54\x64\x95\x35\x7d\x3d\x0d\x88\x8f\x0f\x00\xc4\xdb\xb6\xf7\x55\x67\x7b\x75\xb6\x77\x42\xef\x36\x8b\x1d\x02\x59\xa7\x81\xef\x79\xc0\x69\x40\xa2\x97\x68\x83\x2d\x75\xa0\x45\x36\x38\x87\x75\x2a\x1f\xe2\x15\xd3\xe9\xec\xb0\xde\x0e\x6f\x89\xea\xc5\xdb\xe2\x96\x83\x79\xdb\x16\xbf\xee\x6c\xb1\xc6\x16\x7f\xf9\xf6\x59\xa6\xaf\x5f\x9e\xc1\xb5\x0a\x18\xdb\x83\xd1\xbf\x75\x18\xd5\x3d\x9d\x99\x83\x53\x20\x8b\x46\x4a\x77\x61\x88\x72\x1c\x07\x34\x2d\x9e\xc7\x64\x73\x8e\x59\x70\xf9\x3d\x7f\x79\x08\x7e\xdd\x6e\x04\x2b\x5c\x06\x59\xa3\x1c\x22\x58\xb1\xb2\xcc\xa7\xf3\x1a\x14\x5e\x83\x42\x4c\x2f\xd3\x4f\x68\x39\x82\xb7\x6d\xb0\xa2\x4c\xdd\x76\xd6\x87\x63\x8e\x7f\x4e\x90\x08\xd3\x62\x88\xd4\x46\xc5\x23\x6e\x21\xae\x97\x67\x7d\x7f\x6f\x37\x76\xcf\xe9\x6c\x48\x97\xcf\xec\xa2\xe2\x6a\x48\xef\xb5\x65\x63\x75\x33\x6f\x17\x7e\x6d\x6b\x5f\x4c\x4c\x66\x34\x2e\xa4\xa0\x78\xfb\xc0\x97\x23\x89\x43\x99\xca\x77\x41\x58\x7d\xc9\x6c\x75\xed\x0c\x25\xb2\x86\x9e\x6a\x41\x24\x5a\x55\x81\x84\x93\xe4\x0a\x2c\x05\x66\x98\x15\x95\xc1\x25\xa0\xe0\x57\x56\xfe\x5a\xd4\xf4\x1e\xd7\x75\x0f\xac\x41\x73\x18\x19\x82\x3f\x96\xd7\x35\x5d\x6f\xbc\x50\xbd\xa2\xeb\x62\xd7\x59\xde\xa8\xed\x6f\x60\xdb\x61\xad\x02\xee\xd7\xae\xda\xf2\xc8\x46\x63\x99\x5e\xca\xde\xfa\x28\xb6\x69\x4d\x38\x6d\xb5\x4e\x6b\x43\x6c\xa1\x7d\x7a\xd5\xd9\xa7\xd6\xd8\xa7\x96\x7b\x4e\x8a\xb8\x35\x9a\xe0\x68\x1a\xce\xb3\x18\xc2\xb5\xbc\x0b\x59\xf5\x89\xee\x0d\x41\x79\x98\x81\x7d\x82\xb1\x55\x99\x46\x7b\x82\xd7\xb7\x7b\xbd\xab\x3e\x66\xc4\x7a\x5e\x80\x36\x18\x51\x16\x94\xc0\x7d\x79\xc1\xea\x93\x61\xd5\x2c\x14\x23\x71\xf9\x52\x86\x7c\xdf\xd2\xf4\xd6\x80\x0d\xda\x55\x15\xaa\x4b\xea\xa6\x48\x7f\x8c\x82\x3a\xf0\x1a\x63\xcf\xfa\x31\x3d\xb2\xe5\xe9\x1a\x16\x01\xad\xf7\xb2\x75\x2f\x18\x98\xe4\xd3\xa4\xba\x58\x57\x20\xd2\x55\x17\xb7\xa2\xba\xd8\x29\xce\xf3\x2a\xcc\xd7\x11\xb7\xbb\xae\x79\xfd\x35\x81\x3f\xf6\xcd\x08\xb7\xae\xaa\xb9\x09\x83\x46\x55\xcd\xce\x69\x8c\x46\x10\x57\x31\x78\xfc\x72\xe8\xeb\x88\xd1\x24\xc1\xf1\xfa\xc1\x01\x79\xf9\x9b\x7c\x11\xdf\x54\x96\xaf\x9c\x74\x9b\x62\xbd\x5d\x24\xa2\x9c\x57\x51\xc5\xc4\xd1\xe2\x2c\xd9\x78\x26\x84\x6e\x18\xc6\xff\x22\xb8\x5c\x50\x0e\x57\xb7\x70\xb5\x75\xa4\x88\x93\x10\x7c\x56\x55\xc5\xc8\x73\x65\x55\xac\x2a\x19\xc2\x72\x7a\x4d\x78\x4e\x30\x8a\x31\xcb\xc3\xe5\x7c\x9d\x42\x36\x15\xc7\xf2\x41\x76\x8e\x11\x2b\xa2\x10\x77\x7e\x1b\xa9\x07\x46\xef\xfa\x8b\xc3\x42\xb6\x8f\x15\xd9\x5a\xe5\xb0\x78\x2b\x63\xad\xad\x43\x1e\x52\xf4\xab\x7e\x3f\x3c\xc7\x09\x8e\xa4\xb3\x58\xcb\x42\x87\xcc\xeb\x25\x0f\x25\x3c\x43\xb7\x3e\xac\x52\xb4\xfa\x3e\xbc\x12\xb5\x65\xbf\x16\xd1\xef\xa2\xa5\x95\x22\xdb\x2a\xbc\x68\xa9\x55\xfa\x73\x99\xe2\xde\x90\xad\x4c\x7b\xd7\xd9\xf2\x25\xef\xed\x30\x5a\x7b\xe2\x8b\xe4\xeb\x82\x67\xb7\x59\xaa\x67\x6a\x97\xef\x7e\x6d\xb7\x8a\xa2\x0b\x97\xbc\x77\x95\xc8\x3b\xff\xbd\x64\x65\x9d\x07\x5f\x51\xd8\x23\x65\xd7\x30\xb5\x63\xf8\xac\x19\x7b\xa7\x48\x5a\xc7\xc4\x3e\xa2\xd6\x71\xb0\x8e\xac\x57\x12\x79\x9e\x4c\xe9\x7a\xc8\xb2\xd7\xaf\xc4\xdd\x7a\xa5\xf7\xb7\x98\x38\xbf\x42\x6d\x62\xe6\xe6\xc4\x0b\x0e\x9e\x5e\x9f\x3c\x0a\xa8\x5c\x47\xf1\x7f\x59\x70\x8f\xe2\xd8\xc3\xe7\x2b\xf3\x8f\x63\x3e\xed\x03\x83\x26\x3e\x50\x1b\xbd\x47\x86\x23\x4c\x6e\xc1\x13\x88\xf1\x08\xcd\x13\xde\x9c\xe3\x3c\x2f\xde\x6a\xa0\xd1\xd4\x83\xd5\xa6\x07\x93\x90\x68\xba\xed\xc1\x6c\x2f\x68\xb8\x6c\xe5\xe4\xb7\xd8\x6e\x06\x76\x66\x57\x25\x06\x2f\xd3\xab\x62\xe4\x6e\x7e\x55\x5c\x9c\x4d\xb0\x60\x52\x6f\x86\x65\x33\x0b\x4d\xdd\xb9\x36\xe3\x98\xf0\x66\xea\x5c\x70\x68\x85\x3e\xe7\x38\x2d\x56\xe7\x76\x17\x81\x56\x67\x1b\x56\xf0\xe8\x8c\x83\x1d\x97\x95\x71\xb0\x7e\x1c\xde\x72\xe3\xc0\xf0\x8c\xde\xd6\xbd\x52\x20\x78\xea\xcd\x83\x3c\x7a\xb3\x33\x10\x7a\x56\x7b\x6d\x20\x24\x44\x3a\x13\x61\xc7\x65\x65\x22\x2c\x6b\x4a\x05\x93\x9d\x99\x88\x03\x0a\x91\x9f\xec\x8d\xd9\xb6\x14\x68\xec\xdd\xb3\xc1\x47\x29\xd0\xf8\x6e\x9b\xb5\xef\x0a\x34\x6a\x75\xc4\xbd\x40\x43\x2d\x1b\xd3\x89\xb1\x12\xfb\xb0\x82\x16\xc7\xc3\x6e\x23\xde\xe6\x44\xd8\x67\x39\x34\xaf\x41\xfd\x50\xf3\x33\x34\xa1\xbb\xd0\xea\x38\x4c\xcd\xf2\x1a\x56\xab\x7e\x89\xd6\x33\x7f\xad\x5d\x9e\x27\x3d\xd3\x50\x57\x37\xd7\x55\x1d\x75\x55\x47\x2d\xae\x3a\xaa\x2f\xe5\x30\x9d\xb7\xf4\x98\xfe\x86\x2f\x8b\xae\x14\xa9\x45\xa5\x48\xee\x05\x2c\x3e\xcf\xa1\x34\x85\x2b\x6b\xdf\xa2\xb3\x9e\x71\x8b\xea\x90\x2a\x95\x2a\xc5\x7c\x7c\x99\x31\x4c\x59\x0c\x2e\x49\xe3\xc9\x35\xa9\x4c\xa9\xe6\x69\x0a\x26\x1e\x6f\xd7\x6d\xbb\x42\x94\x26\x9c\x64\x4b\x46\x96\xa7\x23\x04\xcd\xcb\x55\x9c\x4a\x55\xfa\x4b\xbe\xb5\x45\x06\x8f\x9a\x06\xac\x2f\xf9\xa8\x3b\xd7\x49\xf0\xdd\x5e\x4c\xf2\x5f\xf1\xc0\xb2\x38\x7c\x03\xfe\x6f\x94\x3e\xd1\x97\x8e\x5c\xc8\x8f\x35\xbe\x6b\x94\xd2\x2b\x72\x58\xee\x9a\xa0\x9c\x34\x65\x3c\x5c\x9e\x76\xba\x23\x7e\xe5\xb1\xb6\x24\x76\xca\x1e\xd9\xe2\x41\xb4\x34\x17\xc7\xd0\xf1\x38\xc1\x8a\xf2\x18\xf1\xbb\x75\x39\xd0\x46\x82\x51\xd2\x6e\x25\x18\xc1\xa0\x25\x85\xe4\xc8\xf2\xe8\x96\x38\x5c\x36\x7c\xc4\xc2\xaa\xc0\xbd\x74\xa7\x52\xb6\x23\x6a\xe4\xb0\x58\x21\xa7\xc2\x1d\xb7\xa2\x9d\xa7\xae\xad\x79\x4c\xa3\x52\x77\x70\xa7\xe0\x6b\x6d\x08\xde\xaf\xb0\x23\xda\x1e\x88\x41\x70\x78\xe2\xb4\x43\x83\xa0\xad\x96\x7b\x63\xa9\x9a\x8d\xd5\xcd\xaf\x4a\xae\xdd\xca\x56\xcf\x68\x4f\x52\xd2\xc7\x26\x7f\xe1\x70\x72\xd2\xa5\x6f\x32\xf8\x48\x72\xf9\x45\xe9\xb5\xfd\xad\xcb\x54\x1b\x28\x5b\xfd\xad\x22\xab\x34\xba\x7b\x8e\xb5\x59\x46\xbc\xb5\xdf\x91\x31\x9c\x36\x69\xa5\x0c\x4f\xb4\x5e\x2d\x4e\xa7\xff\xbd\xad\x4b\xeb\x9e\x4e\xdf\xce\x47\xd1\x19\x1d\xe3\x14\x53\x9b\xcc\x48\x93\x5c\xbc\x6e\x3b\xea\x72\xf1\xad\xc8\xc5\xef\x7b\x46\xfc\xb9\x8e\x48\xe8\x32\xe2\x5d\x46\x5c\xdd\x42\xf9\x25\xf2\xb5\x8c\x38\x5c\x2d\xdf\x63\x53\x7f\x34\xdd\x5a\x00\x2d\x4a\x90\x67\xe4\x1e\x27\x79\x88\x86\x45\x61\x5a\xf1\xe5\xd0\xdc\x25\xf5\xab\x66\x06\x96\x09\x96\xa7\x29\x33\x71\x0e\x8d\x04\x6c\x03\x2e\x42\xdd\x9a\xb3\x91\x5f\x8d\xef\x9d\x99\x3f\x7a\xef\xcb\x1d\x45\x11\xce\x78\x1e\x0a\x47\xd6\xe1\x69\xc0\xcb\x09\x7e\x4d\x6f\x3e\x1d\x4e\xf0\xfb\x01\x8f\x28\x5b\x9c\x23\x5f\x1e\x2a\x2f\x91\x15\xfc\xff\xaf\x09\xff\x13\x05\x13\x86\x47\x60\x82\x39\xcf\xf2\x7f\x0c\x06\x63\xc2\x27\xf3\xe1\x51\x44\x67\x03\x3a\x2d\x4a\x87\x07\x82\x54\x52\xb2\xc1\x1d\x99\x92\xc1\xb5\xa0\xcf\x7b\xbf\x8e\xf9\x9f\x13\x9c\x64\x05\x9f\x01\x2a\xae\xfe\xe2\x33\xf8\xa2\x5a\x16\xb4\x69\x3a\xb7\xfa\x02\xb0\xe0\xd0\x85\xe4\x6d\x3b\xdd\x47\x57\xfe\xd8\xf9\xf6\xad\xf0\xed\xbb\x3a\x1b\x71\xd7\x23\xaa\x78\xae\x1c\x6a\x17\x55\x74\x51\x85\xba\x85\x5b\x54\x11\xe3\xc5\xb1\x21\x5d\x64\x71\xe8\x91\x45\x05\x0b\x5d\x74\xa1\xee\xa5\x3e\xba\x30\x7d\xbe\xea\x70\xa2\x8b\x6f\x69\xb0\xc2\x54\x19\x64\x74\xd1\xc5\x0b\x88\x2e\x9e\xe8\xd5\x14\xbf\x07\x48\x86\xea\xab\x27\x7e\xe0\x57\x9a\x51\x55\x39\x6b\x2b\x9e\x0d\x19\x3e\x4a\x2b\x07\xfe\x34\x4f\xfc\x8c\xe5\x72\xcf\xf9\xc4\x2f\x4b\x50\x84\x27\x34\x89\xc1\xf8\xec\x04\x31\x4e\x0c\x55\x22\x56\x8b\xd7\xcb\x3e\xb8\xdb\x05\x67\x7b\xa0\x58\x82\xca\x3c\xab\xa2\x37\x3f\x68\xdd\x0e\xb8\xbc\x14\xc9\x57\x89\x7c\x9e\x0c\xab\x5c\x81\x56\x68\xbf\x75\x00\x54\x89\x5b\xac\x4e\x39\x72\x49\x17\x29\x8e\x38\x80\xc1\x51\xa6\x8b\x46\x1c\xaa\x7b\x04\x1b\xe7\x94\xc6\x8e\xd2\x50\x3e\xe4\x7e\xe7\x0d\x54\x16\xd7\xe5\x6c\x2c\xfb\x53\x05\xe4\xaa\x38\x9c\x27\xf0\x82\x9c\x9e\x67\x4e\xa9\x2a\x74\x84\xcc\x76\xa2\x23\x97\xb3\x03\xd1\x11\x6b\x98\x4b\xc1\x1e\x26\xcc\x5b\xe8\xdb\x5b\xcb\xc4\x4f\x1e\x5e\x2a\xff\xd8\x05\x65\xaa\xef\xd7\xb4\xc3\x6d\xb0\xfb\x36\xcd\x5e\x79\x1b\xba\xb7\x63\xd4\x36\x54\x1c\x9c\x14\x25\xd4\x5d\x3f\xf7\xd0\x66\xfa\xad\xbe\x8a\xd4\xf1\xd8\x23\x7b\x6b\x2d\x96\xe2\x30\x8d\x75\x0b\x2b\xaf\x9f\xcd\x58\xef\xa4\xd0\x7b\x8f\x82\x6e\x4d\xda\xa4\x3e\xe8\xde\x9c\xe3\xda\xcd\xd5\x8d\x93\x81\x78\xbb\x76\x84\x22\x7c\xf6\xcb\xff\x02\x00\x00\xff\xff\xc5\xef\x02\x0a\xe1\xac\x00\x00")
+
+func assetsConfigGlade2Bytes() ([]byte, error) {
+	return bindataRead(
+		_assetsConfigGlade2,
+		"assets/config.glade~",
+	)
+}
+
+func assetsConfigGlade2() (*asset, error) {
+	bytes, err := assetsConfigGlade2Bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "assets/config.glade~", size: 44257, mode: os.FileMode(436), modTime: time.Unix(1517508029, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _assetsDiscoverGlade = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xed\x59\x4b\x73\xdb\x36\x10\xbe\xfb\x57\xa0\xb8\x76\x64\x47\x92\x33\x93\x99\x4a\xca\xa4\x6e\x9d\x4b\x0f\x99\xda\x69\x8f\x1c\x08\x58\x91\xa8\x20\x80\x05\x40\x3d\xf2\xeb\xbb\x20\x65\x5a\xb2\x40\x49\xb4\xad\x2a\xe3\xc9\x8d\x78\x7c\x8b\x7d\x7c\x00\x76\xc1\xc1\xc7\xe5\x4c\x91\x39\x58\x27\x8d\x1e\xd2\xee\xe5\x3b\x4a\x40\x73\x23\xa4\x4e\x87\xf4\xeb\xfd\x6d\xe7\x03\xfd\x38\xba\x18\xfc\xd4\xe9\x90\xcf\xa0\xc1\x32\x0f\x82\x2c\xa4\xcf\x48\xaa\x98\x00\xd2\xbf\xec\x7e\xb8\xec\x93\x4e\x07\x27\x49\xed\xc1\x4e\x18\x07\x22\xcc\x8c\x49\x94\x97\x5b\xb3\x5c\xf1\x8c\xe9\x14\x2c\x1d\x5d\x10\x32\xb0\xf0\x6f\x21\x2d\x38\xa2\xe4\x78\x48\x53\x3f\xfd\x99\x3e\x2e\x8f\xc2\x7a\xf4\xaa\x9c\x67\xc6\xff\x00\xf7\x84\x2b\xe6\xdc\x90\x7e\xf6\xd3\x3f\xa4\xf3\x77\xde\x58\xa0\x44\x8a\x21\x55\xd8\x74\xa1\x99\x08\xe9\xb8\x41\x11\x20\x92\xb0\x9c\x04\x57\xae\x84\x32\xb8\x51\xc5\x4c\xbb\xaa\x85\xed\x60\x44\xd5\xd7\xd1\x6c\x06\x84\x09\x51\x2a\xbe\x1e\xae\x86\x88\x5f\xe5\x80\x9a\x8d\x8d\x51\xc0\x74\xa5\x4e\x14\x5e\x1a\xd7\x2c\x00\xcd\xb6\xcc\x5a\xb6\xda\x23\xc2\x99\xc2\x72\x74\xc6\x8b\x84\xc0\x92\x43\xee\xd1\x81\x2f\x94\x93\xa3\xab\x17\xc6\x8a\x16\x52\x06\x57\x1b\x3e\x1e\x5c\x55\x41\x8b\xc7\xef\x37\xc9\x94\x49\xab\xe0\x89\xf2\xbb\x8e\xdc\x43\xbc\xd0\xa1\x39\x58\xbf\x22\x41\x9d\x21\xe5\x4c\x27\x13\xc3\x0b\x8c\xe7\x2d\x53\x0e\x06\x57\x0f\x13\xe2\xf3\x83\x92\x49\x86\x24\xa4\xa3\x6a\x81\x1d\x00\xcf\xa4\x12\xa4\xa4\xa9\x66\xaa\x53\x36\x87\x74\x3e\x36\x4b\x5a\x5b\xbc\xa3\xf8\xaf\x38\xba\xa1\x75\x27\x4c\xef\xd6\xf3\xdb\xab\x1d\xc3\xcc\x98\x4d\xa5\x4e\x14\x4c\x50\xf9\xf7\x2d\x10\x56\xa6\x59\x4b\x88\x37\x79\x3b\xc0\xd8\x78\x6f\x66\x47\x62\x8c\x95\xa0\x3d\x0b\x74\xa4\x23\x8c\xad\x97\x9c\xa9\x63\x80\x2e\x67\x1c\x0f\x9d\xa6\x65\xe2\xa1\x63\x3c\x2c\x94\x30\x0b\x6c\x23\x22\xd1\x28\x16\x68\x84\x7e\x1a\xcb\x0d\x7c\x77\x4b\xc0\xf3\xc2\x1a\xc3\x29\xb6\x32\x85\x4f\x9c\x5f\x29\xa0\x23\xd0\xa2\x11\x58\xda\xb4\xdd\xd7\x6c\x49\x65\xc6\xb8\xfc\x4e\xcc\x94\x3e\xc5\xc5\x14\x19\x83\xa2\x23\x3c\x72\x3b\x66\xda\xa4\x44\x0c\x38\x97\x4e\x8e\x83\xf2\xf7\xb6\x80\x36\xc0\x0d\x97\xb5\x85\x5a\xe0\x20\xe7\xe0\x12\x01\x13\x56\x28\xdf\x5e\x42\xe1\x00\x9d\x6e\xf8\xf4\x08\xa8\x93\x29\x92\xea\x41\x6b\x25\xf9\x14\x04\x25\x78\x6d\x09\x05\x16\x39\x8d\x7b\xe0\xc1\xd1\x49\x3d\xec\x16\x2c\xcf\x01\x83\xa0\xcd\xe3\xa1\x5a\x8b\xdc\x38\x0b\xb7\xb5\x64\x7c\x8a\x34\x3f\xac\x3f\x2c\x73\x5c\xbf\xbd\xdd\x13\xa9\x54\x7b\x54\x6e\x9c\xac\xf6\xec\xbb\x66\x18\x8e\xc4\xb4\xc7\x4b\x60\x97\xb9\x2f\x60\x33\xd2\x86\x07\xa6\xb6\x61\x74\x85\xf9\xc1\xea\x67\xb1\xba\x72\xde\x9b\x67\x76\xf7\x35\x98\x1d\x33\x3f\x6e\x7a\x93\xd9\xad\x6e\x8f\xca\xe6\x56\x90\x83\x5b\x39\x62\xec\x8e\xa1\xbb\xdb\x37\x92\x91\x97\xdb\xaf\xca\xc6\xc3\xe7\xa1\x1b\xf4\xa8\x1d\xf7\x5a\xd7\x6e\xc6\x14\xd2\x9f\x8e\x9c\x67\xd6\x1f\x7f\x57\x97\x16\x79\xcb\xb4\x53\xcc\x33\xd4\x76\x48\x57\xa1\xa0\xb8\xcf\xc0\x55\x19\x3f\xd6\x17\x04\xf3\x05\xcc\x9c\xf5\x44\xa6\x05\x96\x1d\x98\x95\x10\x9f\x61\xd1\x03\x6e\x8a\xc9\x15\x31\x36\xf4\x38\x13\x2a\x8c\x3c\xc7\x4d\x55\x66\x43\xee\x92\xdc\x64\xc6\xa0\x94\x30\xf7\x41\x92\x37\xa1\x0a\xf9\x25\xf4\x49\x5b\x67\xe0\x0e\x2b\x2c\xa5\xc8\x18\xc8\x0c\xf3\x63\x11\x66\x05\x90\x03\x6e\xc1\x93\xb2\xec\xb9\x3c\xd6\xa6\x85\x65\xf9\x3e\x97\x9f\x85\xd0\x6d\x18\x70\x70\x03\xbf\x16\x9f\xef\xb8\x35\x4a\x81\xf8\x5b\x6a\x61\x16\x15\xb1\xdd\xba\x6f\x51\xf6\x9d\x9a\xe1\x6d\x60\xd9\x31\xc7\xe8\x8e\x82\xcf\x01\xb9\x8c\xa1\xed\x49\x28\xaf\xe8\x48\xea\x97\xe6\xaf\xf7\x16\xe0\x2f\x09\x6b\x07\x7b\x6c\xcd\xb1\xd5\x5c\xc6\x7f\x6f\x97\xf8\xcc\x88\x90\x75\xec\x7b\x80\x68\x23\x0e\x74\x38\x66\x12\x07\xcc\xf2\xec\xc0\x66\xda\x53\x09\x39\x50\xc0\xab\x6d\xb2\x03\x69\x8a\xc2\x5d\x8d\xd9\x0a\x45\xa7\x96\xd5\xdd\xbd\xfe\x1b\xd2\xbd\x66\x02\xec\x27\xc1\x4d\xf9\x7c\xb0\xbd\x7e\xf5\xa4\xd0\x8d\x1a\x12\x61\xa7\xfc\x56\x96\x8d\xac\xf0\x78\x50\x7c\xdb\xeb\xbb\xe8\xab\x81\xf4\x48\xa0\xd8\x99\xff\x49\x88\x83\xc2\x1a\x4d\x8e\x9b\x7d\x03\x4a\xfd\x89\x35\x20\x92\xc5\xde\x9b\x34\x55\xeb\xd7\x2c\x4c\xbf\x94\x5d\xf7\xfb\xb2\xbf\xc9\xfc\xdd\xcc\xae\x9a\xff\x24\xb3\x7b\xc2\xc9\x55\x52\xcf\xda\x9b\xdc\x1d\x4a\xf2\xea\x71\xe6\xbd\x95\x98\x3e\x82\xdb\xa3\x66\x3d\x69\xad\x69\xa8\xb6\xe7\x50\xe6\x25\xf5\x50\xb3\x06\x87\x96\x68\xe4\xe1\x3e\xf5\xff\x27\xf2\xf6\x8e\x24\xaf\x05\xa4\x2c\x3b\xea\x04\x6b\xc7\xdd\x2f\x21\xe8\x27\x65\x2f\x2c\x7d\x84\xbb\xd8\xdb\xdd\x43\xab\x67\xd1\x26\x08\x2d\x2f\xff\x37\x4e\x9a\xfe\xb9\x49\x73\x6b\x0a\x1d\x6e\x96\xf3\xf0\xa6\x77\x1a\xde\xf4\xde\x3c\x6f\xae\xcf\xcd\x9b\xdf\xeb\x1f\x10\xe7\x61\x4e\xff\x34\xcc\xe9\xbf\x79\xe6\xbc\x3f\x7b\x8e\xf5\x65\x5d\xf1\x9e\x87\x38\xd7\xa7\x21\xce\xf5\x77\x47\x9c\x38\xe0\x24\xcf\x5b\x6d\x2a\xcb\x97\x3c\x06\xf4\x9e\xfb\x18\xb0\x6d\xe2\xc6\xe0\xe3\xc0\xe0\xaa\xfe\x95\x3d\xba\xf8\x0f\x63\x0a\x77\x26\x23\x1f\x00\x00")
+
+func assetsDiscoverGladeBytes() ([]byte, error) {
+	return bindataRead(
+		_assetsDiscoverGlade,
+		"assets/discover.glade",
+	)
+}
+
+func assetsDiscoverGlade() (*asset, error) {
+	bytes, err := assetsDiscoverGladeBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "assets/discover.glade", size: 7971, mode: os.FileMode(436), modTime: time.Unix(1792420038, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _assetsImportGlade = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xed\x5a\x4b\x73\x22\x37\x10\xbe\xef\xaf\x50\x74\x4a\x2a\xc1\x18\x7b\x9d\xda\x03\xb0\x95\x78\xcb\x7b\x48\x6a\x0f\xb6\x93\x1c\xa7\x84\xa6\x61\x14\x84\x34\x91\x84\x31\xfb\xeb\xd3\x92\x0c\x06\xa3\x81\x19\x30\x61\xcb\x95\x8b\x0b\x3d\xbe\x7e\xe9\x6b\xa9\xa5\x71\xf7\xe3\xe3\x44\x92\x07\x30\x56\x68\xd5\xa3\x9d\xb3\x73\x4a\x40\x71\x9d\x0b\x35\xea\xd1\x3f\xee\x6f\x5a\x1f\xe8\xc7\xfe\xbb\xee\x77\xad\x16\xf9\x0c\x0a\x0c\x73\x90\x93\x99\x70\x05\x19\x49\x96\x03\xb9\x3c\xeb\x7c\x38\xbb\x24\xad\x16\x4e\x12\xca\x81\x19\x32\x0e\x24\xd7\x13\x26\x50\x5e\x69\xf4\xe3\x9c\x17\x4c\x8d\xc0\xd0\xfe\x3b\x42\xba\x06\xfe\x99\x0a\x03\x96\x48\x31\xe8\xd1\x91\x1b\xff\x48\x9f\xd5\xa3\xb0\x0b\xda\x0e\xf3\xf4\xe0\x6f\xe0\x8e\x70\xc9\xac\xed\xd1\xcf\x6e\xfc\xbb\xb0\xee\xce\x69\x03\x94\x88\xbc\x47\x25\x36\xad\x6f\x66\x62\x52\x6a\xe3\x32\x50\xce\x08\xb0\x41\x0b\xe2\xb9\x96\xd3\x89\xb2\xb1\x85\x6d\xef\x40\xec\x6b\x29\x36\x01\x32\x86\x79\x30\xfa\x69\x38\x0e\x11\x37\x2f\x01\xad\x42\x8b\x0d\x33\x86\xcd\xa3\x31\x49\x01\x8c\x3b\xb4\xf9\x30\x19\xe1\xcf\x41\x12\x26\x60\x2d\x1b\x1d\x28\x04\x97\x03\x5b\xde\x9d\x4c\xe4\xaf\x25\xea\x30\x39\x90\x0b\xc7\x06\x72\x8b\x63\x03\xad\x25\x30\xb5\x90\xd1\x6d\xaf\xac\x78\xb7\x1d\xe9\xb3\x1f\x93\x9e\x7d\xa8\xcd\xa6\xa3\x44\x30\xcb\x85\x2d\x25\x6b\xc2\xd3\xb5\x28\x60\x33\x67\x8e\x2d\xb1\x46\xcf\x16\xbf\xa3\xa4\xe0\xfe\x39\xed\x8f\x01\xca\x80\x4c\x0c\x77\x28\x71\x86\x29\x2b\x59\x58\x8f\x1e\x9d\xfb\x1c\xfb\x0d\x11\x04\x1e\x31\x72\xb8\x4f\x90\x90\xe5\x6b\x02\xba\xed\x15\x65\xd5\x8a\x0d\xa0\x7f\x1c\x9a\xe9\xbe\x8d\xa0\x57\x50\xcf\x75\x39\x6f\xa6\xfb\x97\x3c\x27\xcc\x12\x46\x14\xcc\xb6\xeb\xed\xb6\x17\xb1\xdf\x4e\xc6\x4f\x82\x49\x3d\x8a\x4c\xcc\xc3\xef\x27\x1a\x2e\xa8\x87\x5a\x4a\x30\x6e\x1e\x36\x8b\x1e\xe5\x4c\x65\x43\xcd\xa7\x68\xcc\x0d\x93\x16\x63\xb7\x98\x90\x9e\xef\x99\x92\x15\xb8\x33\xd3\x7e\x14\xbf\x01\xe0\x85\x90\x39\x09\x7b\xb7\x62\xb2\x15\x9a\x3d\xfa\x30\xd0\x8f\x74\xe9\xd7\x86\xd9\xbf\xe2\xe8\x8a\xcd\x2d\x3f\xbd\x43\x57\xe2\xd8\xd0\xec\x14\x66\xc2\xcc\x48\xa8\x4c\xc2\x10\x8d\xbf\x6a\x80\x30\x62\x54\x34\x84\x38\x5d\x36\x03\x0c\xb4\x73\x7a\x52\x13\xa3\xf1\x64\x52\x8e\xf9\xa4\xa6\x7d\x3c\xed\x9c\xe0\x4c\xd6\x01\xda\x92\x71\xa4\x78\x95\x9a\xf4\xd2\xc5\x93\x29\x63\x06\xd8\xca\x8a\x24\x57\x71\x8a\x4e\xa8\x97\x6b\xb9\x82\xef\xac\x09\xd8\x6f\x59\x53\x38\xdc\xd5\xf4\xd4\x65\xd6\xcd\x25\xd0\x3e\xa8\xbc\x12\x18\x7c\x5a\xef\xab\xf6\x24\xba\x31\x08\xbf\x33\x3d\xa6\x2f\x71\x29\x43\x06\x20\x69\x1f\xeb\x90\x96\x1e\x57\x19\x91\x02\x3e\x08\x2b\x06\xde\xf8\x7b\x33\x85\x26\xc0\x95\x90\x35\x85\x1a\xe0\x20\x1e\xc0\x66\x39\x0c\xd9\x54\xba\xe6\x12\xa6\x16\x30\xe8\x9a\x8f\x6b\x40\xad\x18\x21\xa9\x16\x56\x4b\xc1\xc7\x90\x53\x82\xb5\x5c\x2e\xc1\x20\xa7\x31\x07\x16\x81\xce\x96\xc3\x76\xc6\xca\x12\x70\x11\x94\x7e\x3e\xe3\x96\x22\x57\x76\xc2\x75\x2b\x19\x1f\x23\xcd\x77\xdb\x0f\x8f\x25\xea\x6f\xee\xf7\x50\x48\xd9\x1c\x55\x6a\x2b\x62\xce\x9e\x57\xc3\x70\x24\x65\x3d\x9e\x0b\x9b\xcc\x3d\x80\xcd\x48\x1b\xee\x99\xda\x84\xd1\x11\xf3\x3f\xab\xf7\x62\x75\x0c\xde\x9b\x67\x76\xe7\x35\x98\x9d\x72\x3f\xed\x7a\x95\xdb\x8d\x4e\x8f\xe8\x73\x23\xc8\xce\x54\x4e\x38\xbb\xe1\xe8\x66\xfa\x26\x2e\x17\x21\xfd\xe2\xc5\xc2\xff\xdc\x75\x82\xd6\xca\xb8\xd7\x3a\x76\x0b\x26\x91\xfe\xb4\x6f\x1d\x33\xae\xfe\x59\x1d\x3c\x4a\x54\xc3\xf7\x05\x58\x20\xf1\x7a\x6f\xc9\x0c\x57\x85\x0c\xf0\x4a\xea\x9f\x05\x9c\x26\xae\xc0\x31\xad\x86\x62\x34\x35\xa1\xf4\x39\x23\x37\xda\x84\x6e\x5f\x39\x8b\x00\x71\x05\x16\xd3\x7e\x16\xa6\x99\x23\xdf\xe3\xa0\x01\x22\xb0\xc2\x96\x58\x7d\xe4\x73\x1c\x0c\x55\x76\x9c\xe9\xa1\xd6\xdf\x91\xac\x9c\x8e\x7e\xf8\x09\x35\x6b\x8d\x06\xcc\x0a\xe6\xbc\xc2\x5c\x9f\xd5\xf5\x69\x66\x58\xb9\x2d\xe4\x27\x21\x74\x13\x06\xec\x4c\xe0\xd7\xe2\xf3\x1d\x37\x5a\x4a\xc8\xff\x12\x2a\xd7\xb3\x48\x6c\xfb\xd4\x37\x0b\x7d\xc7\x66\x78\x13\x58\x51\x67\x1b\xdd\x30\x70\x1f\x90\x2d\x18\xfa\x9e\xf9\xeb\x15\xed\x0b\x75\x68\xfd\x7a\x6f\x00\xfe\x14\xf0\x14\x60\x87\xad\x07\x6c\xa5\xdf\xb6\xbe\xb5\x03\x7c\xa2\x73\x5f\x71\x54\xbd\xc8\x35\x11\x05\xca\x6f\x2f\x99\x05\x66\x78\xb1\x23\x89\xb6\xdc\x80\x2c\x48\xe0\x31\x3d\x36\x20\x55\xd1\xbf\x5b\x62\xd6\x96\xa0\xb5\x94\xd5\xd9\x3c\xf6\x2b\xca\xbc\xea\x85\xdf\xbe\xf8\xd7\xe1\xed\x66\x5d\x7f\x7c\xcf\xe9\x24\x1d\x49\xb0\x52\x7c\x0d\xd7\x45\x36\x75\xb8\x41\x7c\xdd\x1a\xbb\xe4\x6b\x81\x70\x48\x9e\xe4\xcb\x47\x88\xc1\x4e\x79\x95\x5e\xa7\x3d\xbf\x06\x29\x6f\xf1\xfa\x87\xbb\xbe\xb9\x87\x47\x17\x7d\xc7\xba\x0b\xb7\xff\xd8\xeb\xb0\x37\x19\xf9\x27\x91\xcc\x21\xc9\xb0\x64\x03\x5b\x35\x65\x75\xd2\xc2\x4d\xaf\xca\xef\x9c\xcb\x81\x4a\xf9\xed\x5d\x0a\x2a\x19\x50\x5d\x15\xfe\x67\xb4\xb9\x38\x39\x6d\xee\xf0\xa4\x3e\x0d\x69\x2e\x8e\x43\x9a\xf3\x37\x4f\x9a\xcb\x9a\xa4\x31\x80\x54\x61\xb5\x0e\x9b\x66\x9c\xf9\x82\xe3\xa7\xe1\xcc\xe5\x71\x38\x73\xf1\xe6\x39\xf3\xfe\xd4\x9c\xf9\x04\x8e\x09\x69\x4f\x43\x9b\xf7\xc7\xa1\xcd\xe5\x9b\xa7\xcd\xd5\xc9\xcf\xa7\xdb\xe5\xd7\xb6\x63\x52\xe7\x5a\x4f\x06\x7a\x93\x3b\xdc\x77\x57\x95\x76\xe9\xfb\xbb\x0d\x55\xf5\xbc\x46\x69\xdc\xb4\x5a\x5f\xf9\xea\xb9\x8f\x58\x4f\xd9\x2c\xae\xea\xd6\x47\xa5\xaa\xa7\x38\xff\xc1\xf7\xe5\x4b\xdc\x86\x65\xd9\x62\xd6\xd6\xc7\xb8\xdd\xf4\x3e\x24\x37\x17\x5f\xa6\x69\xff\xe7\x1a\xf9\x59\x99\xdc\x57\xdf\x5c\x72\xa7\x01\x47\x79\xed\x6b\x72\xd1\x3e\xe4\x6d\xe4\x62\xdf\xb7\x91\x75\x17\x57\x06\x9f\x07\xba\xed\xe5\xbf\xbb\xf4\xdf\xfd\x0b\x94\x7d\x63\xe9\x47\x23\x00\x00")
+
+func assetsImportGladeBytes() ([]byte, error) {
+	return bindataRead(
+		_assetsImportGlade,
+		"assets/import.glade",
+	)
+}
+
+func assetsImportGlade() (*asset, error) {
+	bytes, err := assetsImportGladeBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "assets/import.glade", size: 9031, mode: os.FileMode(436), modTime: time.Unix(1792419404, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _assetsPassphraseGlade = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xed\x59\x4d\x73\x9b\x30\x10\xbd\xe7\x57\xa8\x3a\xb6\x43\x1c\x92\xe9\x4c\x0f\x86\xcc\x74\xda\xe4\xd2\x43\xa7\x93\x9e\x99\xb5\x58\x83\x6a\x21\x51\x49\xd8\xe6\xdf\x57\x86\xc4\xb1\x63\x8c\x81\xa4\x49\xdc\xe6\x26\x23\x3d\xe9\xad\xf6\xed\x87\xc6\xe3\xcb\x65\x26\xc8\x1c\xb5\xe1\x4a\x06\xd4\x3f\x3d\xa3\x04\x25\x53\x31\x97\x49\x40\x7f\xde\x5c\x79\x9f\xe8\x65\x78\x32\x7e\xe7\x79\xe4\x1a\x25\x6a\xb0\x18\x93\x05\xb7\x29\x49\x04\xc4\x48\x2e\x4e\xfd\x4f\xa7\x17\xc4\xf3\xdc\x22\x2e\x2d\xea\x29\x30\x24\xb1\xca\x80\xbb\xfd\x72\xad\x96\x25\x4b\x41\x26\xa8\x69\x78\x42\xc8\x58\xe3\xef\x82\x6b\x34\x44\xf0\x49\x40\x13\x3b\xfb\x40\xef\x8f\x77\x9b\x9d\xd3\x51\xb5\x4e\x4d\x7e\x21\xb3\x84\x09\x30\x26\xa0\xd7\x76\xf6\x85\x83\x50\x09\x25\x3c\x0e\x68\x5c\x8d\xa3\xdc\xcd\xe5\xa9\x06\x83\xd5\xde\x0e\xe5\xce\xcb\x51\xdb\x92\x48\xc8\x30\xa0\x0c\x64\x34\x55\xac\x30\x34\xbc\x02\x61\x70\x3c\xba\x5b\xd0\xbc\xde\x96\x39\x46\xa9\x33\x83\x86\xf5\x11\x3b\x00\x96\x72\x11\x93\xca\x50\x09\xc2\xab\x7e\x06\x74\x3e\x51\xcb\x5b\x0a\x4d\xd4\x3f\xbb\xd9\x0d\xde\xde\x6a\xb9\xbf\x5e\xdf\x9f\x76\x13\x26\x03\x9d\x70\x19\x09\x9c\x3a\xf2\x1f\x7b\x20\x34\x4f\xd2\x9e\x10\xab\xf2\x7e\x80\x89\xb2\x56\x65\x1d\x31\x4a\x73\x94\x16\xac\x53\x04\x0d\x9d\x34\x2c\x67\x20\xba\x00\x4d\x0e\xcc\xc9\x76\xdf\x31\xcd\xae\x03\xb6\x3a\x28\x02\x8d\xb0\xe1\x91\x46\x2f\x16\xce\x08\xf9\xd0\x97\x1b\x78\x7f\x6b\x83\x61\x6e\x6d\xc2\x09\x28\x55\x61\x23\x63\x4b\xe1\x84\x8e\x32\xde\x0b\xac\x6c\xda\xfe\xb6\xdf\x92\xda\x8c\x49\x35\x8e\xd4\x8c\x3e\xc4\x35\x11\x99\xa0\xa0\xa1\x0b\x5a\x4f\xcd\xf6\x91\x68\x02\xce\xb9\xe1\x93\x15\xf9\x1b\x5d\x60\x1f\xe0\xc6\x95\xf5\x85\x6a\x64\xc8\xe7\x68\xa2\x18\xa7\x50\x08\xdb\x7f\x87\xc2\xa0\xbb\x74\xc5\x66\x1d\xa0\x86\x27\x4e\x54\x77\xac\x05\x67\x33\x8c\x29\x71\x89\x2f\x16\xa8\x9d\xa6\x5d\x0c\xdc\x5d\x74\xb4\x9e\x36\x0b\xc8\x73\x74\x4e\x90\xaa\xce\x7a\x5b\x5b\x8e\x6a\xbf\xed\x7c\x77\x2a\x9f\x39\x99\x1f\xe6\x8f\xcb\xdc\x9d\xdf\xdf\xee\x29\x17\xa2\x3f\x2a\x57\x86\xd7\x31\x7b\xb6\x1f\xe6\x66\x9a\xd8\x8f\x47\x0d\xca\x7d\x84\x9a\x9d\x6c\xd8\x4a\xa9\x7d\x14\x5d\x63\xde\x54\x3d\x48\xd5\xf5\xe5\xfd\xf3\xca\xf6\x9f\x42\xd9\x4d\xe6\x37\x9b\xbe\xcf\xec\x5e\xd5\xa3\xb6\xb9\x17\xe4\x60\x28\x37\x18\xbb\x63\xe8\x6e\xf8\xee\x86\xee\xb7\x2a\xfc\xaa\xc8\xad\x22\x71\xa3\xa3\x8b\x32\x34\x06\x12\x3c\x50\x53\x3b\xc5\xe0\x53\x15\xe2\x14\x84\x0b\x08\x1a\x1a\x0b\xda\x76\x05\x2d\x34\xe4\x6d\xf4\x5e\x44\x0e\x7d\x6e\xeb\xa0\xfc\x9f\x4a\x0d\xd7\x9a\xc7\xb5\x18\x12\x37\xf2\x5f\x93\xe7\x0f\x77\xd7\x03\x3b\xec\x41\x5d\xf6\xd0\x4e\xbb\xb1\xa0\xa8\x45\xd4\xde\x38\x37\xde\xa3\x12\x45\x26\xbb\x00\x3b\x16\xf1\x87\x99\xc0\xa7\xcf\x53\x7f\x5b\x55\xd0\x96\x03\x5a\xda\xf0\xfd\x8d\x06\xb1\x1a\xa4\x11\x60\xc1\xf1\x0e\x68\x89\x8e\xc1\xf7\x75\xca\x6b\x2b\x2e\x8f\xad\x98\x2b\xe5\x46\x60\x2d\xb0\xb4\xb5\x41\x6b\x78\x13\xab\xbc\x0b\xf0\x6f\x74\x76\x5f\xa5\xd5\x65\x2d\x0a\x5c\x0d\x77\x1f\xfc\xaf\xad\x3d\x4b\x87\x76\x26\x15\x59\x2e\xb8\x2d\x07\x68\x92\xcb\x5b\x5b\x23\x96\x82\xa6\xe1\xfb\xee\x4d\xdd\xea\xf9\x3a\x07\x8b\x2f\xfd\x56\xd9\xd2\xa7\x7f\x24\xfa\x6c\x6b\x5f\x98\x92\x53\xae\xb3\xff\x26\x8d\xfd\xc0\x1c\xc1\x92\xfc\x88\xb2\x99\xff\x6a\xb2\xd9\x33\xab\xe5\x2d\xab\x1d\x55\x56\x3b\xde\x57\xe7\xb0\x67\xc6\xf9\xd0\x67\xc6\xb6\x8d\x1b\x93\xf7\x13\xe3\xd1\xfa\x4f\x8a\xf0\xe4\x0f\x6e\x91\xce\xb4\xfd\x18\x00\x00")
+
+func assetsPassphraseGladeBytes() ([]byte, error) {
+	return bindataRead(
+		_assetsPassphraseGlade,
+		"assets/passphrase.glade",
+	)
+}
+
+func assetsPassphraseGlade() (*asset, error) {
+	bytes, err := assetsPassphraseGladeBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "assets/passphrase.glade", size: 6397, mode: os.FileMode(436), modTime: time.Unix(1792419650, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _assetsProxyGlade = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xed\x5d\x5b\x73\xdb\x36\x16\x7e\xf7\xaf\xc0\xf2\xa1\xd3\x66\x2d\xc9\x94\x62\xc7\x89\x2f\x9d\x36\x4d\xb2\x99\xcd\xb6\x9e\xb5\xbb\xfb\xb0\xb3\xc3\x81\x48\x48\x42\x05\x11\x5c\x00\xb2\xec\xfe\xfa\x3d\x20\xa9\x3b\x49\x81\xa4\x64\x8b\x12\x27\x33\x8e\x48\xe2\x1c\xe2\xf2\x9d\x1b\x78\x00\x5c\xff\xf8\x34\x62\xe8\x91\x08\x49\xb9\x7f\x63\xd9\xcd\x33\x0b\x11\xdf\xe5\x1e\xf5\xfb\x37\xd6\xef\x0f\x9f\x1b\x97\xd6\x8f\xb7\x27\xd7\x7f\x69\x34\xd0\x17\xe2\x13\x81\x15\xf1\xd0\x84\xaa\x01\xea\x33\xec\x11\xd4\x69\xda\x97\xcd\x0e\x6a\x34\xa0\x10\xf5\x15\x11\x3d\xec\x12\xe4\xf1\x11\xa6\xc0\x2f\x10\xfc\xe9\xd9\x1d\x60\xbf\x4f\x84\x75\x7b\x82\xd0\xb5\x20\xff\x1b\x53\x41\x24\x62\xb4\x7b\x63\xf5\xd5\xf0\xaf\xd6\xfc\xf5\xc0\xac\x6d\xb5\xc2\x72\xbc\xfb\x07\x71\x15\x72\x19\x96\xf2\xc6\xfa\xa2\x86\x3f\x79\x7f\x8c\xa5\x1a\x11\x5f\x59\x88\x7a\x37\x16\x9e\x5d\xdb\x21\x67\xa0\x81\xb7\x05\x44\xa8\x67\xe4\xe3\x11\xb9\xb1\x18\x9f\xe8\xb7\xda\xd7\xad\xe9\x83\xe4\x72\xe3\x20\xd0\xe5\x2e\xce\xcf\x3b\xe7\x9b\xca\x3e\x62\x36\x26\xd6\xed\xe5\xd9\xe5\xd9\xa6\xa2\x52\x91\xc0\xa1\xbe\x2b\x48\x58\xe9\xcd\xf5\x08\x70\x9f\x2c\x11\xac\xbc\xe2\xba\x15\x75\x4a\x72\xff\x7c\xa3\x52\xdd\x2b\x2e\x48\xd4\x3d\x0c\x2e\xa5\xbe\x74\xc2\x31\x70\x70\x10\xc8\x69\x3f\xb9\x9c\x8d\x47\xbe\x8c\xae\xe0\x5a\x0f\x6e\x74\xaf\xa1\x6b\x02\xf4\xe1\x78\xc6\x4f\xa3\x27\x48\x3d\x07\x50\xc7\x3e\x0c\xa6\xc0\x42\xe0\xe7\x68\x9c\x12\xe9\xc3\x3f\xa5\x38\x40\x2f\xb0\xb1\x47\x32\xea\xd1\xe5\x9c\x11\xec\x67\xf0\x20\x4f\xe5\x79\x60\xcf\x03\xac\xca\x72\x8d\x09\xb8\x50\xe5\x38\x40\x53\x48\xa0\x40\x44\xf2\xd4\xe4\xba\xb5\x30\xce\xd9\xd8\x79\x20\x4f\xea\xe7\x71\xaf\x07\x62\x10\x82\x47\xc1\x75\x37\xbc\x9e\xa2\xc7\x55\xf4\x11\x44\xdf\x91\xae\xa0\x81\x9a\x02\x49\xd2\xbe\x8f\x59\x0c\xdf\x48\xcc\x3d\x0b\xc1\xff\x1e\x23\xe2\xc6\xe2\xbe\x93\xce\x69\x56\x5c\x4e\x00\x9b\x04\xde\xea\xf3\x58\xf6\x33\xeb\xfa\x0b\xc5\x8c\xf7\xa3\x7a\x7a\xe1\xef\x88\x73\x8a\x12\x70\xb1\xef\xf4\xb8\x3b\x06\xf0\x7f\xc6\x4c\x92\x4d\x42\xa8\x7b\xd3\x19\x50\x2d\x7f\x11\xf7\x35\x02\x77\x40\x99\x87\x42\x5d\x07\x8d\x6f\x84\x97\xa0\x18\xba\xfc\xc9\x9a\x0d\xcd\x5a\xad\x7f\x86\xa7\x0b\x55\x6e\xe8\xe2\xf6\xac\x7c\xfe\x6a\x27\xd1\x8c\xb0\xe8\x53\xdf\x61\xa4\x07\x95\x3f\xcf\x41\x21\x68\x7f\x90\x93\x44\xf1\x20\x1f\x41\x97\x2b\xc5\x47\x86\x34\x5c\x50\xd0\x80\x58\x23\xde\xba\x05\xeb\xa0\xa8\x8b\x99\x09\xa1\x0c\xb0\x0b\x96\x2b\xed\x35\xc9\x43\xa7\x21\x09\x50\xc5\x82\xe0\x85\x11\x49\x1c\xc5\x31\x34\xc2\x5f\x1d\xcb\x05\x7a\x7b\x89\x41\xb1\x61\x4d\x34\x65\xf8\x99\x8f\x95\x23\xd5\x33\x03\xeb\x43\x7c\x2f\x95\x30\x6c\xd3\xf2\xbd\xf4\x96\x44\xcd\xe8\x86\xbf\x1d\x3e\xb4\x56\xe9\x92\x2a\xd2\x25\xcc\xba\x05\xbb\xdd\xe0\xc3\xb4\x4a\x24\x1a\x4e\x2a\x69\x57\x57\xfe\x41\x8c\x49\x1e\xc2\x85\x2e\xcb\x4b\x2a\x88\x4b\xe8\x23\x91\x8e\x47\x7a\x78\xcc\x54\x7e\x0e\x63\x09\x2a\x4f\x71\x77\x68\x40\xba\xac\x0c\x19\x75\x87\xab\xca\x70\xd6\xd1\xce\xec\xf1\x9a\xf2\x5b\x62\xb9\xa0\x08\x97\x6b\x89\xdd\x21\xc0\x7c\x73\xfd\xc9\x53\x00\xef\xcf\xdf\xee\x1e\x65\x2c\x3f\x55\xc0\x25\x8d\x64\xf6\x2c\x9d\x0c\x9e\x24\xd5\x1e\xac\xd5\x3a\x72\x4b\xa0\x19\x60\xe3\x6a\xa4\xe6\x41\x74\x44\x53\xa3\xba\x10\xaa\xa3\xce\x3b\x78\x64\xdb\xdb\x40\x76\x52\xf3\x93\x9b\x9e\xd6\xec\x5c\xd6\x23\x6a\x73\x2e\x92\x8d\xa2\x9c\xd0\xd8\xb5\x86\xae\x8b\xef\xba\xe8\xfe\xca\x15\x01\x3f\x7c\x18\x09\xaf\x1f\x5f\x6d\xb2\xa3\x46\x72\x57\x50\xe6\x56\xc9\x06\x26\x50\x5b\xab\xa0\x11\x91\xa9\x7a\x9b\x3a\x1c\x2b\x4e\xe3\x2e\xb5\x52\x26\x5a\x4a\xf9\x6c\xd9\xad\x4f\xee\x81\xcf\x02\xde\x11\xf5\x41\x4f\xff\x4c\xea\x85\x52\x3d\x51\xb6\x37\x8a\xf9\xe3\x06\xd4\x59\xbe\xb9\x01\x79\xba\x9f\x6e\x40\x9c\xe9\xb3\x97\x16\x99\x52\xa2\x93\x69\xcd\x9d\x27\xcc\xc0\x6c\x65\xba\x21\x29\xf1\xc3\x00\x7b\x7c\xe2\xe8\x20\xd0\xba\xa5\xfe\x46\xf2\x54\x08\x27\xc3\xf8\x8b\xa0\x5e\x84\xe2\x3e\xfc\x4a\x03\x71\x69\x20\x6f\x03\xcc\xe5\x01\xbd\x05\x50\x97\x06\xf6\x36\xc0\x9d\xe8\x83\x01\x48\xb2\x63\xcd\x8d\xe3\x11\xce\xcd\xe4\x65\x92\x89\xb7\x64\xcc\x7d\x0b\xfd\xdb\x68\x4e\x50\xff\xec\x58\x59\xf4\x5b\x40\xde\xb6\xd0\x97\xa8\x58\x62\xc1\xce\x88\x80\x37\xb6\x27\x66\x21\x15\x16\xaa\x28\x93\x28\x68\x40\x4a\x60\x5f\x32\xac\x30\x74\xd0\x8d\xf5\x4c\xa0\x79\xf7\x03\x3d\xdb\xa7\x4b\x99\xf1\x4e\xf3\x84\xcd\xbc\xe2\xcc\x3a\x82\x98\x3a\x58\x29\xec\x0e\x0c\x34\x61\x1a\x17\x90\xb3\xbc\x4c\x52\xfc\xe0\x8d\xd1\x5e\x59\xa4\x7f\xf2\x95\x78\x8e\x90\x4e\xf4\x4f\x47\xb2\x71\xff\x95\xd1\x5e\x86\x4d\x2e\x2b\x9a\xea\xc8\x33\xec\x92\x01\x67\x1e\x11\xe1\x64\xec\x06\xd0\x9e\x22\x46\xf0\x23\x41\x64\x14\x00\x0b\xc5\x11\x1e\x2b\xde\x8f\x3f\xfc\x18\xd7\x61\x29\x68\x9c\xce\xf8\x96\x9b\x0b\x79\x05\x99\xb1\x8f\x40\x66\x56\xad\xc3\xdb\xda\x3a\xec\xd2\x3a\xfc\x7a\x90\x76\xc1\xae\x98\x5d\xd0\x2d\xa8\xed\x82\x81\x5d\x30\x87\x6b\xad\xf4\x2b\x24\x10\xab\x4a\xff\xbc\x56\xfa\xbb\x54\xfa\x3f\x45\x49\x04\x87\xa7\xf7\xdb\xd5\x82\xf9\x45\x0d\xf3\x5d\xc2\xfc\x8e\x9b\x72\xad\x12\xc6\x3b\xd5\xc2\xf8\xbb\x1a\xe3\xbb\xc4\xf8\xef\x52\x67\x8e\x1c\xa2\x0f\xff\xb6\x5a\x38\xbf\xac\x71\xbe\x53\x5d\x0e\xfd\x3e\xe1\xc2\x3b\x3c\x9c\x9f\x57\x0b\xe7\xef\x6b\x9c\xef\x12\xe7\x9f\x66\x89\xb5\x87\x87\xf4\x8b\x8a\xcd\xca\xc4\xb9\xd6\xf5\xc4\x8c\xc1\xc4\xcc\xd7\x3b\xc4\x05\x1a\x70\xa9\xfc\x7a\x8a\xe6\x10\x63\xd7\x35\xe9\x18\xc7\x9e\x67\x2d\x1e\x06\xe2\x31\x75\xd3\x4f\x11\xed\x21\xec\x3f\xd7\x02\x72\x70\x01\xc1\x7d\x40\xfd\xc5\xb4\x5f\x09\xd7\xf1\xd0\xe8\xf5\x36\xc7\x2e\x26\xf3\xd5\x71\xd6\xed\xc2\x4a\xb9\xbd\xf2\x72\xec\x23\x98\x9f\xb9\x77\x05\x67\x8c\x78\xff\xa6\xbe\xc7\x27\x31\x54\xe3\x7b\x93\xf0\x9e\x7d\xec\x50\x7d\xdc\x06\x93\x9c\x89\x7b\xe6\x43\x9a\x3c\xac\x7a\xc1\xdc\xbf\x28\x99\xcc\x97\xcb\x3d\xc2\x95\x33\x5f\xa7\x67\x6d\xe2\xb9\xa5\x81\xdd\xe2\xe0\x26\x4b\x1a\x67\x8a\x06\xe9\x96\x56\xaf\x3a\x45\xbc\xb7\xb0\x42\xf1\x14\x71\x9f\x20\x60\x82\x18\xf5\xc9\x15\xea\x81\xa3\x4a\x9e\xf0\x28\x60\xe4\xc3\xc9\x89\xdd\x7e\xd7\x7c\x13\xfe\x3d\x83\x7f\x76\xeb\xf2\x24\x7e\xd6\x74\xf9\xe8\xe4\x4d\x73\xe1\xaa\x4c\xbd\x03\xfa\x44\x98\x74\x70\x97\x3f\x12\x47\xd7\x43\x1a\x47\xfe\x99\x0c\x21\x8e\x04\x94\x6d\x83\x61\xa8\x09\xa3\x54\xc4\x92\x9c\xc2\x64\xca\xed\xb0\xc2\xae\x1e\x45\xe9\xc0\x08\xe7\x0c\xfd\xcd\x8c\x86\x81\x92\xad\x96\xf5\xb9\xa8\xd6\x6c\x92\x7d\x56\x4f\x27\xed\x72\x3a\xe9\x1f\x58\xc1\x28\xf9\x7d\xf4\xf5\xee\x00\x27\x94\xde\x55\x2c\x64\x1e\xc5\xa3\xe1\xd0\xa0\xca\xb3\x4a\xc6\x36\x78\xb4\x80\xbe\x53\x24\x49\x80\xa3\xdd\x3a\xba\xcf\x08\xcc\xe9\x08\xcb\x26\xfa\x1a\x86\xc3\x50\x00\x51\x1f\xa9\x01\xd1\x0f\x82\xb1\x02\x63\x1d\x12\x13\x39\x7d\xac\xb7\x8f\x00\xd2\x01\x11\x10\x44\xab\x01\x95\x28\x5c\x64\x8f\x26\x94\x31\xd4\x25\x40\x46\x50\x18\x1f\x93\xe6\xc9\x09\xbc\x0f\x41\x63\xe1\x3e\x22\x32\x20\x2e\xed\x51\xe2\x4d\xdf\xd0\x03\x5f\x97\x4f\x74\xb5\xc0\x13\x80\xb7\xc8\x0f\x27\xf6\xfb\x76\xd3\xbe\xb8\x6c\xda\xe0\x00\x74\xda\x0b\x97\x67\xad\xf6\xdb\xfd\x9f\x5d\xc8\x2f\xe5\xf5\xcc\x42\x85\xf4\xc8\x9a\xd1\xac\x57\x4c\xec\xd4\x68\xfe\xed\xe1\xe1\xee\x3e\x52\x2f\x87\x67\x33\x2f\x2b\x66\x33\x07\x4a\x05\x72\x69\x43\x95\x83\x34\x99\x77\xa1\x31\x1b\x4b\x30\x53\x3a\x3e\x0d\x21\x78\x3a\xb7\x58\xda\x4e\xa1\xff\x00\x53\xc5\x5d\xce\x3e\xb4\x5a\xff\x8d\x3f\x4e\x7d\xd0\x73\x8b\x57\x88\x2a\x4d\x2b\xc3\xd2\x52\x6f\xd0\x33\x9d\x9d\x07\xf3\xe9\xa1\x20\xfe\x78\xde\xdc\x7f\x53\xf6\x6d\x79\xb1\x07\x34\x23\x6c\x93\xde\xbc\x2b\x8f\x44\xd6\xf6\xad\x42\x32\xbf\x66\xdf\xea\x35\x1f\x3b\xb5\x6f\x9f\x1f\xee\x0e\xd5\xba\xbd\xaf\x98\x75\xeb\xa9\xe0\xe8\x6c\x1b\xc0\xaf\xb6\x6c\xb5\x65\xb3\x8f\x40\xde\xd7\x2c\x5b\xbd\xb0\x65\xb7\x6b\xdd\x7f\xfb\xf8\xf7\x83\x8d\xdc\xec\xca\xad\x76\xe7\xee\xf0\xf8\x42\xb7\x10\x83\xb5\x81\x43\x3e\x0f\x1b\x86\x30\xca\x2d\x95\xb5\x8d\xab\x92\xdc\xaf\x19\xb9\x63\x5f\xd6\x96\x6e\x9f\x3e\x0a\xe2\x81\x6e\xa4\x98\x35\x24\xee\x1d\xe2\xc2\xfb\x3d\x5f\x68\x7c\x3f\xa1\x0a\xea\x19\x65\x3d\x85\xbf\x1d\x77\x36\x26\x8e\x1e\x93\x43\xb6\x54\xbf\x44\x5a\x79\x22\xa8\x8a\x82\x8f\xa9\x75\xd1\xd6\x0a\x74\xbc\xb6\x59\x40\x3b\xb5\x5d\xa0\x4b\x41\xb1\xe2\x68\x9b\x6f\x49\x94\x82\xf1\x92\x57\xeb\x8f\xe2\x50\x86\x0a\xc4\x27\x3e\x14\x84\x1e\x55\x68\x44\xf4\x7e\xda\x54\x8e\xc2\x8c\xd7\x47\x4c\x99\xae\xc8\xa9\xce\x12\xc7\x88\x71\x17\x94\x3b\x88\x58\xc0\xa9\xaf\x80\x18\x4c\x24\x58\xc5\xc8\x08\xce\x07\x44\x36\xcb\x4a\x71\x0e\x3f\xb3\x52\x26\x61\xcf\xc5\x6c\x71\x7f\xc8\x76\xa5\xac\x41\xc1\xf4\xbb\x35\xdf\x77\x2a\x59\x07\x92\x76\x57\xc8\x8b\x4c\x6d\x1c\x65\x54\x3d\xe7\xce\xe7\x5a\x67\x46\xfd\xb8\xaf\xf4\xde\xfd\xc2\xba\x7d\x53\x2a\xa1\xce\xc4\xc7\x9d\x2e\x43\xcc\x9b\xc7\xff\x12\x3e\xad\x79\xce\x9b\xa9\x22\x4b\xea\x26\xa3\xfd\x7e\x4d\x18\x99\xed\x7b\xbc\x71\xd4\x4c\x76\xf8\x2e\xa0\x16\x0d\x53\x03\x4b\x24\xec\xf2\x7e\x9f\x91\xc5\x05\x03\x2a\xbc\x13\x23\x40\x0e\xf8\xe4\xd0\x94\x48\xfe\x7d\xc2\x4b\x7b\x3c\xf7\xd0\x8d\xad\x01\xf5\xe6\xae\x4e\x71\x89\x8d\xc6\x67\xf5\x38\x91\xd4\x41\x73\x66\xe5\x73\x49\xb0\x29\xa2\x92\x51\xf5\x75\x84\xfb\xf1\xb6\xc4\x54\xff\xb4\x2d\x13\x3e\x5b\x84\xcf\x36\x8d\x71\x1a\xbf\x78\xa7\x78\xbd\x41\xbe\x20\x7a\x63\xe7\x86\xe2\x10\x4f\x3d\x12\x2f\x1f\x67\x53\x65\x69\xa8\x09\x8e\x5a\xfb\xda\xaf\xa1\x7d\xab\xe4\xb2\xbf\xd8\x32\xff\xec\x4e\xc9\x24\x8e\x8f\xa3\x89\xce\x6f\x8a\x8f\xa1\x30\xde\x4a\x7a\x75\x1a\xa8\x5d\xb5\xcd\xa4\x41\xb4\xbc\x82\x7b\x27\xa7\xcf\xf8\x44\x47\xd4\xb1\xcd\x4c\x0b\x0f\x5b\x16\x61\x36\xf8\x4b\x29\x97\xe2\x0a\xa5\xb0\x0b\x97\x21\x1b\x29\xfd\x93\xdc\x37\x19\x27\xbb\xc4\xd8\x0f\x97\x9b\x9c\xe4\x45\xfc\xde\x9e\x84\x50\x02\x9e\xe5\xcf\x45\x81\xd7\x39\x06\xe7\x7d\xec\xe6\x1c\x9e\xd5\x63\x1a\xda\xfb\x3a\x44\xe6\x3b\xd9\x97\xd8\xc1\xbe\xf0\xce\xf5\x65\x76\xac\x2f\x3c\x95\x50\x78\x59\x66\x89\x23\x18\x4a\xac\xe2\xcc\x73\x7a\xc8\xe6\x45\xb8\xed\x17\x3e\x4f\xa4\x08\xf9\xf1\x1e\x27\xb2\xe3\x53\x39\x52\x16\xf3\x66\x9d\x7c\xf9\xd2\x5e\x56\x51\x16\x49\xeb\x60\x3b\x25\x18\x2d\xad\x7f\x2d\xc4\x68\x69\xdd\x6b\x21\x0e\xcb\xeb\x5d\x0b\xb1\x88\x4e\x24\xb5\x6e\x37\x9d\x73\xfa\x2a\x7e\x64\x2a\x91\x61\xbc\x60\x10\x2b\x9c\x55\xe1\xf8\x24\xc3\x00\xc1\xdc\xfb\xfa\x2d\xce\xfc\x43\xd3\x51\x46\xd1\x28\xa3\xef\xbf\x63\xea\x0a\xa3\x81\x20\x3d\x30\x98\x7a\x05\xc4\x87\x56\xab\x4f\xd5\x60\xdc\xd5\x0b\xd1\x5b\x7c\x48\x18\x51\xad\xc5\xc3\xac\x5b\x13\x3a\xa4\xad\xfb\x90\x5e\x5a\xdf\xf5\xd5\xd5\x80\xb0\x40\xf3\x69\x61\x7d\xf5\x43\xde\x6a\xeb\x33\xf9\x00\xd3\xc3\x71\x60\xd2\xdb\xb9\xd1\x53\xde\xb1\xdc\xf2\x11\x78\xdb\x09\x01\x2a\x18\x03\xdc\x6f\xd0\x2c\x2f\x34\x52\x7b\x1e\x39\x74\xea\xc8\xa1\x8e\x1c\xaa\x10\x39\x74\xea\xc8\xe1\x88\x23\x07\x41\xc8\x42\xe4\x00\x57\x8b\x91\x43\x90\xb9\xed\xc0\x3e\x86\x0b\x1b\x3f\xf0\xc5\xfb\x07\x2c\xe4\x23\x21\x2a\x11\xf5\x5d\x36\xf6\x88\x77\x1a\x65\x39\x85\x3e\x16\xdc\xe6\x3e\x8b\x8b\x12\x4f\xa7\xa8\xea\x87\xd3\xa2\x4b\x29\x4d\x57\x7a\xfb\xa0\xf5\xdb\x08\x0b\x82\x7c\xfd\xc9\x09\x45\x5e\x57\xb8\x95\xc1\x7c\x33\x82\xe6\xc9\x49\x7c\xb4\xc2\x29\xd2\xc9\xbd\x61\x06\xef\x7c\x23\x22\xf4\x7d\xc2\x16\x08\x3f\x20\x41\xc2\xd4\x83\x85\xaa\x72\x88\x66\x12\x92\xb0\xae\xe2\xa3\xb0\xe0\xf6\x68\x7d\x21\xc9\x9c\xb4\x59\xe8\x10\x42\xee\x69\xf7\x5d\x6f\xb3\x20\x15\x17\x64\x01\x33\x45\xd8\x11\x5f\x0f\x92\x23\x09\x16\xfa\xeb\x87\xf9\xa4\x7c\xe4\x83\x51\x5f\xe9\x24\x68\xd6\x08\x2f\x41\x6c\xc0\xdf\x75\x23\x17\x22\x57\x22\x92\x96\x87\xfb\x19\xed\x92\x50\x34\x66\x3c\xed\xac\xaf\xb2\x3b\xc9\x8f\x9a\x8a\xe9\xc7\xf0\xcc\xc5\xe5\x7a\x45\xe7\x30\xe6\xdd\x84\x4d\xd2\x3f\xc3\x53\x1b\xf5\xe9\x68\xf0\xbb\x78\x0e\x21\x55\x20\xf2\x89\x87\x86\xcc\x81\xb8\xeb\x6c\xaa\x8f\x84\xb1\x7f\x12\xdf\x23\x82\x88\x87\x50\xf2\x75\x07\xb9\x70\x57\xc4\x77\xb5\x3e\xb0\x0d\xd2\x61\xb0\x52\x82\x76\xc7\x8a\x48\x83\x8f\x9b\xb3\xc2\xd3\xbe\xd0\xaf\xd6\x2e\xeb\xec\xc1\xe6\x4f\x9b\xa6\x2f\xdc\xca\xd7\xcd\x57\xc2\x66\x7b\xef\xb0\xf9\x35\x56\xe2\x3f\xbe\x28\x32\xc3\xfc\x8e\x04\x6c\x86\xf7\x4d\x32\x2f\x0c\xf2\x4b\x66\x5a\xd8\x99\x1a\xaa\x82\x79\x25\xc6\xb9\x09\xa5\x44\x26\xda\x81\x27\xdc\xcc\xb8\x16\x9a\x25\xa1\xe9\xec\x9d\xd0\x7c\x7a\xda\x3b\xa1\x69\x6f\x5b\x68\xa6\x6e\x5c\x25\x84\xa6\x53\x0b\xcd\x8a\xd0\xe4\xdd\x08\x02\x5c\x6f\xfa\x27\x2e\x9d\x3d\x9e\xe1\x04\xe5\x39\x39\x6d\xd7\x0e\x50\xbb\x40\x5e\x28\xf1\xa8\xc2\xc5\x12\x43\x97\xe4\x4e\xf3\x49\x17\xbb\x78\x85\xa3\x33\x2d\xb5\x87\x42\x17\x39\x77\x6f\x6b\x91\x5b\x11\xb9\xf3\xbd\xb3\x53\xe6\xc7\xb8\xed\x5a\xe0\x3a\x7b\x2b\x70\x7a\xb6\x61\xff\xa5\xed\xbc\x96\xb6\x15\x69\xbb\xd8\x37\x03\x97\xf7\xfc\xa1\x5d\x8b\xdc\xdb\xbd\x15\xb9\xf9\xbc\xde\xfe\x0b\xde\x45\x65\x05\xaf\x9a\xe9\x0c\xf6\x51\xa6\x33\xdc\x11\xb1\x34\x11\x3f\x5d\x32\xbc\xc7\x99\x03\xed\xfd\xc9\x1c\x68\x57\x2f\x73\x60\x61\x4a\x58\xbe\x4c\xfe\x40\xfb\x95\xf3\x07\x92\xda\x92\xdc\x8e\x42\xc9\xf5\xf9\x93\xea\x73\xa7\x5a\x24\xb4\x75\xa5\x9d\xcb\x6d\x5c\x78\x38\x7f\x70\xdd\x0a\x3f\xd0\xf4\xb0\x0b\xe6\xe4\xff\xd1\xba\x07\xff\xb2\x9f\x00\x00")
+
+func assetsProxyGladeBytes() ([]byte, error) {
+	return bindataRead(
+		_assetsProxyGlade,
+		"assets/proxy.glade",
+	)
+}
+
+func assetsProxyGlade() (*asset, error) {
+	bytes, err := assetsProxyGladeBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "assets/proxy.glade", size: 40882, mode: os.FileMode(436), modTime: time.Unix(1792417490, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _assetsProxyGlade2 = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xec\x5d\x5f\x8f\xdb\x36\x12\x7f\xf7\xa7\xe0\xe9\xa1\xb8\xcb\xc5\xf6\x7a\xb7\x49\xb6\xe9\xae\x8b\x16\xd7\x04\x01\x7a\xbd\x00\x9b\xde\x3d\x0a\xb4\x44\x4b\xac\x69\x51\x27\x52\xfe\xf3\xed\x3b\xfa\x63\xaf\x6c\x91\x12\x25\xd9\x59\xdb\x31\x02\x24\x92\xc5\x19\x92\x33\xbf\x19\xce\x50\xd4\xe4\xe1\xa7\xd5\x9c\xa1\x05\x89\x04\xe5\xc1\xa3\x35\x1a\xdc\x58\x88\x04\x0e\x77\x69\xe0\x3d\x5a\x7f\x7c\xf9\xd0\xbf\xb7\x7e\x1a\xf7\x1e\xfe\xd6\xef\xa3\x8f\x24\x20\x11\x96\xc4\x45\x4b\x2a\x7d\xe4\x31\xec\x12\x74\x37\x18\xdd\x0f\xee\x50\xbf\x0f\x8d\x68\x20\x49\x34\xc5\x0e\x41\x2e\x9f\x63\x0a\xfc\xc2\x88\xaf\xd6\x8e\x8f\x03\x8f\x44\xd6\xb8\x87\xd0\x43\x44\xfe\x1f\xd3\x88\x08\xc4\xe8\xe4\xd1\xf2\xe4\xec\x9f\xd6\x73\xf7\xc0\xec\xd6\x1a\xa6\xed\xf8\xe4\x4f\xe2\x48\xe4\x30\x2c\xc4\xa3\xf5\x51\xce\x7e\x76\xff\x8c\x85\x9c\x93\x40\x5a\x88\xba\x8f\x16\xde\xde\x8f\x52\xce\x40\x03\xbd\x85\x24\x92\x6b\x14\xe0\x39\x79\xb4\x18\x5f\x26\xbd\x8e\x1e\x86\x9b\x07\xea\x76\x71\x18\x26\xed\xde\xbe\x79\x73\xf7\xa6\xae\xed\x02\xb3\x98\x58\xe3\xfb\x9b\xfb\x9b\xba\xa6\x42\x92\xd0\xa6\x81\x13\x91\x74\xd0\xf5\xe3\x08\xb1\x47\x76\x08\xf6\xba\x78\x18\x66\x42\x51\xcb\xe7\x0b\x59\xc9\x5f\xe2\xe9\x14\xa6\x92\xca\x47\xc2\xfd\x24\xbd\xb7\x53\x2d\xd8\xd8\x91\x74\x01\xea\xb3\x85\x13\xd1\x50\x6e\x84\x26\xa8\x17\x60\x96\x0f\x21\x53\x95\x6b\x21\xf8\xd7\x65\x24\x7a\xb4\x78\x60\xeb\x39\x6d\x9b\x8b\x25\x06\x21\x42\xaf\x01\xcf\xf5\x57\x39\xd6\x7f\x51\xcc\xb8\x97\x8d\xd3\x4d\xaf\x33\xce\x1a\x45\x3a\x38\xb0\xa7\xdc\x89\x85\x35\xfe\x80\x99\x20\x75\x82\x94\xeb\x90\xd8\x3e\x4d\x64\x98\x71\x2f\x11\x38\x3e\x65\x2e\x4a\xf1\x0a\x93\xef\xa7\xb7\xa0\xdc\x09\x5f\xe5\x43\x50\x8d\xfa\x17\x78\x5a\x18\x72\x3f\x69\x3e\xda\xb6\x6f\x3e\x6c\x15\xcd\x1c\x47\x1e\x0d\x6c\x46\xa6\x30\xf8\x12\x1a\x2b\x28\x22\xea\xf9\x0d\x49\x24\x0f\x9b\x11\x4c\xb8\x94\x7c\x6e\x48\xc3\x23\x0a\x28\xc6\x12\x0c\xdb\x1a\x83\x85\x4b\xea\x60\x66\x42\x28\x42\xec\x80\xf7\xd1\x75\xa3\x56\x5d\x02\x49\x80\x2a\x8e\x08\x2e\x68\x44\xa9\xc5\x18\x26\x11\xec\xeb\xb2\x40\x3f\xda\x61\xd0\x4e\xad\x4a\x77\x84\xd7\x3c\x96\xb6\x90\x6b\x06\x1e\x84\x04\xae\x96\x30\x9d\xd3\xee\x6f\xfa\x99\x64\xd3\x98\xa4\xd7\x36\x9f\x59\xfb\x74\xaa\x81\x4c\x08\xb3\xc6\xe0\x7b\xfb\x7c\xa6\x1b\x84\x8a\x70\x41\x05\x9d\x24\x83\xff\x12\xc5\xda\x69\xd7\x88\xac\x29\x69\x44\x1c\x42\x17\x44\xd8\x2e\x99\xe2\x98\xc9\xe6\x1c\x62\x01\x2e\x4f\x72\x67\x66\x40\xba\xeb\x0c\x19\x75\x66\xfb\xce\x70\x2b\x68\x7b\xfb\xb8\xe4\xfc\x76\x58\x16\x1c\xe1\xee\x28\xb1\x33\x03\x98\xd7\x8f\x9f\xac\x42\xe8\xbf\xf9\xbc\xa7\x94\xb1\xe6\x54\x21\x17\x34\xb3\xd9\xd2\x02\x57\x9c\x94\x72\xf4\x0f\x43\x05\x72\x3b\xa0\x19\x60\xe3\x24\x48\x6d\x82\xe8\x8c\xe6\x8a\xea\x22\xa9\x31\xaa\x33\xe1\x5d\x3c\xb2\x4b\x91\x58\x71\x52\xa6\xc8\x56\x4d\x5f\x3d\x75\xdd\xb4\x1b\xad\x1e\xd9\x9c\x1b\x91\xd4\x9a\xb2\x62\xb2\xa5\x89\x96\xcd\xb7\x6c\xba\xbf\x73\x49\x26\x1c\xd6\x9e\xd4\x78\x83\xfc\xae\x6e\x1d\x35\xb2\xbb\x96\x36\xb7\x4f\xe6\x9b\x40\xad\x34\x40\x23\x22\x53\xf7\xb6\x09\x38\xf6\x82\xc6\x4e\xd2\xa9\x91\x50\x25\x5a\x54\xb4\xc6\x31\x5b\xf5\xec\xd5\x12\xf8\x10\x41\x1f\x99\x0c\xa6\xc9\xa5\x4a\x0a\x9d\x24\xd1\x55\x1a\x2a\xfa\xfa\x78\xdc\x80\xba\x2a\x36\x37\x20\xd7\xc7\xe9\x06\xc4\x95\x31\x7b\x15\xbd\x91\xc9\x68\x75\xd6\x85\x38\x5d\xcd\xed\x15\x66\xb0\x6c\x55\x86\x21\x6a\x72\xe1\x63\x97\x2f\xed\x24\x09\xb4\xc6\x34\xa8\x25\xd7\x42\x38\x7d\x5a\x82\xf1\xc7\x88\xba\x19\x8a\x3d\xb8\xd2\x81\x58\x29\x94\x26\x40\x56\x31\x68\x0a\x66\x15\x8f\x66\x80\xae\xe0\x60\x0a\xea\x0a\x16\x66\xc0\xae\x60\x60\x0c\x6e\x15\x8f\x08\x40\x52\x9d\x6b\xd6\x71\x70\x38\x8b\xe7\x41\x53\x26\x95\x78\x4b\x5b\x94\x30\xf7\x5b\x1a\xdf\xa6\xa0\x4b\x8d\xe3\xae\x02\x75\xaa\x81\x36\x46\x9e\x72\xb6\x2d\xd0\xa7\xe2\xe3\xe7\x86\x5d\x91\x01\xd7\xce\x27\x67\x21\x24\x8e\x64\x5b\x26\x59\xd2\x80\x64\x84\x03\xc1\xb0\xc4\x20\xa0\x47\x6b\x4d\x60\x7a\x4f\x3e\x8f\x64\xda\xca\x8c\xb7\x2e\x12\xde\xed\x5d\x17\x15\x57\x8e\x11\xcc\xd4\xc6\x52\x62\xc7\x37\xf0\x84\x3a\x2e\x60\x67\x4d\x99\x68\xe2\xe0\xdd\x26\x35\x48\x6e\x83\xf4\x5f\x03\x19\xad\x33\xa4\x93\xe4\xd2\x16\x2c\xf6\x5e\x18\xed\x5d\xd8\x34\x5a\x45\x75\x4c\x42\x86\x1d\xe2\x73\xe6\x92\x28\xdd\x8c\xad\x01\xed\x6b\xc4\x08\x5e\x10\x44\xe6\x21\xb0\x90\x1c\xe1\x58\x72\x2f\xdf\xbc\x37\x1e\xc3\x4e\xd2\xb8\xd9\xf1\xed\xb6\x17\xb2\xc3\xff\xeb\xd8\x4c\x45\xaa\x57\xcd\xe5\x8c\x6c\x66\x7f\x75\xf8\xfe\xba\x3a\x1c\x73\x75\xf8\xfd\x22\xd7\x05\x43\x43\x39\x99\x75\x21\x99\xc1\x75\x5d\x30\x58\x17\xcc\xe1\x7a\x75\xfa\x67\x64\x10\xfb\x4e\xff\xcd\xd5\xe9\x1f\xd3\xe9\xff\xec\xba\x11\x11\xe2\xf2\xfc\xfe\xed\x79\xc1\xfc\xed\x15\xe6\xc7\x84\xf9\x67\x6e\xca\xf5\x9c\x30\x7e\x77\x5e\x18\x7f\x77\xc5\xf8\x31\x31\xfe\x87\x48\x4e\x8e\x5c\x62\x0c\xff\xfd\x79\xe1\xfc\xfe\x8a\xf3\xa3\xfa\x72\x90\xfb\x92\x47\x86\xc3\x3b\x27\x9c\x1b\xed\xb3\x9f\x0e\xce\x7f\xb8\xe2\xfc\x98\x38\xff\x75\xe5\x90\x30\x79\x65\x7d\x81\xd1\xf9\xdb\xd3\x46\x7a\x69\x57\x06\x67\x69\xd2\x75\x63\xc6\x60\x63\xe6\xd3\x67\xc4\x23\xe4\x73\x21\xcd\xa3\x91\xeb\x16\xcd\x19\xe5\xae\x25\xeb\x88\xf3\xc8\xf3\x6a\x1e\x06\xe6\xb1\x09\xd3\x5f\x23\x3a\x45\x38\x58\x5f\x0d\xc4\x8c\xcb\x19\x25\x04\x4f\x21\x0d\x8a\xc7\x7e\x05\xdc\xe7\xaa\x09\x79\x24\xbf\x75\x33\x79\xfe\xc2\xc9\x1a\x17\xbe\x76\x3a\xa9\x28\xe7\x20\x30\x3d\xf1\xfd\x99\x27\x27\xe2\x8c\x11\xf7\x7f\x34\x70\xf9\x32\x87\x6a\xfe\xdb\x32\xfd\xad\xea\x14\x98\x6a\xf2\x17\x07\xd5\x46\xe7\xfd\x74\x4c\x1a\x1e\xdc\xdb\xb2\xa9\x55\x69\xda\x4a\xf9\xc1\xdc\x7f\x29\x59\x3e\x7f\x2e\xb7\x80\x3b\x9b\x6c\xd3\x89\x1a\xa5\x2a\xe5\xd0\x46\xb1\x2a\x46\x2d\x95\xab\x62\x25\x39\x67\x92\x86\xfa\x95\xf6\x37\x2a\x24\xe2\x53\xf4\x3c\xf3\xd7\x88\x07\x04\x01\x13\xc4\x68\x40\x7e\x44\x53\x08\x54\xc9\x0a\xcf\x43\x46\xde\xf7\x7a\xa3\xdb\x77\x83\x57\xe9\xdf\x37\xf0\x67\x34\xbc\xef\xe5\xcf\x06\x0e\x9f\xf7\x5e\x0d\x0a\x77\x5d\xc6\x1d\xd2\x15\x61\xc2\xc6\x13\xbe\x20\x76\x32\x0e\x61\x9c\xf9\x57\x32\x84\x3c\x12\x50\x76\x08\x86\xa9\x27\xcc\x8e\x22\x76\xe4\x94\x1e\xa6\x3c\x0c\x2b\xec\x24\x5a\x14\x36\x68\xb8\x61\xea\x6f\xb6\x68\x18\x38\xd9\xf3\x5a\x7d\x4e\x3c\xc7\xde\xdf\x4d\x1a\xdd\x5c\xb7\x93\x8e\xb9\x9d\xf4\x6f\x2c\x41\x4b\x81\x87\x3e\x7d\xbe\xc0\x0d\xa5\x77\xa7\x0d\xf6\x52\xca\x3c\xcf\xb5\x61\xd3\xf0\x9c\x77\x95\x8c\xd7\xe0\x79\x01\x7d\xaf\x91\x20\x21\xce\x2a\x2e\x4c\xd6\x08\x96\xd3\x39\x16\x03\xf4\x29\x4d\x87\xa1\x01\xa2\x01\x92\x3e\x49\x1e\x84\xb1\x84\xc5\x3a\x25\x26\x62\xf3\x98\x01\x4f\x20\xf5\x49\x04\x49\xb4\xf4\xa9\x40\x61\xf2\x91\x3d\x5a\x52\xc6\xd0\x84\x00\x19\x41\x69\x7e\x4c\x06\xbd\x1e\xf4\x87\x60\xb2\xf0\x3b\x22\x22\x24\x0e\x9d\x52\xe2\x6e\x7a\x98\x42\xac\xcb\x97\xc9\xb0\x20\x12\x80\x5e\xc4\xfb\xde\xe8\x87\xdb\xc1\xe8\xed\xfd\x60\x04\x01\xc0\xdd\x6d\xe1\xf6\x66\x78\x6b\x98\x76\xbe\xe4\xee\x42\x73\x2b\xbf\xee\x2c\x9c\x91\x1f\x29\x7e\x6d\x77\x7b\x56\xeb\x65\xcb\x64\xa6\xe4\x38\xc3\xfc\xed\xdf\x85\x24\x31\xad\x3c\x83\x76\x72\x94\x51\xb9\x6e\x1c\x1d\x97\x99\xd1\x20\x97\x55\x52\x09\x25\xb2\xc6\xaf\x3a\xa5\x27\x26\x7e\x6b\xf3\x52\xb7\xe9\xae\x68\xda\xe1\x91\xfd\x57\xda\x87\x51\x06\x61\xea\xc7\x54\x62\x32\xfa\x7a\xda\x84\x91\xd9\x57\xe4\x75\x5c\x8c\xea\x25\x94\x98\xd4\xbb\xc5\xac\x99\x81\x37\x68\xbd\xfd\xc1\x3d\x8f\x91\xe2\xf6\xab\x4c\x7f\xc9\x11\x20\x7c\x48\x92\x2f\xcc\x89\x34\xaf\xba\x50\xc7\xb1\x36\xae\x7b\x02\x31\x0e\x7d\xea\x12\x14\x36\x3a\x8e\x91\x77\xb7\x63\xb1\x99\x7e\xf6\x8b\x33\x69\x95\x66\x6f\xdb\x37\xb2\x60\x53\x44\xa5\x2d\x4b\xa8\xfa\x34\xc7\x5e\xfe\x91\x37\x4d\x2e\xeb\x76\x46\x75\x82\x6d\x0d\x1f\x15\xb3\xb6\x8b\xb1\x8e\x5f\x5e\x77\x23\x29\x37\x12\x91\xe4\x33\xf9\xbe\xe4\x7d\x81\x17\xa4\x91\x72\xcd\x9d\xa5\xa1\x27\xf8\xa6\xbd\xaf\x71\x54\x7b\x48\xef\x7b\x4e\x11\xfb\x57\x3b\x34\x55\x2d\x94\x4a\xe2\xbc\xb8\x57\xf2\x06\x60\x5b\xd4\x47\xd7\x4b\xed\x46\x59\x55\xdc\xdf\xd9\xdd\x1c\xc2\xc5\xec\xf3\x00\xd3\x72\x5b\x7e\x89\xae\xdf\xce\xca\x8a\x36\x56\x16\xf1\xc8\x59\xb6\x55\x5b\x15\x61\x35\xf8\x3b\x39\x97\xf6\x0e\xa5\x75\x08\x57\x61\x1b\x1a\xf9\xa8\x65\x53\x51\x27\x2b\xc7\x7e\xba\x79\xbf\xcf\xab\x7e\x6b\xf8\x54\xeb\xca\x74\x80\x67\xf7\x2a\x53\xd0\x9d\x6d\x50\x3d\x49\xab\xde\x4e\x55\xcd\xf6\x8b\xde\xa8\x9c\xd2\x49\xa8\xc8\xbc\x2e\x88\x86\xd2\xa4\x1e\x88\x86\xb4\xbe\x0e\x88\x86\xd0\xa8\xfe\x47\xeb\xad\x84\xd6\x2f\xb9\x95\xf0\x37\x2b\x68\xd3\xe1\x9d\x78\x93\x5a\x4c\xf5\x47\x1a\x74\xab\x67\xa7\x95\xb3\x63\x6e\xd7\x1e\xb3\x15\xd4\x67\x59\x9c\xe9\xc8\x35\x8e\x34\x47\x23\xc2\x8a\x3a\xc2\x26\xa3\x3e\x64\x94\xd5\x96\x85\xea\x54\x81\xd1\xf9\x23\x93\xd3\x04\xad\x18\xed\x9c\x22\x68\xc5\x61\xf7\xf4\x40\x2b\x16\x59\x7d\x67\x6b\x5c\x57\x35\xfa\x45\xe2\x48\x2d\x91\x61\xbe\x60\x90\x2b\xe8\x5e\xaa\x1f\xcb\xdd\xb5\x0a\x71\x0d\x13\x04\xf3\xe8\xeb\x3f\x41\xfe\x4a\x70\xa3\x65\x94\x69\x19\xfd\xfd\x3b\x26\x7f\xc4\xc8\x8f\xc8\x14\x16\x4c\x29\x43\xf1\x7e\x38\xf4\xa8\xf4\xe3\x49\x72\xac\x67\xc8\x67\x84\x11\x39\x0c\x0b\xe5\xdd\x87\x4b\x3a\xa3\xc3\xa7\x94\x5e\x58\xdf\x79\x40\xbf\x8e\x5d\x9c\x30\x1a\xe2\xe4\xf6\x1f\x4d\xc7\x9d\x94\x38\x05\x50\xcf\xe2\xd0\x44\xdc\x8d\xe1\xd3\x3d\xb2\x3c\x70\x45\xd1\xc3\xe4\x00\x67\x98\x04\x3c\xd5\xb8\x96\xaf\xa4\xa9\x97\x4d\x1d\x0a\xaf\x7f\x86\x07\x81\x4a\x2b\x86\x27\x36\xac\x17\x29\xb2\xdb\xa4\x46\x6c\x2d\xb0\x14\xc8\xd8\x9b\xe7\xee\x1c\x0b\x0f\x9f\x1f\x3c\x0c\xb7\xff\xb5\xc6\xb8\xf7\x57\x00\x00\x00\xff\xff\x15\xc7\xf2\xdc\xb3\x63\x00\x00")
+
+func assetsProxyGlade2Bytes() ([]byte, error) {
+	return bindataRead(
+		_assetsProxyGlade2,
+		"assets/proxy.glade~",
+	)
+}
+
+func assetsProxyGlade2() (*asset, error) {
+	bytes, err := assetsProxyGlade2Bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "assets/proxy.glade~", size: 25523, mode: os.FileMode(436), modTime: time.Unix(1517005512, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+// Asset loads and returns the asset for the given name.
+// It returns an error if the asset could not be found or
+// could not be loaded.
+func Asset(name string) ([]byte, error) {
+	cannonicalName := strings.Replace(name, "\\", "/", -1)
+	if f, ok := _bindata[cannonicalName]; ok {
+		a, err := f()
+		if err != nil {
+			return nil, fmt.Errorf("Asset %s can't read by error: %v", name, err)
+		}
+		return a.bytes, nil
+	}
+	return nil, fmt.Errorf("Asset %s not found", name)
+}
+
+// MustAsset is like Asset but panics when Asset would return an error.
+// It simplifies safe initialization of global variables.
+func MustAsset(name string) []byte {
+	a, err := Asset(name)
+	if err != nil {
+		panic("asset: Asset(" + name + "): " + err.Error())
+	}
+
+	return a
+}
+
+// AssetInfo loads and returns the asset info for the given name.
+// It returns an error if the asset could not be found or
+// could not be loaded.
+func AssetInfo(name string) (os.FileInfo, error) {
+	cannonicalName := strings.Replace(name, "\\", "/", -1)
+	if f, ok := _bindata[cannonicalName]; ok {
+		a, err := f()
+		if err != nil {
+			return nil, fmt.Errorf("AssetInfo %s can't read by error: %v", name, err)
+		}
+		return a.info, nil
+	}
+	return nil, fmt.Errorf("AssetInfo %s not found", name)
+}
+
+// AssetNames returns the names of the assets.
+func AssetNames() []string {
+	names := make([]string, 0, len(_bindata))
+	for name := range _bindata {
+		names = append(names, name)
+	}
+	return names
+}
+
+// _bindata is a table, holding each asset generator, mapped to its name.
+var _bindata = map[string]func() (*asset, error){
+	"assets/config.glade": assetsConfigGlade,
+	"assets/config.glade~": assetsConfigGlade2,
+	"assets/discover.glade": assetsDiscoverGlade,
+	"assets/import.glade": assetsImportGlade,
+	"assets/passphrase.glade": assetsPassphraseGlade,
+	"assets/proxy.glade": assetsProxyGlade,
+	"assets/proxy.glade~": assetsProxyGlade2,
+}
+
+// AssetDir returns the file names below a certain
+// directory embedded in the file by go-bindata.
+// For example if you run go-bindata on data/... and data contains the
+// following hierarchy:
+//     data/
+//       foo.txt
+//       img/
+//         a.png
+//         b.png
+// then AssetDir("data") would return []string{"foo.txt", "img"}
+// AssetDir("data/img") would return []string{"a.png", "b.png"}
+// AssetDir("foo.txt") and AssetDir("notexist") would return an error
+// AssetDir("") will return []string{"data"}.
+func AssetDir(name string) ([]string, error) {
+	node := _bintree
+	if len(name) != 0 {
+		cannonicalName := strings.Replace(name, "\\", "/", -1)
+		pathList := strings.Split(cannonicalName, "/")
+		for _, p := range pathList {
+			node = node.Children[p]
+			if node == nil {
+				return nil, fmt.Errorf("Asset %s not found", name)
+			}
+		}
+	}
+	if node.Func != nil {
+		return nil, fmt.Errorf("Asset %s not found", name)
+	}
+	rv := make([]string, 0, len(node.Children))
+	for childName := range node.Children {
+		rv = append(rv, childName)
+	}
+	return rv, nil
+}
+
+type bintree struct {
+	Func     func() (*asset, error)
+	Children map[string]*bintree
+}
+var _bintree = &bintree{nil, map[string]*bintree{
+	"assets": &bintree{nil, map[string]*bintree{
+		"config.glade": &bintree{assetsConfigGlade, map[string]*bintree{}},
+		"config.glade~": &bintree{assetsConfigGlade2, map[string]*bintree{}},
+		"discover.glade": &bintree{assetsDiscoverGlade, map[string]*bintree{}},
+		"import.glade": &bintree{assetsImportGlade, map[string]*bintree{}},
+		"passphrase.glade": &bintree{assetsPassphraseGlade, map[string]*bintree{}},
+		"proxy.glade": &bintree{assetsProxyGlade, map[string]*bintree{}},
+		"proxy.glade~": &bintree{assetsProxyGlade2, map[string]*bintree{}},
+	}},
+}}
+
+// RestoreAsset restores an asset under the given directory
+func RestoreAsset(dir, name string) error {
+	data, err := Asset(name)
+	if err != nil {
+		return err
+	}
+	info, err := AssetInfo(name)
+	if err != nil {
+		return err
+	}
+	err = os.MkdirAll(_filePath(dir, filepath.Dir(name)), os.FileMode(0755))
+	if err != nil {
+		return err
+	}
+	err = ioutil.WriteFile(_filePath(dir, name), data, info.Mode())
+	if err != nil {
+		return err
+	}
+	err = os.Chtimes(_filePath(dir, name), info.ModTime(), info.ModTime())
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// RestoreAssets restores an asset under the given directory recursively
+func RestoreAssets(dir, name string) error {
+	children, err := AssetDir(name)
+	// File
+	if err != nil {
+		return RestoreAsset(dir, name)
+	}
+	// Dir
+	for _, child := range children {
+		err = RestoreAssets(dir, filepath.Join(name, child))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func _filePath(dir, name string) string {
+	cannonicalName := strings.Replace(name, "\\", "/", -1)
+	return filepath.Join(append([]string{dir}, strings.Split(cannonicalName, "/")...)...)
+}
+
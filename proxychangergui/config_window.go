@@ -0,0 +1,987 @@
+package proxychangergui
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/juju/loggo"
+
+	"github.com/gotk3/gotk3/gtk"
+	"github.com/okelet/goutils"
+	"github.com/okelet/proxychanger/proxychangerlib"
+	"github.com/pkg/errors"
+)
+
+type ConfigWindow struct {
+	*goutils.BuilderBase
+	Window *gtk.Window
+
+	InfoBar             *gtk.InfoBar
+	SwitchRunStartup    *gtk.Switch
+	SwitchShowProxyName *gtk.Switch
+	ComboBoxLogLevel    *gtk.ComboBox
+	ListStoreLogLevel   *gtk.ListStore
+
+	SwitchEnableAutoSwitch *gtk.Switch
+	ComboBoxIpNoMatch      *gtk.ComboBox
+	ListStoreNoMatch       *gtk.ListStore
+
+	SwitchUpdateCheck *gtk.Switch
+
+	TreeViewProxies   *gtk.TreeView
+	ListStoreProxies  *gtk.ListStore
+	ButtonProxyEdit   *gtk.Button
+	ButtonProxyRemove *gtk.Button
+
+	TreeViewApps  *gtk.TreeView
+	ListStoreApps *gtk.ListStore
+
+	TextViewOnProxyChangeScript       *gtk.TextView
+	TextBufferOnProxyChangeScript     *gtk.TextBuffer
+	TextViewOnProxyDeactivateScript   *gtk.TextView
+	TextBufferOnProxyDeactivateScript *gtk.TextBuffer
+	TextViewOnProxyActivateScript     *gtk.TextView
+	TextBufferOnProxyActivateScript   *gtk.TextBuffer
+
+	Indicator *Indicator
+}
+
+func NewConfigWindow(indicator *Indicator) (*ConfigWindow, error) {
+
+	var err error
+
+	w := ConfigWindow{}
+	w.Indicator = indicator
+
+	w.BuilderBase, err = goutils.NewBuilderBase(indicator, "assets/config.glade")
+	if err != nil {
+		return nil, errors.Wrap(err, "Error loading asset assets/config.glade")
+	}
+
+	// ------------------------------------------------------------------------------------
+
+	w.Window, err = w.GetWindow("preferences_window")
+	if err != nil {
+		return nil, errors.Wrap(err, "Error getting preferences_window")
+	}
+	w.Window.Resize(700, 600)
+	w.Window.SetPosition(gtk.WIN_POS_CENTER)
+	w.Window.SetIconName(proxychangerlib.ICON_NAME)
+
+	// ------------------------------------------------------------------------------------
+
+	w.InfoBar, err = w.GetInfoBar("infobar_log_level")
+	if err != nil {
+		return nil, errors.Wrap(err, "Error getting infobar_log_level")
+	}
+
+	w.SwitchRunStartup, err = w.GetSwitch("switch_run_startup")
+	if err != nil {
+		return nil, errors.Wrap(err, "Error getting switch_run_startup")
+	}
+
+	w.SwitchShowProxyName, err = w.GetSwitch("switch_show_proxy_name")
+	if err != nil {
+		return nil, errors.Wrap(err, "Error getting switch_show_proxy_name")
+	}
+
+	w.ListStoreLogLevel, err = w.GetListStore("liststore_log_level")
+	if err != nil {
+		return nil, errors.Wrap(err, "Error getting liststore_log_level")
+	}
+
+	w.ComboBoxLogLevel, err = w.GetComboBox("combobox_log_level")
+	if err != nil {
+		return nil, errors.Wrap(err, "Error getting combobox_log_level")
+	}
+
+	// ------------------------------------------------------------------------------------
+
+	w.SwitchEnableAutoSwitch, err = w.GetSwitch("switch_enable_auto_change")
+	if err != nil {
+		return nil, errors.Wrap(err, "Error getting switch_enable_auto_change")
+	}
+
+	w.ComboBoxIpNoMatch, err = w.GetComboBox("combobox_ip_no_match")
+	if err != nil {
+		return nil, errors.Wrap(err, "Error getting combobox_ip_no_match")
+	}
+
+	w.ListStoreNoMatch, err = w.GetListStore("liststore_ip_no_match")
+	if err != nil {
+		return nil, errors.Wrap(err, "Error getting liststore_ip_no_match")
+	}
+
+	// ------------------------------------------------------------------------------------
+
+	w.SwitchUpdateCheck, err = w.GetSwitch("switch_check_updates")
+	if err != nil {
+		return nil, errors.Wrap(err, "Error getting switch_check_updates")
+	}
+
+	// ------------------------------------------------------------------------------------
+
+	w.TreeViewProxies, err = w.GetTreeView("treeview_proxies")
+	if err != nil {
+		return nil, errors.Wrap(err, "Error getting treeview_proxies")
+	}
+
+	w.ListStoreProxies, err = w.GetListStore("liststore_proxies")
+	if err != nil {
+		return nil, errors.Wrap(err, "Error getting liststore_proxies")
+	}
+
+	w.ButtonProxyEdit, err = w.GetButton("button_proxy_edit")
+	if err != nil {
+		return nil, errors.Wrap(err, "Error getting button_proxy_edit")
+	}
+
+	w.ButtonProxyRemove, err = w.GetButton("button_proxy_remove")
+	if err != nil {
+		return nil, errors.Wrap(err, "Error getting button_proxy_remove")
+	}
+
+	// ------------------------------------------------------------------------------------
+
+	w.TreeViewApps, err = w.GetTreeView("treeview_apps")
+	if err != nil {
+		return nil, errors.Wrap(err, "Error getting treeview_apps")
+	}
+
+	w.ListStoreApps, err = w.GetListStore("liststore_apps")
+	if err != nil {
+		return nil, errors.Wrap(err, "Error getting liststore_apps")
+	}
+
+	// ------------------------------------------------------------------------------------
+
+	w.TextViewOnProxyChangeScript, err = w.GetTextView("textview_proxy_change_script")
+	if err != nil {
+		return nil, errors.Wrap(err, "Error getting textview_proxy_change_script")
+	}
+
+	w.TextBufferOnProxyChangeScript, err = w.GetTextBuffer("textbuffer_proxy_change_script")
+	if err != nil {
+		return nil, errors.Wrap(err, "Error getting textbuffer_proxy_change_script")
+	}
+
+	w.TextViewOnProxyDeactivateScript, err = w.GetTextView("textview_proxy_deactivate_script")
+	if err != nil {
+		return nil, errors.Wrap(err, "Error getting textview_proxy_deactivate_script")
+	}
+
+	w.TextBufferOnProxyDeactivateScript, err = w.GetTextBuffer("textbuffer_proxy_deactivate_script")
+	if err != nil {
+		return nil, errors.Wrap(err, "Error getting textbuffer_proxy_deactivate_script")
+	}
+
+	w.TextViewOnProxyActivateScript, err = w.GetTextView("textview_proxy_activate_script")
+	if err != nil {
+		return nil, errors.Wrap(err, "Error getting textview_proxy_activate_script")
+	}
+
+	w.TextBufferOnProxyActivateScript, err = w.GetTextBuffer("textbuffer_proxy_activate_script")
+	if err != nil {
+		return nil, errors.Wrap(err, "Error getting textbuffer_proxy_activate_script")
+	}
+
+	// ------------------------------------------------------------------------------------
+	// Signals
+	// ------------------------------------------------------------------------------------
+
+	w.Builder.ConnectSignals(map[string]interface{}{
+		"on_window_deleted":                             w.OnWindowDeleted,
+		"on_switch_run_startup_state_set":               w.OnSwitchRunStartupChanged,
+		"on_switch_show_proxy_name_state_set":           w.OnSwitchShowProxyNameChanged,
+		"on_combobox_log_level_changed":                 w.OnComboBoxLogLevelChanged,
+		"on_enable_auto_change":                         w.OnSwitchEnableAutoChangeByIp,
+		"on_combobox_ip_no_match_changed":               w.OnComboBoxWhatToDoWhenNoIpMatchesChanged,
+		"on_switch_check_updates_changed":               w.OnSwitchUpdateCheckChanged,
+		"on_treeview_proxies_row_activated":             w.OnTreeviewProxiesRowActivated,
+		"on_treeview_proxies_selection_changed":         w.OnTreeviewProxiesSelectionChanged,
+		"on_button_proxy_add_clicked":                   w.OnButtonProxyAddClicked,
+		"on_button_proxy_edit_clicked":                  w.OnButtonProxyEditClicked,
+		"on_button_proxy_remove_clicked":                w.OnButtonProxyRemoveClicked,
+		"on_application_enabled_toggled":                w.OnApplicationEnabledToggled,
+		"on_button_export_clicked":                      w.OnExportButtonClicked,
+		"on_button_import_clicked":                      w.OnImportButtonClicked,
+		"on_button_close_clicked":                       w.OnCloseButtonClicked,
+		"on_textbuffer_proxy_change_script_changed":     w.OnTextbufferProxyChangeScriptChanged,
+		"on_textbuffer_proxy_deactivate_script_changed": w.OnTextbufferProxyDeactivateScriptChanged,
+		"on_textbuffer_proxy_activate_script_changed":   w.OnTextbufferProxyActivateScriptChanged,
+	})
+
+	return &w, nil
+
+}
+
+func (w *ConfigWindow) FillData() {
+
+	if w.Indicator.Service.CmdLogLevelSet {
+		w.InfoBar.Show()
+	} else {
+		w.InfoBar.Hide()
+	}
+
+	autoStart, err := w.Indicator.Config.GetIndicatorAutostart()
+	if err != nil {
+		proxychangerlib.Log.Errorf("Error checking auto start: %v", err)
+		goutils.ShowMessage(w.Window, gtk.MESSAGE_ERROR, proxychangerlib.MyGettextv("Error"), proxychangerlib.MyGettextv("Error filling information; please, check the LOG."))
+		w.SwitchRunStartup.SetSensitive(false)
+		w.SwitchRunStartup.SetTooltipText(err.Error())
+	} else {
+		w.SwitchRunStartup.SetSensitive(true)
+		w.SwitchRunStartup.SetActive(autoStart)
+	}
+
+	// The configuration is changed from other goroutines, so it is read holding its lock, and the widgets are
+	// filled after releasing it
+	var showProxyName, enableAutoSwitch, updateCheck, ipNoMatchLocked, autoSwitchLocked, updateCheckLocked bool
+	var logLevel, ipNoMatch, changeScript, deactivateScript, activateScript string
+	w.Indicator.Config.Update(func() {
+		showProxyName = w.Indicator.Config.ShowCurrentProxyNameNextToIndicator
+		logLevel = w.Indicator.Config.LogLevel.String()
+		enableAutoSwitch = w.Indicator.Config.EnableAutoChangeByIp
+		ipNoMatch = w.Indicator.Config.WhatToDoWhenNoIpMatches
+		updateCheck = w.Indicator.Config.EnableUpdateCheck
+		ipNoMatchLocked = w.Indicator.Config.IsPolicyLocked("what_to_do_when_no_ip_matches")
+		autoSwitchLocked = w.Indicator.Config.IsPolicyLocked("enable_auto_change_by_ip")
+		updateCheckLocked = w.Indicator.Config.IsPolicyLocked("enable_update_check")
+		changeScript = w.Indicator.Config.ProxyChangeScript
+		deactivateScript = w.Indicator.Config.ProxyDeactivateScript
+		activateScript = w.Indicator.Config.ProxyActivateScript
+	})
+
+	w.SwitchShowProxyName.SetActive(showProxyName)
+	w.ComboBoxLogLevel.SetActiveID(logLevel)
+	w.SwitchEnableAutoSwitch.SetActive(enableAutoSwitch)
+	w.ComboBoxIpNoMatch.SetActiveID(ipNoMatch)
+	w.SwitchUpdateCheck.SetActive(updateCheck)
+	if enableAutoSwitch {
+		w.ComboBoxIpNoMatch.SetSensitive(!ipNoMatchLocked)
+	} else {
+		w.ComboBoxIpNoMatch.SetSensitive(false)
+	}
+	w.SetPolicyLocked(&w.SwitchEnableAutoSwitch.Widget, autoSwitchLocked)
+	w.SetPolicyLocked(&w.SwitchUpdateCheck.Widget, updateCheckLocked)
+	w.FillProxiesTreeView()
+	w.FillApplicationsTreeView()
+
+	w.SetTextViewText(w.TextViewOnProxyChangeScript, changeScript)
+	w.SetTextViewText(w.TextViewOnProxyDeactivateScript, deactivateScript)
+	w.SetTextViewText(w.TextViewOnProxyActivateScript, activateScript)
+
+}
+
+// Disables the widget if the setting is forced by the system configuration
+func (w *ConfigWindow) SetPolicyLocked(widget *gtk.Widget, locked bool) {
+	if locked {
+		widget.SetSensitive(false)
+		widget.SetTooltipText(proxychangerlib.MyGettextv("This setting is managed by the system configuration"))
+	} else {
+		widget.SetSensitive(true)
+		widget.SetTooltipText("")
+	}
+}
+
+func (w *ConfigWindow) FillProxiesTreeView() {
+
+	// Rows (UUID and description) read holding the lock of the configuration
+	rows := [][]string{}
+	w.Indicator.Config.Update(func() {
+		for _, p := range w.Indicator.Config.Proxies {
+			suffix := ""
+			if p.Locked {
+				suffix = " - " + proxychangerlib.MyGettextv("managed by the system")
+			}
+			url, err := p.ToUrl(false)
+			if err != nil {
+				proxychangerlib.Log.Errorf("Error generating URL for proxy %v: %v.", p.Name, err)
+				url = p.ToSimpleUrl()
+			}
+			rows = append(rows, []string{p.UUID, p.Name + " (" + url + ")" + suffix})
+		}
+	})
+
+	var err error
+	w.ListStoreProxies.Clear()
+	for _, row := range rows {
+		iter := w.ListStoreProxies.Append()
+		err = w.ListStoreProxies.SetValue(iter, 0, row[0])
+		if err == nil {
+			err = w.ListStoreProxies.SetValue(iter, 1, row[1])
+		}
+		if err != nil {
+			proxychangerlib.Log.Errorf("Can't set value in liststoreproxies: %v", err)
+			goutils.ShowMessage(w.Window, gtk.MESSAGE_ERROR, proxychangerlib.MyGettextv("Error"), proxychangerlib.MyGettextv("Please review the LOG."))
+		}
+	}
+
+}
+
+// Returns the proxy with the UUID (nil if not found), its name, and if it is locked or active, read holding the
+// lock of the configuration
+func (w *ConfigWindow) GetProxy(uuid string) (p *proxychangerlib.Proxy, name string, locked bool, active bool) {
+	w.Indicator.Config.Update(func() {
+		p = w.Indicator.Config.GetProxyWithUuid(uuid)
+		if p != nil {
+			name, locked, active = p.Name, p.Locked, w.Indicator.Config.ActiveProxy == p
+		}
+	})
+	return
+}
+
+func (w *ConfigWindow) FillApplicationsTreeView() {
+	var err error
+	enabled := map[string]bool{}
+	w.Indicator.Config.Update(func() {
+		for _, a := range proxychangerlib.ProxifiedApplications {
+			enabled[a.GetId()] = w.Indicator.Config.IsApplicationEnabled(a.GetId())
+		}
+	})
+	w.ListStoreApps.Clear()
+	for _, a := range proxychangerlib.ProxifiedApplications {
+		iter := w.ListStoreApps.Append()
+		err = w.ListStoreApps.SetValue(iter, 0, a.GetId())
+		if err != nil {
+			proxychangerlib.Log.Errorf("Can't set value in liststoreproxies: %v", err)
+			goutils.ShowMessage(w.Window, gtk.MESSAGE_ERROR, proxychangerlib.MyGettextv("Error"), proxychangerlib.MyGettextv("Please review the LOG."))
+		}
+		err = w.ListStoreApps.SetValue(iter, 1, a.GetSimpleName())
+		if err != nil {
+			proxychangerlib.Log.Errorf("Can't set value in liststoreproxies: %v", err)
+			goutils.ShowMessage(w.Window, gtk.MESSAGE_ERROR, proxychangerlib.MyGettextv("Error"), proxychangerlib.MyGettextv("Please review the LOG."))
+		}
+		err = w.ListStoreApps.SetValue(iter, 2, enabled[a.GetId()])
+		if err != nil {
+			proxychangerlib.Log.Errorf("Can't set value in liststoreproxies: %v", err)
+			goutils.ShowMessage(w.Window, gtk.MESSAGE_ERROR, proxychangerlib.MyGettextv("Error"), proxychangerlib.MyGettextv("Please review the LOG."))
+		}
+		err = w.ListStoreApps.SetValue(iter, 3, a.GetDescription())
+		if err != nil {
+			proxychangerlib.Log.Errorf("Can't set value in liststoreproxies: %v", err)
+			goutils.ShowMessage(w.Window, gtk.MESSAGE_ERROR, proxychangerlib.MyGettextv("Error"), proxychangerlib.MyGettextv("Please review the LOG."))
+		}
+		installed, version, _ := a.Detect()
+		err = w.ListStoreApps.SetValue(iter, 4, installed)
+		if err != nil {
+			proxychangerlib.Log.Errorf("Can't set value in liststoreproxies: %v", err)
+			goutils.ShowMessage(w.Window, gtk.MESSAGE_ERROR, proxychangerlib.MyGettextv("Error"), proxychangerlib.MyGettextv("Please review the LOG."))
+		}
+		err = w.ListStoreApps.SetValue(iter, 5, version)
+		if err != nil {
+			proxychangerlib.Log.Errorf("Can't set value in liststoreproxies: %v", err)
+			goutils.ShowMessage(w.Window, gtk.MESSAGE_ERROR, proxychangerlib.MyGettextv("Error"), proxychangerlib.MyGettextv("Please review the LOG."))
+		}
+	}
+}
+
+func (w *ConfigWindow) OnSwitchRunStartupChanged() {
+	var err error
+	w.Indicator.Config.Update(func() {
+		err = w.Indicator.Config.SetIndicatorAutostart(w.SwitchRunStartup.GetActive())
+	})
+	if err != nil {
+		proxychangerlib.Log.Errorf("Error updating auto start: %v", err)
+		goutils.ShowMessage(w.Window, gtk.MESSAGE_ERROR, proxychangerlib.MyGettextv("Error"), proxychangerlib.MyGettextv("Please review the LOG."))
+	} else {
+		proxychangerlib.Log.Debugf("Run startup is now %v", w.SwitchRunStartup.GetActive())
+	}
+}
+
+func (w *ConfigWindow) OnSwitchShowProxyNameChanged() {
+	var err error
+	w.Indicator.Config.Update(func() {
+		w.Indicator.Config.SetShowCurrentProxyNameNextToIndicator(w.SwitchShowProxyName.GetActive())
+		err = w.Indicator.Config.Save(fmt.Sprintf("Show proxy name is now %v", w.Indicator.Config.ShowCurrentProxyNameNextToIndicator))
+	})
+	if err != nil {
+		proxychangerlib.Log.Errorf("Error saving configuration: %v", err)
+		goutils.ShowMessage(w.Window, gtk.MESSAGE_ERROR, proxychangerlib.MyGettextv("Error"), proxychangerlib.MyGettextv("Error saving configuration: %v.", err))
+	}
+}
+
+func (w *ConfigWindow) OnComboBoxLogLevelChanged() {
+	logLevelStr := w.ComboBoxLogLevel.GetActiveID()
+	level, ok := loggo.ParseLevel(logLevelStr)
+	if !ok {
+		proxychangerlib.Log.Errorf("Invalid log level %v", logLevelStr)
+		goutils.ShowMessage(w.Window, gtk.MESSAGE_ERROR, proxychangerlib.MyGettextv("Error"), proxychangerlib.MyGettextv("Please review the LOG."))
+	} else {
+		var err error
+		w.Indicator.Config.Update(func() {
+			w.Indicator.Config.SetLogLevel(level)
+			err = w.Indicator.Config.Save(fmt.Sprintf("Configuration log level is now %v; runtime level is %v", w.Indicator.Config.LogLevel.String(), proxychangerlib.Log.LogLevel().String()))
+		})
+		if err != nil {
+			proxychangerlib.Log.Errorf("Error saving configuration: %v", err)
+			goutils.ShowMessage(w.Window, gtk.MESSAGE_ERROR, proxychangerlib.MyGettextv("Error"), proxychangerlib.MyGettextv("Error saving configuration: %v.", err))
+		}
+	}
+}
+
+func (w *ConfigWindow) OnSwitchEnableAutoChangeByIp() {
+	var err error
+	var ipNoMatchLocked bool
+	w.Indicator.Config.Update(func() {
+		w.Indicator.Config.SetEnableAutoChangeByIp(w.SwitchEnableAutoSwitch.GetActive())
+		err = w.Indicator.Config.Save(fmt.Sprintf("Enable auto change by ip is now %v", w.Indicator.Config.EnableAutoChangeByIp))
+		ipNoMatchLocked = w.Indicator.Config.IsPolicyLocked("what_to_do_when_no_ip_matches")
+	})
+	if w.SwitchEnableAutoSwitch.GetActive() {
+		w.ComboBoxIpNoMatch.SetSensitive(!ipNoMatchLocked)
+	} else {
+		w.ComboBoxIpNoMatch.SetSensitive(false)
+	}
+	if err != nil {
+		proxychangerlib.Log.Errorf("Error saving configuration: %v", err)
+		goutils.ShowMessage(w.Window, gtk.MESSAGE_ERROR, proxychangerlib.MyGettextv("Error"), proxychangerlib.MyGettextv("Error saving configuration: %v.", err))
+	}
+}
+
+func (w *ConfigWindow) OnComboBoxWhatToDoWhenNoIpMatchesChanged() {
+	var err error
+	w.Indicator.Config.Update(func() {
+		w.Indicator.Config.SetWhatToDoWhenNoIpMatches(w.ComboBoxIpNoMatch.GetActiveID())
+		err = w.Indicator.Config.Save(fmt.Sprintf("What to do when no ip matches is now %v", w.Indicator.Config.WhatToDoWhenNoIpMatches))
+	})
+	if err != nil {
+		proxychangerlib.Log.Errorf("Error saving configuration: %v", err)
+		goutils.ShowMessage(w.Window, gtk.MESSAGE_ERROR, proxychangerlib.MyGettextv("Error"), proxychangerlib.MyGettextv("Error saving configuration: %v.", err))
+	}
+}
+
+func (w *ConfigWindow) OnSwitchUpdateCheckChanged() {
+	var err error
+	w.Indicator.Config.Update(func() {
+		w.Indicator.Config.SetEnableUpdateCheck(w.SwitchUpdateCheck.GetActive())
+		err = w.Indicator.Config.Save(fmt.Sprintf("Update check is now %v", w.Indicator.Config.EnableUpdateCheck))
+	})
+	if err != nil {
+		proxychangerlib.Log.Errorf("Error saving configuration: %v", err)
+		goutils.ShowMessage(w.Window, gtk.MESSAGE_ERROR, proxychangerlib.MyGettextv("Error"), proxychangerlib.MyGettextv("Error saving configuration: %v.", err))
+	}
+}
+
+func (w *ConfigWindow) OnTreeviewProxiesRowActivated(treeView *gtk.TreeView, path *gtk.TreePath) {
+
+	selection, err := w.TreeViewProxies.GetSelection()
+	if err != nil {
+		proxychangerlib.Log.Errorf("Can't get selection: %v", err)
+		goutils.ShowMessage(w.Window, gtk.MESSAGE_ERROR, proxychangerlib.MyGettextv("Error"), proxychangerlib.MyGettextv("Please review the LOG."))
+		return
+	}
+
+	_, iter, ok := selection.GetSelected()
+	if !ok {
+		proxychangerlib.Log.Errorf("Selected not ok")
+		goutils.ShowMessage(w.Window, gtk.MESSAGE_ERROR, proxychangerlib.MyGettextv("Error"), proxychangerlib.MyGettextv("Please review the LOG."))
+		return
+	}
+
+	gval, err := w.ListStoreProxies.GetValue(iter, 0)
+	if err != nil {
+		proxychangerlib.Log.Errorf("Can't get value for iter: %v", err)
+		goutils.ShowMessage(w.Window, gtk.MESSAGE_ERROR, proxychangerlib.MyGettextv("Error"), proxychangerlib.MyGettextv("Please review the LOG."))
+		return
+	}
+
+	val, err := gval.GoValue()
+	if err != nil {
+		proxychangerlib.Log.Errorf("Can't get value for gvalue: %v", err)
+		goutils.ShowMessage(w.Window, gtk.MESSAGE_ERROR, proxychangerlib.MyGettextv("Error"), proxychangerlib.MyGettextv("Please review the LOG."))
+		return
+	}
+
+	proxyUuid, ok := val.(string)
+	if !ok {
+		proxychangerlib.Log.Errorf("Can't convert value to string for iter: %v", err)
+		goutils.ShowMessage(w.Window, gtk.MESSAGE_ERROR, proxychangerlib.MyGettextv("Error"), proxychangerlib.MyGettextv("Please review the LOG."))
+		return
+	}
+
+	p, name, locked, _ := w.GetProxy(proxyUuid)
+	if p == nil {
+		proxychangerlib.Log.Errorf("Proxy with UUID %v not found", proxyUuid)
+		goutils.ShowMessage(w.Window, gtk.MESSAGE_ERROR, proxychangerlib.MyGettextv("Error"), proxychangerlib.MyGettextv("Please review the LOG."))
+		return
+	}
+
+	if locked {
+		goutils.ShowMessage(w.Window, gtk.MESSAGE_INFO, proxychangerlib.MyGettextv("System proxy"), proxychangerlib.MyGettextv("The proxy %v is managed by the system configuration and can't be changed.", name))
+		return
+	}
+
+	d, err := NewProxyDialog(w, p, false)
+	if err != nil {
+		proxychangerlib.Log.Errorf("Error creating dialog: %v", err)
+		goutils.ShowMessage(w.Window, gtk.MESSAGE_ERROR, proxychangerlib.MyGettextv("Error"), proxychangerlib.MyGettextv("Please review the LOG."))
+		return
+	}
+
+	response := d.Dialog.Run()
+	d.Dialog.Destroy()
+	if response == int(gtk.RESPONSE_OK) {
+		w.FillProxiesTreeView()
+	}
+
+}
+
+func (w *ConfigWindow) OnTreeviewProxiesSelectionChanged(selection *gtk.TreeSelection) {
+
+	_, iter, ok := selection.GetSelected()
+	if !ok {
+		w.ButtonProxyEdit.SetSensitive(false)
+		w.ButtonProxyRemove.SetSensitive(false)
+		return
+	}
+
+	// System proxies can't be changed
+	locked := false
+	gval, err := w.ListStoreProxies.GetValue(iter, 0)
+	if err == nil {
+		val, err := gval.GoValue()
+		if err == nil {
+			if proxyUuid, ok := val.(string); ok {
+				_, _, locked, _ = w.GetProxy(proxyUuid)
+			}
+		}
+	}
+	w.ButtonProxyEdit.SetSensitive(!locked)
+	w.ButtonProxyRemove.SetSensitive(!locked)
+
+}
+
+func (w *ConfigWindow) OnButtonProxyAddClicked() {
+	w.ShowAddProxyDialog(false)
+}
+
+func (w *ConfigWindow) ShowAddProxyDialog(askToSetAfter bool) {
+
+	d, err := NewProxyDialog(w, nil, askToSetAfter)
+	if err != nil {
+		proxychangerlib.Log.Errorf("Error creating dialog: %v", err)
+		goutils.ShowMessage(w.Window, gtk.MESSAGE_ERROR, proxychangerlib.MyGettextv("Error"), proxychangerlib.MyGettextv("Please review the LOG."))
+		return
+	}
+
+	response := d.Dialog.Run()
+	d.Dialog.Destroy()
+	if response == int(gtk.RESPONSE_OK) {
+		w.FillProxiesTreeView()
+	}
+
+}
+
+func (w *ConfigWindow) OnButtonProxyEditClicked() {
+
+	selection, err := w.TreeViewProxies.GetSelection()
+	if err != nil {
+		proxychangerlib.Log.Errorf("Can't get selection: %v", err)
+		goutils.ShowMessage(w.Window, gtk.MESSAGE_ERROR, proxychangerlib.MyGettextv("Error"), proxychangerlib.MyGettextv("Please review the LOG."))
+		return
+	}
+
+	_, iter, ok := selection.GetSelected()
+	if !ok {
+		proxychangerlib.Log.Errorf("Selected not ok")
+		goutils.ShowMessage(w.Window, gtk.MESSAGE_ERROR, proxychangerlib.MyGettextv("Error"), proxychangerlib.MyGettextv("Please review the LOG."))
+		return
+	}
+
+	gval, err := w.ListStoreProxies.GetValue(iter, 0)
+	if err != nil {
+		proxychangerlib.Log.Errorf("Can't get value for iter: %v", err)
+		goutils.ShowMessage(w.Window, gtk.MESSAGE_ERROR, proxychangerlib.MyGettextv("Error"), proxychangerlib.MyGettextv("Please review the LOG."))
+		return
+	}
+
+	val, err := gval.GoValue()
+	if err != nil {
+		proxychangerlib.Log.Errorf("Can't get value for gvalue: %v", err)
+		goutils.ShowMessage(w.Window, gtk.MESSAGE_ERROR, proxychangerlib.MyGettextv("Error"), proxychangerlib.MyGettextv("Please review the LOG."))
+		return
+	}
+
+	proxyUuid, ok := val.(string)
+	if !ok {
+		proxychangerlib.Log.Errorf("Can't convert value to string for iter: %v", err)
+		goutils.ShowMessage(w.Window, gtk.MESSAGE_ERROR, proxychangerlib.MyGettextv("Error"), proxychangerlib.MyGettextv("Please review the LOG."))
+		return
+	}
+
+	p, name, locked, _ := w.GetProxy(proxyUuid)
+	if p == nil {
+		proxychangerlib.Log.Errorf("Proxy with UUID %v not found", proxyUuid)
+		goutils.ShowMessage(w.Window, gtk.MESSAGE_ERROR, proxychangerlib.MyGettextv("Error"), proxychangerlib.MyGettextv("Please review the LOG."))
+		return
+	}
+
+	if locked {
+		goutils.ShowMessage(w.Window, gtk.MESSAGE_INFO, proxychangerlib.MyGettextv("System proxy"), proxychangerlib.MyGettextv("The proxy %v is managed by the system configuration and can't be changed.", name))
+		return
+	}
+
+	d, err := NewProxyDialog(w, p, false)
+	if err != nil {
+		proxychangerlib.Log.Errorf("Error creating dialog: %v", err)
+		goutils.ShowMessage(w.Window, gtk.MESSAGE_ERROR, proxychangerlib.MyGettextv("Error"), proxychangerlib.MyGettextv("Please review the LOG."))
+		return
+	}
+
+	response := d.Dialog.Run()
+	d.Dialog.Destroy()
+	if response == int(gtk.RESPONSE_OK) {
+		w.FillProxiesTreeView()
+	}
+
+}
+
+func (w *ConfigWindow) OnButtonProxyRemoveClicked() {
+
+	selection, err := w.TreeViewProxies.GetSelection()
+	if err != nil {
+		proxychangerlib.Log.Errorf("Can't get selection: %v", err)
+		goutils.ShowMessage(w.Window, gtk.MESSAGE_ERROR, proxychangerlib.MyGettextv("Error"), proxychangerlib.MyGettextv("Please review the LOG."))
+		return
+	}
+
+	_, iter, ok := selection.GetSelected()
+	if !ok {
+		proxychangerlib.Log.Errorf("Selected not ok")
+		goutils.ShowMessage(w.Window, gtk.MESSAGE_ERROR, proxychangerlib.MyGettextv("Error"), proxychangerlib.MyGettextv("Please review the LOG."))
+		return
+	}
+
+	gval, err := w.ListStoreProxies.GetValue(iter, 0)
+	if err != nil {
+		proxychangerlib.Log.Errorf("Can't get value for iter: %v", err)
+		goutils.ShowMessage(w.Window, gtk.MESSAGE_ERROR, proxychangerlib.MyGettextv("Error"), proxychangerlib.MyGettextv("Please review the LOG."))
+		return
+	}
+
+	val, err := gval.GoValue()
+	if err != nil {
+		proxychangerlib.Log.Errorf("Can't get value for gvalue: %v", err)
+		goutils.ShowMessage(w.Window, gtk.MESSAGE_ERROR, proxychangerlib.MyGettextv("Error"), proxychangerlib.MyGettextv("Please review the LOG."))
+		return
+	}
+
+	proxyUuid, ok := val.(string)
+	if !ok {
+		proxychangerlib.Log.Errorf("Can't convert value to string for iter: %v", err)
+		goutils.ShowMessage(w.Window, gtk.MESSAGE_ERROR, proxychangerlib.MyGettextv("Error"), proxychangerlib.MyGettextv("Please review the LOG."))
+		return
+	}
+
+	p, name, locked, active := w.GetProxy(proxyUuid)
+	if p == nil {
+		proxychangerlib.Log.Errorf("Proxy with UUID %v not found", proxyUuid)
+		goutils.ShowMessage(w.Window, gtk.MESSAGE_ERROR, proxychangerlib.MyGettextv("Error"), proxychangerlib.MyGettextv("Please review the LOG."))
+		return
+	}
+
+	if locked {
+		goutils.ShowMessage(w.Window, gtk.MESSAGE_INFO, proxychangerlib.MyGettextv("System proxy"), proxychangerlib.MyGettextv("The proxy %v is managed by the system configuration and can't be removed.", name))
+		return
+	}
+	if active {
+		goutils.ShowMessage(w.Window, gtk.MESSAGE_ERROR, "Active proxy", "The selected proxy is currently active and can't be removed.")
+		return
+	}
+	if goutils.ConfirmMessage(w.Window, "Remove proxy", "Are you sure you want to remove this proxy?") {
+		w.Indicator.Config.Update(func() {
+			w.Indicator.Config.DeleteProxy(p, true)
+		})
+		w.FillProxiesTreeView()
+	}
+
+}
+
+func (w *ConfigWindow) OnApplicationEnabledToggled(cellRendererToggle *gtk.CellRendererToggle, path string) {
+
+	iter, err := w.ListStoreApps.GetIterFromString(path)
+	if err != nil {
+		proxychangerlib.Log.Errorf("Can't get iter for path: %v", err)
+		goutils.ShowMessage(w.Window, gtk.MESSAGE_ERROR, proxychangerlib.MyGettextv("Error"), proxychangerlib.MyGettextv("Please review the LOG."))
+		return
+	}
+
+	// Now extract the application id in column 0
+	gval, err := w.ListStoreApps.GetValue(iter, 0)
+	if err != nil {
+		proxychangerlib.Log.Errorf("Can't get value for iter: %v", err)
+		goutils.ShowMessage(w.Window, gtk.MESSAGE_ERROR, proxychangerlib.MyGettextv("Error"), proxychangerlib.MyGettextv("Please review the LOG."))
+		return
+	}
+
+	val, err := gval.GoValue()
+	if err != nil {
+		proxychangerlib.Log.Errorf("Can't get value for gvalue: %v", err)
+		goutils.ShowMessage(w.Window, gtk.MESSAGE_ERROR, proxychangerlib.MyGettextv("Error"), proxychangerlib.MyGettextv("Please review the LOG."))
+		return
+	}
+
+	appId, ok := val.(string)
+	if !ok {
+		proxychangerlib.Log.Errorf("Can't convert value to string for iter: %v", err)
+		goutils.ShowMessage(w.Window, gtk.MESSAGE_ERROR, proxychangerlib.MyGettextv("Error"), proxychangerlib.MyGettextv("Please review the LOG."))
+		return
+	}
+
+	var appLocked bool
+	w.Indicator.Config.Update(func() {
+		appLocked = w.Indicator.Config.IsApplicationLocked(appId)
+	})
+	if appLocked {
+		goutils.ShowMessage(w.Window, gtk.MESSAGE_INFO, proxychangerlib.MyGettextv("System application"), proxychangerlib.MyGettextv("The application %v is enabled or disabled by the system configuration.", appId))
+		return
+	}
+
+	// Switch the value in the model, in column 2
+	newValue := !cellRendererToggle.GetActive()
+	w.ListStoreApps.SetValue(iter, 2, newValue)
+
+	// And set the value in the configuration
+	w.Indicator.Config.Update(func() {
+		_, err = w.Indicator.Config.SetApplicationEnabled(appId, newValue, false)
+	})
+	if err != nil {
+		proxychangerlib.Log.Errorf("Error saving configuration: %v", err)
+		goutils.ShowMessage(w.Window, gtk.MESSAGE_ERROR, proxychangerlib.MyGettextv("Error"), proxychangerlib.MyGettextv("Error saving configuration: %v.", err))
+	}
+
+	// Update the applications in the indicator menu
+	w.Indicator.BuildMenu()
+
+}
+
+func (w *ConfigWindow) OnWindowDeleted() bool {
+	w.Window.Hide()
+	return true
+}
+
+func (w *ConfigWindow) OnExportButtonClicked() {
+
+	// Confirm export with passwords
+	includePasswords := goutils.ConfirmMessage(
+		w.Window,
+		proxychangerlib.MyGettextv("Export configuration"),
+		proxychangerlib.MyGettextv("Do you want to include passwords in the exported file?"),
+	)
+	passphrase := ""
+	if includePasswords {
+		var ok bool
+		passphrase, ok = w.AskPassphrase(proxychangerlib.MyGettextv("The passwords are encrypted with a passphrase, that will be asked when importing the file."), true)
+		if !ok {
+			return
+		}
+	}
+
+	chooser, err := gtk.FileChooserDialogNewWith2Buttons("Select file", w.Window, gtk.FILE_CHOOSER_ACTION_SAVE, "OK", gtk.RESPONSE_OK, "Cancel", gtk.RESPONSE_CANCEL)
+	if err != nil {
+		proxychangerlib.Log.Errorf("Error creating file chooser dialog: %v", err)
+		goutils.ShowMessage(w.Window, gtk.MESSAGE_ERROR, proxychangerlib.MyGettextv("Error"), proxychangerlib.MyGettextv("Please review the LOG."))
+		return
+	}
+	chooser.SetDoOverwriteConfirmation(true)
+
+	filter, err := gtk.FileFilterNew()
+	if err != nil {
+		proxychangerlib.Log.Errorf("Error creating file filter: %v", err)
+		goutils.ShowMessage(w.Window, gtk.MESSAGE_ERROR, proxychangerlib.MyGettextv("Error"), proxychangerlib.MyGettextv("Please review the LOG."))
+		return
+	}
+	filter.SetName("JSON files (*.json)")
+	filter.AddPattern("*.json")
+	chooser.AddFilter(filter)
+	chooser.SetDoOverwriteConfirmation(true)
+
+	response := chooser.Run()
+	defer chooser.Destroy()
+	if response == int(gtk.RESPONSE_OK) {
+		filename := chooser.GetFilename()
+		if filename != "" {
+			if !strings.HasSuffix(strings.ToLower(filename), ".json") {
+				filename += ".json"
+			}
+			var err error
+			w.Indicator.Config.Update(func() {
+				err = w.Indicator.Config.Export(filename, &proxychangerlib.ExportOptions{IncludePasswords: includePasswords, Passphrase: passphrase})
+			})
+			if err != nil {
+				proxychangerlib.Log.Errorf("Error exporting configuration: %v", err)
+				goutils.ShowMessage(w.Window, gtk.MESSAGE_ERROR, proxychangerlib.MyGettextv("Error"), proxychangerlib.MyGettextv("Please review the LOG."))
+			}
+		} else {
+			proxychangerlib.Log.Errorf("Empty filename")
+			goutils.ShowMessage(w.Window, gtk.MESSAGE_ERROR, proxychangerlib.MyGettextv("Error"), proxychangerlib.MyGettextv("Please review the LOG."))
+		}
+	}
+
+}
+
+func (w *ConfigWindow) OnImportButtonClicked() {
+
+	chooser, err := gtk.FileChooserDialogNewWith2Buttons("Select file", w.Window, gtk.FILE_CHOOSER_ACTION_OPEN, "OK", gtk.RESPONSE_OK, "Cancel", gtk.RESPONSE_CANCEL)
+	if err != nil {
+		proxychangerlib.Log.Errorf("Error creating file chooser dialog: %v", err)
+		goutils.ShowMessage(w.Window, gtk.MESSAGE_ERROR, proxychangerlib.MyGettextv("Error"), proxychangerlib.MyGettextv("Please review the LOG."))
+		return
+	}
+
+	filter, err := gtk.FileFilterNew()
+	if err != nil {
+		proxychangerlib.Log.Errorf("Error creating file filter: %v", err)
+		goutils.ShowMessage(w.Window, gtk.MESSAGE_ERROR, proxychangerlib.MyGettextv("Error"), proxychangerlib.MyGettextv("Please review the LOG."))
+		return
+	}
+	filter.SetName("JSON files (*.json)")
+	filter.AddPattern("*.json")
+	chooser.AddFilter(filter)
+
+	response := chooser.Run()
+	defer chooser.Destroy()
+	if response == int(gtk.RESPONSE_OK) {
+		filename := chooser.GetFilename()
+		if filename != "" {
+
+			passphrase := ""
+			encrypted, err := proxychangerlib.IsEncryptedConfigurationFile(filename)
+			if err != nil {
+				proxychangerlib.Log.Errorf("Error importing configuration: %v", err)
+				goutils.ShowMessage(w.Window, gtk.MESSAGE_ERROR, proxychangerlib.MyGettextv("Error"), proxychangerlib.MyGettextv("Error importing configuration: %v.", err))
+				return
+			}
+			if encrypted {
+				var ok bool
+				passphrase, ok = w.AskPassphrase(proxychangerlib.MyGettextv("The passwords of the file are encrypted; enter the passphrase used when exporting it."), false)
+				if !ok {
+					return
+				}
+			}
+
+			merge := goutils.ConfirmMessage(
+				w.Window,
+				proxychangerlib.MyGettextv("Import configuration"),
+				proxychangerlib.MyGettextv("Do you want to merge the proxies of the file with the current ones? Otherwise, the whole configuration will be replaced by the one of the file."),
+			)
+			if merge {
+				w.MergeImport(filename, passphrase)
+			} else {
+				w.Indicator.Config.Update(func() {
+					_, err = w.Indicator.Config.ImportFile(filename, passphrase, proxychangerlib.IMPORT_MODE_REPLACE, true, false, nil)
+				})
+				if err != nil {
+					proxychangerlib.Log.Errorf("Error importing configuration: %v", err)
+					goutils.ShowMessage(w.Window, gtk.MESSAGE_ERROR, proxychangerlib.MyGettextv("Error"), proxychangerlib.MyGettextv("Error importing configuration: %v.", err))
+				}
+			}
+
+			w.FillData()
+
+		} else {
+			proxychangerlib.Log.Errorf("Empty filename")
+			goutils.ShowMessage(w.Window, gtk.MESSAGE_ERROR, proxychangerlib.MyGettextv("Error"), proxychangerlib.MyGettextv("Please review the LOG."))
+		}
+	}
+
+}
+
+// Shows the changes that merging the file would make and, if the user accepts them, applies them with the
+// chosen resolutions
+func (w *ConfigWindow) MergeImport(filename string, passphrase string) {
+
+	var entries []*proxychangerlib.ImportEntry
+	var err error
+	w.Indicator.Config.Update(func() {
+		entries, err = w.Indicator.Config.PreviewImport(filename, passphrase, proxychangerlib.IMPORT_MODE_MERGE)
+	})
+	if err != nil {
+		proxychangerlib.Log.Errorf("Error importing configuration: %v", err)
+		goutils.ShowMessage(w.Window, gtk.MESSAGE_ERROR, proxychangerlib.MyGettextv("Error"), proxychangerlib.MyGettextv("Error importing configuration: %v.", err))
+		return
+	}
+
+	d, err := NewImportDialog(w, filepath.Base(filename), entries)
+	if err != nil {
+		proxychangerlib.Log.Errorf("Error creating dialog: %v", err)
+		goutils.ShowMessage(w.Window, gtk.MESSAGE_ERROR, proxychangerlib.MyGettextv("Error"), proxychangerlib.MyGettextv("Please review the LOG."))
+		return
+	}
+
+	response := d.Dialog.Run()
+	d.Dialog.Destroy()
+	if response != int(gtk.RESPONSE_OK) {
+		return
+	}
+
+	w.Indicator.Config.Update(func() {
+		err = w.Indicator.Config.MergeImport(entries)
+	})
+	if err != nil {
+		proxychangerlib.Log.Errorf("Error importing configuration: %v", err)
+		goutils.ShowMessage(w.Window, gtk.MESSAGE_ERROR, proxychangerlib.MyGettextv("Error"), proxychangerlib.MyGettextv("Error importing configuration: %v.", err))
+	}
+
+}
+
+// Asks the passphrase of the exported passwords; returns false if the user cancels
+func (w *ConfigWindow) AskPassphrase(message string, confirm bool) (string, bool) {
+
+	d, err := NewPassphraseDialog(w, message, confirm)
+	if err != nil {
+		proxychangerlib.Log.Errorf("Error creating dialog: %v", err)
+		goutils.ShowMessage(w.Window, gtk.MESSAGE_ERROR, proxychangerlib.MyGettextv("Error"), proxychangerlib.MyGettextv("Please review the LOG."))
+		return "", false
+	}
+
+	response := d.Dialog.Run()
+	d.Dialog.Destroy()
+	return d.Passphrase, response == int(gtk.RESPONSE_OK)
+
+}
+
+func (w *ConfigWindow) OnCloseButtonClicked() {
+	w.Window.Hide()
+}
+
+func (w *ConfigWindow) OnTextbufferProxyChangeScriptChanged() {
+	text, err := w.GetTextViewText(w.TextViewOnProxyChangeScript)
+	if err != nil {
+		proxychangerlib.Log.Errorf("Error getting text: %v", err)
+		goutils.ShowMessage(w.Window, gtk.MESSAGE_ERROR, proxychangerlib.MyGettextv("Error"), proxychangerlib.MyGettextv("Please review the LOG."))
+	} else {
+		w.Indicator.Config.Update(func() {
+			w.Indicator.Config.ProxyChangeScript = text
+			w.Indicator.Config.Save("ProxyChangeScript changed")
+		})
+	}
+}
+
+func (w *ConfigWindow) OnTextbufferProxyDeactivateScriptChanged() {
+	text, err := w.GetTextViewText(w.TextViewOnProxyDeactivateScript)
+	if err != nil {
+		proxychangerlib.Log.Errorf("Error getting text: %v", err)
+		goutils.ShowMessage(w.Window, gtk.MESSAGE_ERROR, proxychangerlib.MyGettextv("Error"), proxychangerlib.MyGettextv("Please review the LOG."))
+	} else {
+		w.Indicator.Config.Update(func() {
+			w.Indicator.Config.ProxyDeactivateScript = text
+			w.Indicator.Config.Save("ProxyDeactivateScript changed")
+		})
+	}
+}
+
+func (w *ConfigWindow) OnTextbufferProxyActivateScriptChanged() {
+	text, err := w.GetTextViewText(w.TextViewOnProxyActivateScript)
+	if err != nil {
+		proxychangerlib.Log.Errorf("Error getting text: %v", err)
+		goutils.ShowMessage(w.Window, gtk.MESSAGE_ERROR, proxychangerlib.MyGettextv("Error"), proxychangerlib.MyGettextv("Please review the LOG."))
+	} else {
+		w.Indicator.Config.Update(func() {
+			w.Indicator.Config.ProxyActivateScript = text
+			w.Indicator.Config.Save("ProxyActivateScript changed")
+		})
+	}
+}
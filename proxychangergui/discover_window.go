@@ -0,0 +1,156 @@
+package proxychangergui
+
+import (
+	"strings"
+
+	"github.com/gotk3/gotk3/gtk"
+	"github.com/okelet/goutils"
+	"github.com/okelet/proxychanger/proxychangerlib"
+	"github.com/pkg/errors"
+)
+
+// First run wizard; shows the proxies configured in the desktop and in the applications, and lets the user choose
+// which ones to add
+type DiscoverDialog struct {
+	*goutils.BuilderBase
+	Indicator *Indicator
+	Proxies   []*proxychangerlib.DiscoveredProxy
+	// Proxies checked by the user
+	Selected []*proxychangerlib.DiscoveredProxy
+
+	Dialog           *gtk.Dialog
+	TreeViewProxies  *gtk.TreeView
+	ListStoreProxies *gtk.ListStore
+}
+
+func NewDiscoverDialog(indicator *Indicator, proxies []*proxychangerlib.DiscoveredProxy) (*DiscoverDialog, error) {
+
+	var err error
+
+	w := DiscoverDialog{}
+	w.Indicator = indicator
+	w.Proxies = proxies
+
+	w.BuilderBase, err = goutils.NewBuilderBase(w.Indicator, "assets/discover.glade")
+	if err != nil {
+		return nil, errors.Wrap(err, proxychangerlib.MyGettextv("Error loading asset %v", "assets/discover.glade"))
+	}
+
+	// ------------------------------------------------------------------------------------
+
+	w.Dialog, err = w.GetDialog("dialog_discover")
+	if err != nil {
+		return nil, errors.Wrap(err, proxychangerlib.MyGettextv("Error getting widget %v", "dialog_discover"))
+	}
+	w.Dialog.Resize(600, 300)
+	w.Dialog.SetPosition(gtk.WIN_POS_CENTER)
+	w.Dialog.SetIconName(proxychangerlib.ICON_NAME)
+	w.Dialog.SetTitle(proxychangerlib.MyGettextv("Initial configuration"))
+
+	// ------------------------------------------------------------------------------------
+
+	w.TreeViewProxies, err = w.GetTreeView("treeview_discovered_proxies")
+	if err != nil {
+		return nil, errors.Wrap(err, proxychangerlib.MyGettextv("Error getting widget %v", "treeview_discovered_proxies"))
+	}
+
+	w.ListStoreProxies, err = w.GetListStore("liststore_discovered_proxies")
+	if err != nil {
+		return nil, errors.Wrap(err, proxychangerlib.MyGettextv("Error getting widget %v", "liststore_discovered_proxies"))
+	}
+
+	err = w.FillProxies()
+	if err != nil {
+		return nil, errors.Wrap(err, proxychangerlib.MyGettextv("Error filling discovered proxies"))
+	}
+
+	// ------------------------------------------------------------------------------------
+	// Signals
+	// ------------------------------------------------------------------------------------
+
+	w.Builder.ConnectSignals(map[string]interface{}{
+		"on_button_ok_clicked":        w.OnButtonOkClicked,
+		"on_button_cancel_clicked":    w.OnButtonCancelClicked,
+		"on_discovered_proxy_toggled": w.OnDiscoveredProxyToggled,
+	})
+
+	return &w, nil
+
+}
+
+// All the proxies are checked by default
+func (w *DiscoverDialog) FillProxies() error {
+
+	w.ListStoreProxies.Clear()
+	for _, d := range w.Proxies {
+		sources := []string{}
+		for _, id := range d.Sources {
+			if a := proxychangerlib.GetProxifiedApplication(id); a != nil {
+				sources = append(sources, a.GetSimpleName())
+			} else {
+				sources = append(sources, id)
+			}
+		}
+		password := map[bool]string{true: proxychangerlib.MyGettextv("Yes"), false: proxychangerlib.MyGettextv("No")}[d.Password != ""]
+		iter := w.ListStoreProxies.Append()
+		for column, value := range []interface{}{true, d.Key(), strings.Join(sources, ", "), strings.Join(d.Exceptions, ", "), password} {
+			err := w.ListStoreProxies.SetValue(iter, column, value)
+			if err != nil {
+				return errors.Wrap(err, proxychangerlib.MyGettextv("Error setting discovered proxy %v", d.Key()))
+			}
+		}
+	}
+	return nil
+
+}
+
+func (w *DiscoverDialog) OnDiscoveredProxyToggled(cellRendererToggle *gtk.CellRendererToggle, path string) {
+
+	iter, err := w.ListStoreProxies.GetIterFromString(path)
+	if err != nil {
+		proxychangerlib.Log.Errorf("Can't get iter for path: %v", err)
+		goutils.ShowMessage(&w.Dialog.Window, gtk.MESSAGE_ERROR, proxychangerlib.MyGettextv("Error"), proxychangerlib.MyGettextv("Please review the LOG."))
+		return
+	}
+	w.ListStoreProxies.SetValue(iter, 0, !cellRendererToggle.GetActive())
+
+}
+
+// Reads the proxies checked by the user; the rows are in the same order than the proxies
+func (w *DiscoverDialog) LoadSelected() error {
+
+	w.Selected = []*proxychangerlib.DiscoveredProxy{}
+	index := 0
+	iter, ok := w.ListStoreProxies.GetIterFirst()
+	for ok && index < len(w.Proxies) {
+		gval, err := w.ListStoreProxies.GetValue(iter, 0)
+		if err != nil {
+			return errors.Wrap(err, "Can't get value for iter")
+		}
+		val, err := gval.GoValue()
+		if err != nil {
+			return errors.Wrap(err, "Can't get value for gvalue")
+		}
+		if selected, _ := val.(bool); selected {
+			w.Selected = append(w.Selected, w.Proxies[index])
+		}
+		index++
+		ok = w.ListStoreProxies.IterNext(iter)
+	}
+	return nil
+
+}
+
+func (w *DiscoverDialog) OnButtonOkClicked() {
+	err := w.LoadSelected()
+	if err != nil {
+		proxychangerlib.Log.Errorf("Error reading selected proxies: %v", err)
+		goutils.ShowMessage(&w.Dialog.Window, gtk.MESSAGE_ERROR, proxychangerlib.MyGettextv("Error"), proxychangerlib.MyGettextv("Please review the LOG."))
+		return
+	}
+	w.Dialog.Response(gtk.RESPONSE_OK)
+}
+
+func (w *DiscoverDialog) OnButtonCancelClicked() {
+	w.Dialog.Response(gtk.RESPONSE_CANCEL)
+}
@@ -0,0 +1,209 @@
+package proxychangergui
+
+import (
+	"github.com/gotk3/gotk3/gtk"
+	"github.com/okelet/goutils"
+	"github.com/okelet/proxychanger/proxychangerlib"
+	"github.com/pkg/errors"
+)
+
+// Shows the changes that a merge import will make, and lets the user choose how to resolve the conflicts
+type ImportDialog struct {
+	*goutils.BuilderBase
+	ConfigWindow *ConfigWindow
+	Entries      []*proxychangerlib.ImportEntry
+
+	Dialog                 *gtk.Dialog
+	TreeViewEntries        *gtk.TreeView
+	ListStoreEntries       *gtk.ListStore
+	ListStoreResolutions   *gtk.ListStore
+	ResolutionDisplayNames map[string]string
+}
+
+func NewImportDialog(configWindow *ConfigWindow, filename string, entries []*proxychangerlib.ImportEntry) (*ImportDialog, error) {
+
+	var err error
+
+	w := ImportDialog{}
+	w.ConfigWindow = configWindow
+	w.Entries = entries
+
+	w.BuilderBase, err = goutils.NewBuilderBase(w.ConfigWindow.Indicator, "assets/import.glade")
+	if err != nil {
+		return nil, errors.Wrap(err, proxychangerlib.MyGettextv("Error loading asset %v", "assets/import.glade"))
+	}
+
+	// ------------------------------------------------------------------------------------
+
+	w.Dialog, err = w.GetDialog("dialog_import")
+	if err != nil {
+		return nil, errors.Wrap(err, proxychangerlib.MyGettextv("Error getting widget %v", "dialog_import"))
+	}
+	w.Dialog.SetTransientFor(configWindow.Window)
+	w.Dialog.Resize(600, 400)
+	w.Dialog.SetPosition(gtk.WIN_POS_CENTER)
+	w.Dialog.SetIconName(proxychangerlib.ICON_NAME)
+	w.Dialog.SetTitle(proxychangerlib.MyGettextv("Import %v", filename))
+
+	// ------------------------------------------------------------------------------------
+
+	w.TreeViewEntries, err = w.GetTreeView("treeview_import_entries")
+	if err != nil {
+		return nil, errors.Wrap(err, proxychangerlib.MyGettextv("Error getting widget %v", "treeview_import_entries"))
+	}
+
+	w.ListStoreEntries, err = w.GetListStore("liststore_import_entries")
+	if err != nil {
+		return nil, errors.Wrap(err, proxychangerlib.MyGettextv("Error getting widget %v", "liststore_import_entries"))
+	}
+
+	w.ListStoreResolutions, err = w.GetListStore("liststore_import_resolutions")
+	if err != nil {
+		return nil, errors.Wrap(err, proxychangerlib.MyGettextv("Error getting widget %v", "liststore_import_resolutions"))
+	}
+
+	err = w.FillEntries()
+	if err != nil {
+		return nil, errors.Wrap(err, proxychangerlib.MyGettextv("Error filling import entries"))
+	}
+
+	// ------------------------------------------------------------------------------------
+	// Signals
+	// ------------------------------------------------------------------------------------
+
+	w.Builder.ConnectSignals(map[string]interface{}{
+		"on_button_ok_clicked":        w.OnButtonOkClicked,
+		"on_button_cancel_clicked":    w.OnButtonCancelClicked,
+		"on_import_resolution_edited": w.OnImportResolutionEdited,
+	})
+
+	return &w, nil
+
+}
+
+// Returns the text shown for the action of an import entry
+func GetImportActionDisplayName(action string) string {
+	switch action {
+	case proxychangerlib.IMPORT_ACTION_ADD:
+		return proxychangerlib.MyGettextv("Add")
+	case proxychangerlib.IMPORT_ACTION_UPDATE:
+		return proxychangerlib.MyGettextv("Update")
+	case proxychangerlib.IMPORT_ACTION_UNCHANGED:
+		return proxychangerlib.MyGettextv("Unchanged")
+	case proxychangerlib.IMPORT_ACTION_REMOVE:
+		return proxychangerlib.MyGettextv("Remove")
+	case proxychangerlib.IMPORT_ACTION_CONFLICT:
+		return proxychangerlib.MyGettextv("Conflict")
+	case proxychangerlib.IMPORT_ACTION_SKIP:
+		return proxychangerlib.MyGettextv("Skip")
+	}
+	return action
+}
+
+// Reads the resolutions and their (translated) names from the model of the combo
+func (w *ImportDialog) LoadResolutionDisplayNames() error {
+
+	w.ResolutionDisplayNames = map[string]string{}
+	iter, ok := w.ListStoreResolutions.GetIterFirst()
+	for ok {
+		values := []string{}
+		for column := 0; column < 2; column++ {
+			gval, err := w.ListStoreResolutions.GetValue(iter, column)
+			if err != nil {
+				return errors.Wrap(err, "Can't get value for iter")
+			}
+			val, err := gval.GoValue()
+			if err != nil {
+				return errors.Wrap(err, "Can't get value for gvalue")
+			}
+			s, _ := val.(string)
+			values = append(values, s)
+		}
+		w.ResolutionDisplayNames[values[0]] = values[1]
+		ok = w.ListStoreResolutions.IterNext(iter)
+	}
+	return nil
+
+}
+
+func (w *ImportDialog) FillEntries() error {
+
+	err := w.LoadResolutionDisplayNames()
+	if err != nil {
+		return err
+	}
+
+	w.ListStoreEntries.Clear()
+	for _, e := range w.Entries {
+		iter := w.ListStoreEntries.Append()
+		resolution := w.ResolutionDisplayNames[e.Resolution]
+		editable := e.Action == proxychangerlib.IMPORT_ACTION_CONFLICT
+		for column, value := range []interface{}{e.Key, GetImportActionDisplayName(e.Action), e.GetName(), e.Message, e.Resolution, resolution, editable} {
+			err = w.ListStoreEntries.SetValue(iter, column, value)
+			if err != nil {
+				return errors.Wrap(err, proxychangerlib.MyGettextv("Error setting import entry %v", e.Key))
+			}
+		}
+	}
+	return nil
+
+}
+
+// The combo returns the text of the selected resolution; the id is searched by that text
+func (w *ImportDialog) OnImportResolutionEdited(cellRendererCombo *gtk.CellRendererCombo, path string, text string) {
+
+	iter, err := w.ListStoreEntries.GetIterFromString(path)
+	if err != nil {
+		proxychangerlib.Log.Errorf("Can't get iter for path: %v", err)
+		goutils.ShowMessage(&w.Dialog.Window, gtk.MESSAGE_ERROR, proxychangerlib.MyGettextv("Error"), proxychangerlib.MyGettextv("Please review the LOG."))
+		return
+	}
+
+	for id, displayName := range w.ResolutionDisplayNames {
+		if displayName == text {
+			w.ListStoreEntries.SetValue(iter, 4, id)
+			w.ListStoreEntries.SetValue(iter, 5, displayName)
+			return
+		}
+	}
+	proxychangerlib.Log.Errorf("Unknown resolution %v", text)
+
+}
+
+// Sets the resolutions chosen by the user in the entries; the rows are in the same order than the entries
+func (w *ImportDialog) ApplyResolutions() error {
+
+	index := 0
+	iter, ok := w.ListStoreEntries.GetIterFirst()
+	for ok && index < len(w.Entries) {
+		gval, err := w.ListStoreEntries.GetValue(iter, 4)
+		if err != nil {
+			return errors.Wrap(err, "Can't get value for iter")
+		}
+		val, err := gval.GoValue()
+		if err != nil {
+			return errors.Wrap(err, "Can't get value for gvalue")
+		}
+		if w.Entries[index].Action == proxychangerlib.IMPORT_ACTION_CONFLICT {
+			w.Entries[index].Resolution, _ = val.(string)
+		}
+		index++
+		ok = w.ListStoreEntries.IterNext(iter)
+	}
+	return nil
+
+}
+
+func (w *ImportDialog) OnButtonOkClicked() {
+	err := w.ApplyResolutions()
+	if err != nil {
+		proxychangerlib.Log.Errorf("Error reading resolutions: %v", err)
+		goutils.ShowMessage(&w.Dialog.Window, gtk.MESSAGE_ERROR, proxychangerlib.MyGettextv("Error"), proxychangerlib.MyGettextv("Please review the LOG."))
+		return
+	}
+	w.Dialog.Response(gtk.RESPONSE_OK)
+}
+
+func (w *ImportDialog) OnButtonCancelClicked() {
+	w.Dialog.Response(gtk.RESPONSE_CANCEL)
+}
@@ -0,0 +1,782 @@
+package proxychangergui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/juju/loggo"
+
+	"github.com/esiqveland/notify"
+	"github.com/godbus/dbus"
+	"github.com/gotk3/gotk3/glib"
+	"github.com/gotk3/gotk3/gtk"
+	appindicator "github.com/jamesadney/appindicator"
+	appindicatorgtk3 "github.com/jamesadney/appindicator/gtk-extensions/gotk3"
+	"github.com/okelet/goutils"
+	"github.com/okelet/proxychanger/proxychangerlib"
+	"github.com/pkg/errors"
+)
+
+type Indicator struct {
+	Config  *proxychangerlib.Configuration
+	Service *proxychangerlib.Service
+
+	AppIndicator *appindicatorgtk3.AppIndicatorGotk3
+	ConfigWindow *ConfigWindow
+
+	NoProxyRadioItem       *gtk.RadioMenuItem
+	NoProxyRadioItemHandle glib.SignalHandle
+	// Menu items of the proxies, by proxy UUID
+	ProxyMenuItems map[string]*ProxyMenuItem
+
+	NotificationId uint32
+
+	// Avoids asking again while the configuration conflict dialog is shown
+	ConflictDialogShown bool
+}
+
+type ProxyMenuItem struct {
+	Item   *gtk.RadioMenuItem
+	Handle glib.SignalHandle
+}
+
+func NewIndicator(sessionBus *dbus.Conn, config *proxychangerlib.Configuration, currentVersion string, cmdLogLevelSet bool, testMode bool) (*Indicator, error) {
+
+	var err error
+
+	i := Indicator{}
+
+	i.Config = config
+	i.ProxyMenuItems = map[string]*ProxyMenuItem{}
+	i.Service = proxychangerlib.NewService(sessionBus, config, currentVersion, cmdLogLevelSet, testMode)
+	i.Service.Notifier = &i
+	i.Service.FirstRunWizard = true
+
+	i.AppIndicator = appindicatorgtk3.NewAppIndicator(proxychangerlib.APP_ID, proxychangerlib.ICON_NAME, appindicator.CategoryApplicationStatus)
+	i.AppIndicator.SetStatus(appindicator.StatusActive)
+
+	i.ConfigWindow, err = NewConfigWindow(&i)
+	if err != nil {
+		return nil, errors.Wrap(err, proxychangerlib.MyGettextv("Error creating configuration window"))
+	}
+
+	return &i, nil
+
+}
+
+func (i *Indicator) BuildMenu() error {
+
+	var err error
+
+	// The configuration is changed from other goroutines, so the data shown is read holding its lock, and the
+	// menu is built after releasing it
+	type proxyItem struct {
+		Proxy  *proxychangerlib.Proxy
+		Name   string
+		Active bool
+	}
+	var proxyItems []proxyItem
+	var noActiveProxy, proxiesLocked bool
+	var lastResultsErrors = -1
+	i.Config.Update(func() {
+		noActiveProxy = i.Config.ActiveProxy == nil
+		proxiesLocked = i.Config.EnableAutoChangeByIp && i.Config.WhatToDoWhenNoIpMatches == proxychangerlib.DEACTIVATE_PROXY
+		for _, p := range i.Config.Proxies {
+			proxyItems = append(proxyItems, proxyItem{p, p.Name, i.Config.ActiveProxy == p})
+		}
+		if i.Config.LastExecutionResults != nil {
+			lastResultsErrors = i.Config.LastExecutionResults.GetNumberOfErrors()
+		}
+	})
+
+	menu, err := gtk.MenuNew()
+	if err != nil {
+		proxychangerlib.Log.Errorf("Error building GUI: %v.", err)
+		goutils.ShowMessage(nil, gtk.MESSAGE_ERROR, proxychangerlib.MyGettextv("Error"), proxychangerlib.MyGettextv("Please review the LOG."))
+		return nil
+	}
+
+	noProxyRadioItem, err := gtk.RadioMenuItemNewWithLabel(nil, proxychangerlib.MyGettextv("No proxy"))
+	if err != nil {
+		proxychangerlib.Log.Errorf("Error building GUI: %v.", err)
+		goutils.ShowMessage(nil, gtk.MESSAGE_ERROR, proxychangerlib.MyGettextv("Error"), proxychangerlib.MyGettextv("Please review the LOG."))
+		return nil
+	} else {
+		if noActiveProxy {
+			noProxyRadioItem.SetActive(true)
+		}
+		handle, err := noProxyRadioItem.Connect("activate", i.OnProxyItemDeActivated)
+		if err != nil {
+			proxychangerlib.Log.Errorf("Error building GUI: %v.", err)
+			goutils.ShowMessage(nil, gtk.MESSAGE_ERROR, proxychangerlib.MyGettextv("Error"), proxychangerlib.MyGettextv("Please review the LOG."))
+		} else {
+			menu.Append(noProxyRadioItem)
+			i.NoProxyRadioItem = noProxyRadioItem
+			i.NoProxyRadioItemHandle = handle
+		}
+		i.NoProxyRadioItem.SetSensitive(!proxiesLocked)
+	}
+
+	group, err := noProxyRadioItem.GetGroup()
+	if err != nil {
+		proxychangerlib.Log.Errorf("Error building GUI: %v.", err)
+		goutils.ShowMessage(nil, gtk.MESSAGE_ERROR, proxychangerlib.MyGettextv("Error"), proxychangerlib.MyGettextv("Please review the LOG."))
+		return nil
+	}
+
+	i.ProxyMenuItems = map[string]*ProxyMenuItem{}
+	if len(proxyItems) > 0 {
+		for _, pi := range proxyItems {
+			p := pi.Proxy
+			radioItem, err := gtk.RadioMenuItemNewWithLabel(group, pi.Name)
+			if err != nil {
+				proxychangerlib.Log.Errorf("Error building GUI: %v.", err)
+				goutils.ShowMessage(nil, gtk.MESSAGE_ERROR, proxychangerlib.MyGettextv("Error"), proxychangerlib.MyGettextv("Please review the LOG."))
+				return nil
+			} else {
+				if pi.Active {
+					radioItem.SetActive(true)
+				}
+				handle, err := radioItem.Connect("activate", i.OnProxyItemActivated, p)
+				if err != nil {
+					proxychangerlib.Log.Errorf("Error building GUI: %v.", err)
+					goutils.ShowMessage(nil, gtk.MESSAGE_ERROR, proxychangerlib.MyGettextv("Error"), proxychangerlib.MyGettextv("Please review the LOG."))
+				} else {
+					menu.Append(radioItem)
+					i.ProxyMenuItems[p.UUID] = &ProxyMenuItem{radioItem, handle}
+				}
+				radioItem.SetSensitive(!proxiesLocked)
+			}
+		}
+	} else {
+		radioItem, err := gtk.RadioMenuItemNewWithLabel(group, proxychangerlib.MyGettextv("No proxies defined"))
+		if err != nil {
+			proxychangerlib.Log.Errorf("Error building GUI: %v.", err)
+			goutils.ShowMessage(nil, gtk.MESSAGE_ERROR, proxychangerlib.MyGettextv("Error"), proxychangerlib.MyGettextv("Please review the LOG."))
+			return nil
+		} else {
+			radioItem.SetSensitive(false)
+			menu.Append(radioItem)
+		}
+	}
+
+	sepItem, err := gtk.SeparatorMenuItemNew()
+	if err != nil {
+		proxychangerlib.Log.Errorf("Error building GUI: %v.", err)
+		goutils.ShowMessage(nil, gtk.MESSAGE_ERROR, proxychangerlib.MyGettextv("Error"), proxychangerlib.MyGettextv("Please review the LOG."))
+	} else {
+		menu.Append(sepItem)
+	}
+
+	item, err := gtk.MenuItemNewWithLabel(proxychangerlib.MyGettextv("Configuration"))
+	if err != nil {
+		proxychangerlib.Log.Errorf("Error building GUI: %v.", err)
+		goutils.ShowMessage(nil, gtk.MESSAGE_ERROR, proxychangerlib.MyGettextv("Error"), proxychangerlib.MyGettextv("Please review the LOG."))
+		return nil
+	} else {
+		_, err := item.Connect("activate", i.ShowConfigurationWindow)
+		if err != nil {
+			proxychangerlib.Log.Errorf("Error building GUI: %v.", err)
+			goutils.ShowMessage(nil, gtk.MESSAGE_ERROR, proxychangerlib.MyGettextv("Error"), proxychangerlib.MyGettextv("Please review the LOG."))
+		}
+		menu.Append(item)
+	}
+
+	appsMenu, err := i.BuildApplicationsMenu()
+	if err != nil {
+		proxychangerlib.Log.Errorf("Error building GUI: %v.", err)
+		goutils.ShowMessage(nil, gtk.MESSAGE_ERROR, proxychangerlib.MyGettextv("Error"), proxychangerlib.MyGettextv("Please review the LOG."))
+		return nil
+	}
+	item, err = gtk.MenuItemNewWithLabel(proxychangerlib.MyGettextv("Applications"))
+	if err != nil {
+		proxychangerlib.Log.Errorf("Error building GUI: %v.", err)
+		goutils.ShowMessage(nil, gtk.MESSAGE_ERROR, proxychangerlib.MyGettextv("Error"), proxychangerlib.MyGettextv("Please review the LOG."))
+		return nil
+	} else {
+		item.SetSubmenu(appsMenu)
+		menu.Append(item)
+	}
+
+	itemLabel := proxychangerlib.MyGettextv("Status")
+	if lastResultsErrors > 0 {
+		itemLabel = proxychangerlib.MyGettextv("%v Status", "\u2716")
+	} else if lastResultsErrors == 0 {
+		itemLabel = proxychangerlib.MyGettextv("%v Status", "\u2714")
+	}
+	item, err = gtk.MenuItemNewWithLabel(itemLabel)
+	if err != nil {
+		proxychangerlib.Log.Errorf("Error building GUI: %v.", err)
+		goutils.ShowMessage(nil, gtk.MESSAGE_ERROR, proxychangerlib.MyGettextv("Error"), proxychangerlib.MyGettextv("Please review the LOG."))
+		return nil
+	} else {
+		if lastResultsErrors >= 0 {
+			_, err := item.Connect("activate", i.ShowLastExecutionResults)
+			if err != nil {
+				proxychangerlib.Log.Errorf("Error building GUI: %v.", err)
+				goutils.ShowMessage(nil, gtk.MESSAGE_ERROR, proxychangerlib.MyGettextv("Error"), proxychangerlib.MyGettextv("Please review the LOG."))
+			}
+		} else {
+			item.SetSensitive(false)
+		}
+		menu.Append(item)
+	}
+
+	if i.Service.NewVersionDetected != "" {
+		item, err = gtk.MenuItemNewWithLabel(proxychangerlib.MyGettextv("New version %v released, click to update", i.Service.NewVersionDetected))
+		if err != nil {
+			proxychangerlib.Log.Errorf("Error building GUI: %v.", err)
+			goutils.ShowMessage(nil, gtk.MESSAGE_ERROR, proxychangerlib.MyGettextv("Error"), proxychangerlib.MyGettextv("Please review the LOG."))
+			return nil
+		} else {
+			_, err := item.Connect("activate", goutils.XdgOpenFromMenuItem, fmt.Sprintf("https://github.com/okelet/proxychanger/releases/tag/%v", i.Service.NewVersionDetected))
+			if err != nil {
+				proxychangerlib.Log.Errorf("Error building GUI: %v.", err)
+				goutils.ShowMessage(nil, gtk.MESSAGE_ERROR, proxychangerlib.MyGettextv("Error"), proxychangerlib.MyGettextv("Please review the LOG."))
+			}
+			menu.Append(item)
+		}
+	}
+
+	item, err = gtk.MenuItemNewWithLabel(proxychangerlib.MyGettextv("Show LOG"))
+	if err != nil {
+		proxychangerlib.Log.Errorf("Error building GUI: %v.", err)
+		goutils.ShowMessage(nil, gtk.MESSAGE_ERROR, proxychangerlib.MyGettextv("Error"), proxychangerlib.MyGettextv("Please review the LOG."))
+		return nil
+	} else {
+		_, err := item.Connect("activate", goutils.XdgOpenFromMenuItem, proxychangerlib.LOG_PATH)
+		if err != nil {
+			proxychangerlib.Log.Errorf("Error building GUI: %v.", err)
+			goutils.ShowMessage(nil, gtk.MESSAGE_ERROR, proxychangerlib.MyGettextv("Error"), proxychangerlib.MyGettextv("Please review the LOG."))
+		}
+		menu.Append(item)
+	}
+
+	item, err = gtk.MenuItemNewWithLabel(proxychangerlib.MyGettextv("Help"))
+	if err != nil {
+		proxychangerlib.Log.Errorf("Error building GUI: %v.", err)
+		goutils.ShowMessage(nil, gtk.MESSAGE_ERROR, proxychangerlib.MyGettextv("Error"), proxychangerlib.MyGettextv("Please review the LOG."))
+		return nil
+	} else {
+		_, err := item.Connect("activate", goutils.XdgOpenFromMenuItem, fmt.Sprintf("https://github.com/okelet/proxychanger?currentVersion=%v", i.Service.CurrentVersion))
+		if err != nil {
+			proxychangerlib.Log.Errorf("Error building GUI: %v.", err)
+			goutils.ShowMessage(nil, gtk.MESSAGE_ERROR, proxychangerlib.MyGettextv("Error"), proxychangerlib.MyGettextv("Please review the LOG."))
+		}
+		menu.Append(item)
+	}
+
+	item, err = gtk.MenuItemNewWithLabel(proxychangerlib.MyGettextv("Quit"))
+	if err != nil {
+		proxychangerlib.Log.Errorf("Error building GUI: %v.", err)
+		goutils.ShowMessage(nil, gtk.MESSAGE_ERROR, proxychangerlib.MyGettextv("Error"), proxychangerlib.MyGettextv("Please review the LOG."))
+		return nil
+	} else {
+		_, err := item.Connect("activate", i.Quit)
+		if err != nil {
+			proxychangerlib.Log.Errorf("Error building GUI: %v.", err)
+			goutils.ShowMessage(nil, gtk.MESSAGE_ERROR, proxychangerlib.MyGettextv("Error"), proxychangerlib.MyGettextv("Please review the LOG."))
+		}
+		menu.Append(item)
+	}
+
+	menu.ShowAll()
+	i.AppIndicator.SetMenu(menu)
+
+	return nil
+
+}
+
+// Builds the submenu that allows to apply the active proxy to, or clear the proxy from, a single application
+func (i *Indicator) BuildApplicationsMenu() (*gtk.Menu, error) {
+
+	var applications []proxychangerlib.ProxifiedApplication
+	var hasActiveProxy bool
+	i.Config.Update(func() {
+		applications = i.Config.GetEnabledApplications()
+		hasActiveProxy = i.Config.ActiveProxy != nil
+	})
+
+	menu, err := gtk.MenuNew()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, a := range applications {
+
+		appMenu, err := gtk.MenuNew()
+		if err != nil {
+			return nil, err
+		}
+
+		item, err := gtk.MenuItemNewWithLabel(proxychangerlib.MyGettextv("Apply active proxy"))
+		if err != nil {
+			return nil, err
+		}
+		_, err = item.Connect("activate", i.OnApplicationApplyItemActivated, a.GetId())
+		if err != nil {
+			return nil, err
+		}
+		item.SetSensitive(hasActiveProxy)
+		appMenu.Append(item)
+
+		item, err = gtk.MenuItemNewWithLabel(proxychangerlib.MyGettextv("Clear proxy"))
+		if err != nil {
+			return nil, err
+		}
+		_, err = item.Connect("activate", i.OnApplicationClearItemActivated, a.GetId())
+		if err != nil {
+			return nil, err
+		}
+		appMenu.Append(item)
+
+		item, err = gtk.MenuItemNewWithLabel(a.GetSimpleName())
+		if err != nil {
+			return nil, err
+		}
+		item.SetSubmenu(appMenu)
+		menu.Append(item)
+
+	}
+
+	return menu, nil
+
+}
+
+func (i *Indicator) OnApplicationApplyItemActivated(item *gtk.MenuItem, id string) {
+	var err error
+	i.Config.Update(func() {
+		_, err = i.Config.ApplyToApplications([]string{id}, false, proxychangerlib.MyGettextv("Proxy applied from the indicator"))
+	})
+	if err != nil {
+		proxychangerlib.Log.Errorf("Error applying proxy to application %v: %v", id, err)
+		goutils.ShowMessage(nil, gtk.MESSAGE_ERROR, proxychangerlib.MyGettextv("Error"), proxychangerlib.MyGettextv("Please review the LOG."))
+	}
+}
+
+func (i *Indicator) OnApplicationClearItemActivated(item *gtk.MenuItem, id string) {
+	var err error
+	i.Config.Update(func() {
+		_, err = i.Config.ApplyToApplications([]string{id}, true, proxychangerlib.MyGettextv("Proxy cleared from the indicator"))
+	})
+	if err != nil {
+		proxychangerlib.Log.Errorf("Error clearing proxy from application %v: %v", id, err)
+		goutils.ShowMessage(nil, gtk.MESSAGE_ERROR, proxychangerlib.MyGettextv("Error"), proxychangerlib.MyGettextv("Please review the LOG."))
+	}
+}
+
+func (i *Indicator) OnProxyItemDeActivated(item *gtk.RadioMenuItem) {
+	if item.GetActive() {
+		i.Config.Update(func() {
+			i.Config.SetActiveProxy(nil, "", true)
+		})
+	}
+}
+
+func (i *Indicator) OnProxyItemActivated(item *gtk.RadioMenuItem, p *proxychangerlib.Proxy) {
+	if item.GetActive() {
+		i.Config.Update(func() {
+			i.Config.SetActiveProxy(p, "", true)
+		})
+	}
+}
+
+func (i *Indicator) Run(setProxyNow bool) error {
+
+	var err error
+
+	err = i.BuildMenu()
+	if err != nil {
+		return errors.Wrap(err, "Error building indicator menu")
+	}
+
+	i.UpdateLabel()
+
+	i.Config.AddListener(i)
+
+	err = i.Service.Start(setProxyNow)
+	if err != nil {
+		return err
+	}
+	i.Service.CheckUpdatesThread.AddListener(i)
+
+	var numberOfProxies int
+	i.Config.Update(func() {
+		numberOfProxies = len(i.Config.Proxies)
+	})
+
+	if i.Service.FirstRun && len(i.Service.DiscoveredProxies) > 0 {
+		i.ShowDiscoverDialog(i.Service.DiscoveredProxies)
+	} else if i.Service.FirstRun && numberOfProxies == 0 {
+		if goutils.ConfirmMessage(
+			nil,
+			proxychangerlib.MyGettextv("Initial configuration"),
+			proxychangerlib.MyGettextv("Do you want to add a proxy?"),
+		) {
+			i.ConfigWindow.FillData()
+			i.ConfigWindow.Window.Show()
+			i.ConfigWindow.Window.Present()
+			i.ConfigWindow.ShowAddProxyDialog(true)
+		}
+
+	}
+
+	return nil
+
+}
+
+// Asks which discovered proxies to add, and activates the first one added if there is no active proxy
+func (i *Indicator) ShowDiscoverDialog(proxies []*proxychangerlib.DiscoveredProxy) {
+
+	d, err := NewDiscoverDialog(i, proxies)
+	if err != nil {
+		proxychangerlib.Log.Errorf("Error creating dialog: %v", err)
+		goutils.ShowMessage(nil, gtk.MESSAGE_ERROR, proxychangerlib.MyGettextv("Error"), proxychangerlib.MyGettextv("Please review the LOG."))
+		return
+	}
+
+	response := d.Dialog.Run()
+	d.Dialog.Destroy()
+	if response != int(gtk.RESPONSE_OK) || len(d.Selected) == 0 {
+		return
+	}
+
+	var added []*proxychangerlib.Proxy
+	i.Config.Update(func() {
+		added, err = i.Config.AddDiscoveredProxies(d.Selected)
+		if len(added) > 0 && i.Config.ActiveProxy == nil {
+			i.Config.SetActiveProxy(added[0], proxychangerlib.MyGettextv("Startup"), true)
+		}
+	})
+	if err != nil {
+		proxychangerlib.Log.Errorf("Error adding discovered proxies: %v", err)
+		goutils.ShowMessage(nil, gtk.MESSAGE_ERROR, proxychangerlib.MyGettextv("Error"), proxychangerlib.MyGettextv("Error adding discovered proxies: %v.", err))
+	}
+
+}
+
+func (i *Indicator) UpdateLabel() {
+	var label string
+	i.Config.Update(func() {
+		label = i.GetLabel()
+	})
+	i.AppIndicator.SetLabel(label, "")
+}
+
+// Returns the label shown next to the indicator; must be called holding the lock of the configuration
+func (i *Indicator) GetLabel() string {
+	if i.Config.ShowCurrentProxyNameNextToIndicator {
+		n := i.Config.LastExecutionResults
+		if n != nil {
+			if n.Proxy != nil {
+				if n.GetNumberOfErrors() > 0 {
+					return proxychangerlib.MyGettextv("%v %v", "\u2757", n.Proxy.Name)
+				}
+				return n.Proxy.Name
+			}
+			if n.GetNumberOfErrors() > 0 {
+				return proxychangerlib.MyGettextv("%v No proxy", "\u2757")
+			}
+			return proxychangerlib.MyGettextv("No proxy")
+		}
+		if i.Config.ActiveProxy != nil {
+			return i.Config.ActiveProxy.Name
+		}
+		return proxychangerlib.MyGettextv("No proxy")
+	}
+	return ""
+}
+
+func (i *Indicator) ShowLastExecutionResults() {
+	var lines []string
+	i.Config.Update(func() {
+		lines = i.GetLastExecutionResultsLines()
+	})
+	goutils.ShowMessage(nil, gtk.MESSAGE_INFO, "Execution results", strings.Join(lines, "\n"))
+}
+
+// Returns the description of the result of the last proxy change; must be called holding the lock of the
+// configuration
+func (i *Indicator) GetLastExecutionResultsLines() []string {
+
+	lines := []string{}
+	if i.Config.LastExecutionResults != nil {
+
+		changeScriptResult := i.Config.LastExecutionResults.ChangeScriptResult
+		if changeScriptResult != nil {
+			if changeScriptResult.Error != nil {
+				lines = append(lines, proxychangerlib.MyGettextv("%v: ERROR (%v)", "Before proxy change script", changeScriptResult.Error))
+			} else if changeScriptResult.Code != 0 {
+				lines = append(lines, proxychangerlib.MyGettextv("%v: WARNING (%v, %v)", "Before proxy change script", changeScriptResult.Code, changeScriptResult.GetCombinedOutput()))
+			} else {
+				combinedOutput := changeScriptResult.GetCombinedOutput()
+				if combinedOutput != "" {
+					lines = append(lines, proxychangerlib.MyGettextv("%v: OK (%v)", "Before proxy change script", combinedOutput))
+				} else {
+					lines = append(lines, proxychangerlib.MyGettextv("%v: OK", "Before proxy change script"))
+				}
+			}
+		} else {
+			lines = append(lines, proxychangerlib.MyGettextv("%v: Skipped (%v)", "Before proxy change script", proxychangerlib.MyGettextv("not configured")))
+		}
+
+		globalActivateScriptResult := i.Config.LastExecutionResults.GlobalActivateScriptResult
+		if globalActivateScriptResult != nil {
+			if globalActivateScriptResult.Error != nil {
+				lines = append(lines, proxychangerlib.MyGettextv("%v: ERROR (%v)", "Global activate proxy script", globalActivateScriptResult.Error))
+			} else if globalActivateScriptResult.Code != 0 {
+				lines = append(lines, proxychangerlib.MyGettextv("%v: WARNING (%v, %v)", "Global activate proxy script", globalActivateScriptResult.Code, globalActivateScriptResult.GetCombinedOutput()))
+			} else {
+				combinedOutput := globalActivateScriptResult.GetCombinedOutput()
+				if combinedOutput != "" {
+					lines = append(lines, proxychangerlib.MyGettextv("%v: OK (%v)", "Global activate proxy script", combinedOutput))
+				} else {
+					lines = append(lines, proxychangerlib.MyGettextv("%v: OK", "Global activate proxy script"))
+				}
+			}
+		} else {
+			lines = append(lines, proxychangerlib.MyGettextv("%v: Skipped (%v)", "Global activate proxy script", proxychangerlib.MyGettextv("not configured")))
+		}
+
+		globalDeactivateScriptResult := i.Config.LastExecutionResults.GlobalDeactivateScriptResult
+		if globalDeactivateScriptResult != nil {
+			if globalDeactivateScriptResult.Error != nil {
+				lines = append(lines, proxychangerlib.MyGettextv("%v: ERROR (%v)", "Global deactivate proxy script", globalDeactivateScriptResult.Error))
+			} else if globalDeactivateScriptResult.Code != 0 {
+				lines = append(lines, proxychangerlib.MyGettextv("%v: WARNING (%v, %v)", "Global deactivate proxy script", globalDeactivateScriptResult.Code, globalDeactivateScriptResult.GetCombinedOutput()))
+			} else {
+				combinedOutput := globalDeactivateScriptResult.GetCombinedOutput()
+				if combinedOutput != "" {
+					lines = append(lines, proxychangerlib.MyGettextv("%v: OK (%v)", "Global deactivate proxy script", combinedOutput))
+				} else {
+					lines = append(lines, proxychangerlib.MyGettextv("%v: OK", "Global deactivate proxy script"))
+				}
+			}
+		} else {
+			lines = append(lines, proxychangerlib.MyGettextv("%v: Skipped (%v)", "Global deactivate proxy script", proxychangerlib.MyGettextv("not configured")))
+		}
+
+		proxyActivateScriptResult := i.Config.LastExecutionResults.ProxyActivateScriptResult
+		if proxyActivateScriptResult != nil {
+			if proxyActivateScriptResult.Error != nil {
+				lines = append(lines, proxychangerlib.MyGettextv("%v: ERROR (%v)", "Activate proxy script", proxyActivateScriptResult.Error))
+			} else if proxyActivateScriptResult.Code != 0 {
+				lines = append(lines, proxychangerlib.MyGettextv("%v: WARNING (%v, %v)", "Activate proxy script", proxyActivateScriptResult.Code, proxyActivateScriptResult.GetCombinedOutput()))
+			} else {
+				combinedOutput := proxyActivateScriptResult.GetCombinedOutput()
+				if combinedOutput != "" {
+					lines = append(lines, proxychangerlib.MyGettextv("%v: OK (%v)", "Activate proxy script", combinedOutput))
+				} else {
+					lines = append(lines, proxychangerlib.MyGettextv("%v: OK", "Activate proxy script"))
+				}
+			}
+		} else {
+			lines = append(lines, proxychangerlib.MyGettextv("%v: Skipped (%v)", "Activate proxy script", proxychangerlib.MyGettextv("not configured")))
+		}
+
+		for _, r := range i.Config.LastExecutionResults.Results {
+			if r.SkippedMessage != "" {
+				lines = append(lines, proxychangerlib.MyGettextv("%v: Skipped (%v)", r.Application.GetSimpleName(), r.SkippedMessage))
+			} else if r.ErrorMessage != "" {
+				lines = append(lines, proxychangerlib.MyGettextv("%v: ERROR (%v)", r.Application.GetSimpleName(), r.ErrorMessage))
+			} else if r.WarningMessage != "" {
+				lines = append(lines, proxychangerlib.MyGettextv("%v: WARNING (%v)", r.Application.GetSimpleName(), r.WarningMessage))
+			} else {
+				lines = append(lines, proxychangerlib.MyGettextv("%v: OK", r.Application.GetSimpleName()))
+			}
+		}
+
+	} else {
+		lines = append(lines, proxychangerlib.MyGettextv("No proxy set yet."))
+	}
+	return lines
+
+}
+
+func (i *Indicator) Quit() {
+
+	i.Service.Stop()
+
+	gtk.MainQuit()
+
+}
+
+func (i *Indicator) OnProxyActivated(n *proxychangerlib.GlobalProxyChangeResult) {
+	glib.IdleAdd(i.OnProxyActivatedInternal, n)
+}
+
+func (i *Indicator) OnProxyActivatedInternal(n *proxychangerlib.GlobalProxyChangeResult) {
+
+	i.UpdateLabel()
+
+	// Set the selected proxy in the menu
+	var item *gtk.RadioMenuItem
+	var handle glib.SignalHandle
+	if n.Proxy == nil {
+		item = i.NoProxyRadioItem
+		handle = i.NoProxyRadioItemHandle
+		if handle == 0 {
+			proxychangerlib.Log.Errorf("Handle not found for deactivate menu item")
+			goutils.ShowMessage(nil, gtk.MESSAGE_ERROR, proxychangerlib.MyGettextv("Error"), proxychangerlib.MyGettextv("Please review the LOG."))
+		}
+	} else {
+		if menuItem, ok := i.ProxyMenuItems[n.Proxy.UUID]; ok {
+			item = menuItem.Item
+			handle = menuItem.Handle
+		}
+		if handle == 0 {
+			proxychangerlib.Log.Errorf("Handle not found for menu item for proxy %v", n.Proxy.Name)
+			goutils.ShowMessage(nil, gtk.MESSAGE_ERROR, proxychangerlib.MyGettextv("Error"), proxychangerlib.MyGettextv("Please review the LOG."))
+		}
+	}
+
+	if item != nil {
+		if handle != 0 {
+			item.HandlerBlock(handle)
+		}
+		item.SetActive(true)
+		if handle != 0 {
+			item.HandlerUnblock(handle)
+		}
+	}
+
+	// Rebuild menu
+	i.BuildMenu()
+
+}
+
+func (i *Indicator) OnApplicationsApplied(results []*proxychangerlib.AppProxyChangeResult) {
+	glib.IdleAdd(i.OnApplicationsAppliedInternal, results)
+}
+
+func (i *Indicator) OnApplicationsAppliedInternal(results []*proxychangerlib.AppProxyChangeResult) {
+
+	i.UpdateLabel()
+
+	// Rebuild menu, to update the status
+	i.BuildMenu()
+
+}
+
+// The configuration can be reloaded from the file watcher, so the menu is rebuilt from the GTK main loop
+func (i *Indicator) OnConfigLoaded() {
+	glib.IdleAdd(i.OnConfigLoadedInternal)
+}
+
+func (i *Indicator) OnConfigLoadedInternal() {
+	i.BuildMenu()
+	i.UpdateLabel()
+}
+
+func (i *Indicator) OnConfigConflict() {
+	glib.IdleAdd(i.OnConfigConflictInternal)
+}
+
+// Asks the user what to do when the configuration file has been changed by other program while there were
+// changes not saved: reload the file (discarding the changes) or overwrite it
+func (i *Indicator) OnConfigConflictInternal() {
+
+	if i.ConflictDialogShown {
+		return
+	}
+	i.ConflictDialogShown = true
+	defer func() { i.ConflictDialogShown = false }()
+
+	reload := goutils.ConfirmMessage(
+		nil,
+		proxychangerlib.MyGettextv("Configuration changed"),
+		proxychangerlib.MyGettextv("The configuration file has been changed by other program, but there are changes not saved. Do you want to reload the file, discarding the changes? Otherwise, the file will be overwritten."),
+	)
+
+	var err error
+	i.Config.Update(func() {
+		if reload {
+			err = i.Config.Reload()
+		} else {
+			err = i.Config.ForceSave(proxychangerlib.MyGettextv("Overwriting configuration changed by other program"))
+		}
+	})
+	if err != nil {
+		proxychangerlib.Log.Errorf("Error solving configuration conflict: %v", err)
+		goutils.ShowMessage(nil, gtk.MESSAGE_ERROR, proxychangerlib.MyGettextv("Error"), proxychangerlib.MyGettextv("Error solving configuration conflict: %v", err))
+	}
+
+}
+
+func (i *Indicator) OnProxyAdded(p *proxychangerlib.Proxy) {
+	glib.IdleAdd(i.OnProxyAddedInternal, p)
+}
+
+func (i *Indicator) OnProxyAddedInternal(p *proxychangerlib.Proxy) {
+	i.BuildMenu()
+}
+
+func (i *Indicator) OnProxyUpdated(p *proxychangerlib.Proxy) {
+	glib.IdleAdd(i.OnProxyUpdatedInternal, p)
+}
+
+func (i *Indicator) OnProxyUpdatedInternal(p *proxychangerlib.Proxy) {
+	i.BuildMenu()
+	i.UpdateLabel()
+}
+
+func (i *Indicator) OnProxyRemoved(p *proxychangerlib.Proxy) {
+	glib.IdleAdd(i.OnProxyRemovedInternal, p)
+}
+
+func (i *Indicator) OnProxyRemovedInternal(p *proxychangerlib.Proxy) {
+	i.BuildMenu()
+}
+
+func (i *Indicator) OnShowProxyNameNextToIndicatorChanged(newValue bool) {
+	i.UpdateLabel()
+}
+
+// Shows the notifications of the service from the GTK main loop
+func (i *Indicator) OnServiceNotification(title string, text string) {
+	glib.IdleAdd(i.ShowNotification, title, text)
+}
+
+func (i *Indicator) ShowNotification(title string, text string) {
+	var err error
+	i.NotificationId, err = notify.SendNotification(i.Service.SessionBus, notify.Notification{
+		AppIcon:       proxychangerlib.ICON_NAME,
+		Summary:       title,
+		Body:          text,
+		ExpireTimeout: int32(5000),
+		ReplacesID:    i.NotificationId,
+	})
+	if err != nil {
+		proxychangerlib.Log.Errorf("Error showing notification: %v.", err)
+		goutils.ShowMessage(nil, gtk.MESSAGE_ERROR, proxychangerlib.MyGettextv("Error"), proxychangerlib.MyGettextv("Please review the LOG."))
+	}
+}
+
+func (i *Indicator) ShowConfigurationWindow() {
+	i.ConfigWindow.FillData()
+	i.ConfigWindow.Window.Show()
+	i.ConfigWindow.Window.Present()
+}
+
+func (i *Indicator) GetAsset(assetName string) ([]byte, error) {
+	return Asset(assetName)
+}
+
+func (i *Indicator) OnNewVersionDetecetd(newVersion string) {
+	glib.IdleAdd(i.BuildMenu)
+}
+
+// The IP and update check threads are started and stopped by the service
+func (i *Indicator) OnEnableAutoChangeByIpChanged(newValue bool) {
+	glib.IdleAdd(i.BuildMenu)
+}
+
+func (i *Indicator) OnEnableUpdateCheckChanged(newValue bool) {
+}
+
+func (i *Indicator) OnWhatToDoWhenNoIpMatchesChanged(newValue string) {
+	glib.IdleAdd(i.BuildMenu)
+}
+
+func (i *Indicator) OnLogLevelChanged(newValue loggo.Level) {
+}
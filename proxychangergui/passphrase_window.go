@@ -0,0 +1,139 @@
+package proxychangergui
+
+import (
+	"github.com/gotk3/gotk3/gtk"
+	"github.com/okelet/goutils"
+	"github.com/okelet/proxychanger/proxychangerlib"
+	"github.com/pkg/errors"
+)
+
+// Asks the passphrase that encrypts the passwords of an exported file; when exporting, it is asked twice
+type PassphraseDialog struct {
+	*goutils.BuilderBase
+	ConfigWindow *ConfigWindow
+	Confirm      bool
+	Passphrase   string
+
+	Dialog                 *gtk.Dialog
+	LabelMessage           *gtk.Label
+	EntryPassphrase        *gtk.Entry
+	LabelPassphraseConfirm *gtk.Label
+	EntryPassphraseConfirm *gtk.Entry
+}
+
+func NewPassphraseDialog(configWindow *ConfigWindow, message string, confirm bool) (*PassphraseDialog, error) {
+
+	var err error
+
+	w := PassphraseDialog{}
+	w.ConfigWindow = configWindow
+	w.Confirm = confirm
+
+	w.BuilderBase, err = goutils.NewBuilderBase(w.ConfigWindow.Indicator, "assets/passphrase.glade")
+	if err != nil {
+		return nil, errors.Wrap(err, proxychangerlib.MyGettextv("Error loading asset %v", "assets/passphrase.glade"))
+	}
+
+	// ------------------------------------------------------------------------------------
+
+	w.Dialog, err = w.GetDialog("dialog_passphrase")
+	if err != nil {
+		return nil, errors.Wrap(err, proxychangerlib.MyGettextv("Error getting widget %v", "dialog_passphrase"))
+	}
+	w.Dialog.SetTransientFor(configWindow.Window)
+	w.Dialog.SetPosition(gtk.WIN_POS_CENTER)
+	w.Dialog.SetIconName(proxychangerlib.ICON_NAME)
+	w.Dialog.SetTitle(proxychangerlib.MyGettextv("Passphrase"))
+
+	// ------------------------------------------------------------------------------------
+
+	w.LabelMessage, err = w.GetLabel("label_passphrase_message")
+	if err != nil {
+		return nil, errors.Wrap(err, proxychangerlib.MyGettextv("Error getting widget %v", "label_passphrase_message"))
+	}
+	w.LabelMessage.SetText(message)
+
+	w.EntryPassphrase, err = w.GetEntry("entry_passphrase")
+	if err != nil {
+		return nil, errors.Wrap(err, proxychangerlib.MyGettextv("Error getting widget %v", "entry_passphrase"))
+	}
+
+	w.LabelPassphraseConfirm, err = w.GetLabel("label_passphrase_confirm")
+	if err != nil {
+		return nil, errors.Wrap(err, proxychangerlib.MyGettextv("Error getting widget %v", "label_passphrase_confirm"))
+	}
+
+	w.EntryPassphraseConfirm, err = w.GetEntry("entry_passphrase_confirm")
+	if err != nil {
+		return nil, errors.Wrap(err, proxychangerlib.MyGettextv("Error getting widget %v", "entry_passphrase_confirm"))
+	}
+
+	if !w.Confirm {
+		w.LabelPassphraseConfirm.Hide()
+		w.EntryPassphraseConfirm.Hide()
+	}
+
+	// ------------------------------------------------------------------------------------
+	// Signals
+	// ------------------------------------------------------------------------------------
+
+	w.Builder.ConnectSignals(map[string]interface{}{
+		"on_button_ok_clicked":     w.OnButtonOkClicked,
+		"on_button_cancel_clicked": w.OnButtonCancelClicked,
+	})
+
+	w.Dialog.SetFocus(&w.EntryPassphrase.Widget)
+
+	return &w, nil
+
+}
+
+// The builder base has no getter for labels
+func (w *PassphraseDialog) GetLabel(id string) (*gtk.Label, error) {
+	obj, err := w.Builder.GetObject(id)
+	if err != nil {
+		return nil, err
+	}
+	label, ok := obj.(*gtk.Label)
+	if !ok {
+		return nil, errors.Errorf("Object %v is not a label", id)
+	}
+	return label, nil
+}
+
+func (w *PassphraseDialog) OnButtonOkClicked() {
+
+	passphrase, err := w.EntryPassphrase.GetText()
+	if err != nil {
+		proxychangerlib.Log.Errorf("Error getting passphrase: %v", err)
+		goutils.ShowMessage(&w.Dialog.Window, gtk.MESSAGE_ERROR, proxychangerlib.MyGettextv("Error"), proxychangerlib.MyGettextv("Please review the LOG."))
+		return
+	}
+	if passphrase == "" {
+		goutils.ShowMessage(&w.Dialog.Window, gtk.MESSAGE_ERROR, proxychangerlib.MyGettextv("Error"), proxychangerlib.MyGettextv("The passphrase can't be empty"))
+		w.Dialog.SetFocus(&w.EntryPassphrase.Widget)
+		return
+	}
+
+	if w.Confirm {
+		repeated, err := w.EntryPassphraseConfirm.GetText()
+		if err != nil {
+			proxychangerlib.Log.Errorf("Error getting passphrase: %v", err)
+			goutils.ShowMessage(&w.Dialog.Window, gtk.MESSAGE_ERROR, proxychangerlib.MyGettextv("Error"), proxychangerlib.MyGettextv("Please review the LOG."))
+			return
+		}
+		if repeated != passphrase {
+			goutils.ShowMessage(&w.Dialog.Window, gtk.MESSAGE_ERROR, proxychangerlib.MyGettextv("Error"), proxychangerlib.MyGettextv("The passphrases don't match"))
+			w.Dialog.SetFocus(&w.EntryPassphraseConfirm.Widget)
+			return
+		}
+	}
+
+	w.Passphrase = passphrase
+	w.Dialog.Response(gtk.RESPONSE_OK)
+
+}
+
+func (w *PassphraseDialog) OnButtonCancelClicked() {
+	w.Dialog.Response(gtk.RESPONSE_CANCEL)
+}
@@ -0,0 +1,546 @@
+package proxychangergui
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/gotk3/gotk3/gtk"
+	"github.com/okelet/goutils"
+	"github.com/okelet/proxychanger/proxychangerlib"
+	"github.com/pkg/errors"
+)
+
+type ProxyDialog struct {
+	*goutils.BuilderBase
+	ConfigWindow  *ConfigWindow
+	Proxy         *proxychangerlib.Proxy
+	AskToSetAfter bool
+
+	Dialog                        *gtk.Dialog
+	EntrySlug                     *gtk.Entry
+	EntryName                     *gtk.Entry
+	EntryAddress                  *gtk.Entry
+	SpinButtonPort                *gtk.SpinButton
+	EntryUsername                 *gtk.Entry
+	EntryPassword                 *gtk.Entry
+	TextViewExceptions            *gtk.TextView
+	EntryMatchingIps              *gtk.Entry
+	EntrySchemeEndpoints          map[string]*gtk.Entry
+	SwitchCredentialSafe          *gtk.Switch
+	TextViewProxyActivateScript   *gtk.TextView
+	TextBufferProxyActivateScript *gtk.TextBuffer
+	TreeViewApps                  *gtk.TreeView
+	ListStoreApps                 *gtk.ListStore
+}
+
+func NewProxyDialog(configWindow *ConfigWindow, p *proxychangerlib.Proxy, askToSetAfter bool) (*ProxyDialog, error) {
+
+	var err error
+
+	w := ProxyDialog{}
+	w.ConfigWindow = configWindow
+	w.Proxy = p
+	w.AskToSetAfter = askToSetAfter
+
+	w.BuilderBase, err = goutils.NewBuilderBase(w.ConfigWindow.Indicator, "assets/proxy.glade")
+	if err != nil {
+		return nil, errors.Wrap(err, proxychangerlib.MyGettextv("Error loading asset %v", "assets/proxy.glade"))
+	}
+
+	// ------------------------------------------------------------------------------------
+
+	w.Dialog, err = w.GetDialog("dialog_proxy")
+	if err != nil {
+		return nil, errors.Wrap(err, proxychangerlib.MyGettextv("Error getting widget %v", "preferences_window"))
+	}
+	w.Dialog.SetTransientFor(configWindow.Window)
+	w.Dialog.Resize(400, 500)
+	w.Dialog.SetPosition(gtk.WIN_POS_CENTER)
+	w.Dialog.SetIconName(proxychangerlib.ICON_NAME)
+
+	if w.Proxy != nil {
+		w.Dialog.SetTitle(proxychangerlib.MyGettextv("Edit proxy %v", w.Proxy.Name))
+	} else {
+		w.Dialog.SetTitle(proxychangerlib.MyGettextv("Add proxy"))
+	}
+
+	// ------------------------------------------------------------------------------------
+
+	w.EntrySlug, err = w.GetEntry("entry_slug")
+	if err != nil {
+		return nil, errors.Wrap(err, proxychangerlib.MyGettextv("Error getting widget %v", "entry_slug"))
+	}
+	if w.Proxy != nil {
+		w.EntrySlug.SetText(w.Proxy.Slug)
+	}
+
+	w.EntryName, err = w.GetEntry("entry_name")
+	if err != nil {
+		return nil, errors.Wrap(err, proxychangerlib.MyGettextv("Error getting widget %v", "widget entry_name"))
+	}
+	if w.Proxy != nil {
+		w.EntryName.SetText(w.Proxy.Name)
+	}
+
+	w.EntryAddress, err = w.GetEntry("entry_address")
+	if err != nil {
+		return nil, errors.Wrap(err, proxychangerlib.MyGettextv("Error getting widget %v", "entry_address"))
+	}
+	if w.Proxy != nil {
+		w.EntryAddress.SetText(w.Proxy.Address)
+	}
+
+	w.SpinButtonPort, err = w.GetSpinButton("spinbutton_port")
+	if err != nil {
+		return nil, errors.Wrap(err, proxychangerlib.MyGettextv("Error getting widget %v", "spinbutton_port"))
+	}
+	if w.Proxy != nil {
+		w.SpinButtonPort.SetValue(float64(w.Proxy.Port))
+	}
+
+	w.EntryUsername, err = w.GetEntry("entry_username")
+	if err != nil {
+		return nil, errors.Wrap(err, proxychangerlib.MyGettextv("Error getting widget %v", "entry_username"))
+	}
+	if w.Proxy != nil {
+		w.EntryUsername.SetText(w.Proxy.Username)
+	}
+
+	w.EntryPassword, err = w.GetEntry("entry_password")
+	if err != nil {
+		return nil, errors.Wrap(err, proxychangerlib.MyGettextv("Error getting widget %v", "entry_password"))
+	}
+	if w.Proxy != nil {
+		password, err := w.Proxy.GetPassword()
+		if err != nil {
+			return nil, err
+		}
+		w.EntryPassword.SetText(password)
+	}
+
+	w.TextViewExceptions, err = w.GetTextView("textview_exceptions")
+	if err != nil {
+		return nil, errors.Wrap(err, proxychangerlib.MyGettextv("Error getting widget %v", "textview_exceptions"))
+	}
+	if w.Proxy != nil {
+		w.SetTextViewText(w.TextViewExceptions, strings.Join(w.Proxy.Exceptions, "\n"))
+	}
+
+	w.EntryMatchingIps, err = w.GetEntry("entry_matching_ips")
+	if err != nil {
+		return nil, errors.Wrap(err, proxychangerlib.MyGettextv("Error getting widget %v", "entry_matching_ips"))
+	}
+	if w.Proxy != nil {
+		w.EntryMatchingIps.SetText(strings.Join(w.Proxy.MatchingIps, ", "))
+	}
+
+	w.EntrySchemeEndpoints = map[string]*gtk.Entry{}
+	for _, scheme := range []string{proxychangerlib.PROXY_SCHEME_HTTPS, proxychangerlib.PROXY_SCHEME_FTP, proxychangerlib.PROXY_SCHEME_SOCKS} {
+		widgetName := fmt.Sprintf("entry_%v_proxy", scheme)
+		entry, err := w.GetEntry(widgetName)
+		if err != nil {
+			return nil, errors.Wrap(err, proxychangerlib.MyGettextv("Error getting widget %v", widgetName))
+		}
+		if w.Proxy != nil {
+			if e, ok := w.Proxy.SchemeEndpoints[scheme]; ok {
+				entry.SetText(e.String())
+			}
+		}
+		w.EntrySchemeEndpoints[scheme] = entry
+	}
+
+	w.SwitchCredentialSafe, err = w.GetSwitch("switch_credential_safe")
+	if err != nil {
+		return nil, errors.Wrap(err, proxychangerlib.MyGettextv("Error getting widget %v", "switch_credential_safe"))
+	}
+	if w.Proxy != nil {
+		w.SwitchCredentialSafe.SetActive(w.Proxy.CredentialSafe)
+	}
+
+	// ------------------------------------------------------------------------------------
+
+	w.TextViewProxyActivateScript, err = w.GetTextView("textview_proxy_activate_script")
+	if err != nil {
+		return nil, errors.Wrap(err, proxychangerlib.MyGettextv("Error getting widget %v", "textview_proxy_activate"))
+	}
+
+	w.TextBufferProxyActivateScript, err = w.GetTextBuffer("textbuffer_proxy_activate_script")
+	if err != nil {
+		return nil, errors.Wrap(err, proxychangerlib.MyGettextv("Error getting widget %v", "textbuffer_proxy_activate_script"))
+	}
+
+	if w.Proxy != nil {
+		w.SetTextViewText(w.TextViewProxyActivateScript, w.Proxy.ActivateScript)
+	}
+
+	// ------------------------------------------------------------------------------------
+
+	w.TreeViewApps, err = w.GetTreeView("treeview_proxy_apps")
+	if err != nil {
+		return nil, errors.Wrap(err, proxychangerlib.MyGettextv("Error getting widget %v", "treeview_proxy_apps"))
+	}
+
+	w.ListStoreApps, err = w.GetListStore("liststore_proxy_apps")
+	if err != nil {
+		return nil, errors.Wrap(err, proxychangerlib.MyGettextv("Error getting widget %v", "liststore_proxy_apps"))
+	}
+
+	err = w.FillApplications()
+	if err != nil {
+		return nil, errors.Wrap(err, proxychangerlib.MyGettextv("Error filling applications"))
+	}
+
+	// ------------------------------------------------------------------------------------
+	// Signals
+	// ------------------------------------------------------------------------------------
+
+	w.Builder.ConnectSignals(map[string]interface{}{
+		"on_togglebutton_show_password_toggled": w.OnToggleButtonShowPasswordChanged,
+		"on_button_ok_clicked":                  w.OnButtonOkClicked,
+		"on_button_cancel_clicked":              w.OnButtonCancelClicked,
+		"on_proxy_app_included_toggled":         w.OnProxyAppIncludedToggled,
+		"on_proxy_app_excluded_toggled":         w.OnProxyAppExcludedToggled,
+		"on_proxy_app_address_edited":           w.OnProxyAppAddressEdited,
+		"on_proxy_app_port_edited":              w.OnProxyAppPortEdited,
+		"on_proxy_app_exceptions_edited":        w.OnProxyAppExceptionsEdited,
+	})
+
+	w.Dialog.SetFocus(&w.EntrySlug.Widget)
+
+	return &w, nil
+
+}
+
+func (w *ProxyDialog) FillApplications() error {
+
+	var err error
+
+	w.ListStoreApps.Clear()
+	for _, a := range proxychangerlib.ProxifiedApplications {
+
+		included := false
+		excluded := false
+		address := ""
+		port := ""
+		exceptions := ""
+		if w.Proxy != nil {
+			included = goutils.ListContainsString(w.Proxy.IncludedApplicationsIds, a.GetId())
+			excluded = goutils.ListContainsString(w.Proxy.ExcludedApplicationsIds, a.GetId())
+			if o, ok := w.Proxy.ApplicationOverrides[a.GetId()]; ok {
+				address = o.Address
+				if o.Port != 0 {
+					port = strconv.Itoa(o.Port)
+				}
+				exceptions = strings.Join(o.Exceptions, ", ")
+			}
+		}
+
+		iter := w.ListStoreApps.Append()
+		for column, value := range []interface{}{a.GetId(), a.GetSimpleName(), included, excluded, address, port, exceptions} {
+			err = w.ListStoreApps.SetValue(iter, column, value)
+			if err != nil {
+				return errors.Wrap(err, proxychangerlib.MyGettextv("Error setting application %v", a.GetId()))
+			}
+		}
+
+	}
+
+	return nil
+
+}
+
+func (w *ProxyDialog) OnProxyAppIncludedToggled(cellRendererToggle *gtk.CellRendererToggle, path string) {
+	w.ToggleApplication(path, 2, 3, !cellRendererToggle.GetActive())
+}
+
+func (w *ProxyDialog) OnProxyAppExcludedToggled(cellRendererToggle *gtk.CellRendererToggle, path string) {
+	w.ToggleApplication(path, 3, 2, !cellRendererToggle.GetActive())
+}
+
+// Sets the value of the column in the row with the path; an application can not be included and
+// excluded at the same time, so the opposite column is unset when setting it
+func (w *ProxyDialog) ToggleApplication(path string, column int, oppositeColumn int, newValue bool) {
+
+	iter, err := w.ListStoreApps.GetIterFromString(path)
+	if err != nil {
+		proxychangerlib.Log.Errorf("Can't get iter for path: %v", err)
+		goutils.ShowMessage(&w.Dialog.Window, gtk.MESSAGE_ERROR, proxychangerlib.MyGettextv("Error"), proxychangerlib.MyGettextv("Please review the LOG."))
+		return
+	}
+
+	w.ListStoreApps.SetValue(iter, column, newValue)
+	if newValue {
+		w.ListStoreApps.SetValue(iter, oppositeColumn, false)
+	}
+
+}
+
+func (w *ProxyDialog) OnProxyAppAddressEdited(cellRendererText *gtk.CellRendererText, path string, text string) {
+	w.EditApplication(path, 4, strings.TrimSpace(text))
+}
+
+func (w *ProxyDialog) OnProxyAppPortEdited(cellRendererText *gtk.CellRendererText, path string, text string) {
+	w.EditApplication(path, 5, strings.TrimSpace(text))
+}
+
+func (w *ProxyDialog) OnProxyAppExceptionsEdited(cellRendererText *gtk.CellRendererText, path string, text string) {
+	w.EditApplication(path, 6, strings.TrimSpace(text))
+}
+
+func (w *ProxyDialog) EditApplication(path string, column int, text string) {
+
+	iter, err := w.ListStoreApps.GetIterFromString(path)
+	if err != nil {
+		proxychangerlib.Log.Errorf("Can't get iter for path: %v", err)
+		goutils.ShowMessage(&w.Dialog.Window, gtk.MESSAGE_ERROR, proxychangerlib.MyGettextv("Error"), proxychangerlib.MyGettextv("Please review the LOG."))
+		return
+	}
+
+	w.ListStoreApps.SetValue(iter, column, text)
+
+}
+
+// Reads the applications settings from the model
+func (w *ProxyDialog) GetApplicationSettings() ([]string, []string, map[string]*proxychangerlib.ProxyApplicationOverride, error) {
+
+	includedIds := []string{}
+	excludedIds := []string{}
+	overrides := map[string]*proxychangerlib.ProxyApplicationOverride{}
+
+	iter, ok := w.ListStoreApps.GetIterFirst()
+	for ok {
+
+		values := []interface{}{}
+		for column := 0; column < 7; column++ {
+			gval, err := w.ListStoreApps.GetValue(iter, column)
+			if err != nil {
+				return nil, nil, nil, errors.Wrap(err, "Can't get value for iter")
+			}
+			val, err := gval.GoValue()
+			if err != nil {
+				return nil, nil, nil, errors.Wrap(err, "Can't get value for gvalue")
+			}
+			values = append(values, val)
+		}
+
+		appId, _ := values[0].(string)
+		appName, _ := values[1].(string)
+		included, _ := values[2].(bool)
+		excluded, _ := values[3].(bool)
+		address, _ := values[4].(string)
+		port, _ := values[5].(string)
+		exceptions, _ := values[6].(string)
+
+		if included {
+			includedIds = append(includedIds, appId)
+		}
+		if excluded {
+			excludedIds = append(excludedIds, appId)
+		}
+
+		o := proxychangerlib.ProxyApplicationOverride{Address: address}
+		if port != "" {
+			portNumber, err := strconv.Atoi(port)
+			if err != nil {
+				return nil, nil, nil, errors.New(proxychangerlib.MyGettextv("Port for application %v is not a number", appName))
+			}
+			o.Port = portNumber
+		}
+		if exceptions != "" {
+			o.Exceptions = []string{}
+			for _, v := range strings.Split(exceptions, ",") {
+				cleanValue := strings.TrimSpace(v)
+				if cleanValue != "" {
+					o.Exceptions = append(o.Exceptions, cleanValue)
+				}
+			}
+		}
+		overrides[appId] = &o
+
+		ok = w.ListStoreApps.IterNext(iter)
+
+	}
+
+	return includedIds, excludedIds, overrides, nil
+
+}
+
+func (w *ProxyDialog) OnToggleButtonShowPasswordChanged(button *gtk.ToggleButton) {
+	w.EntryPassword.SetVisibility(button.GetActive())
+}
+
+func (w *ProxyDialog) OnButtonOkClicked() {
+
+	slug, err := w.EntrySlug.GetText()
+	if err != nil {
+		proxychangerlib.Log.Errorf("Error getting data: %v", err)
+		goutils.ShowMessage(&w.Dialog.Window, gtk.MESSAGE_ERROR, proxychangerlib.MyGettextv("Error"), proxychangerlib.MyGettextv("Please review the LOG."))
+		return
+	}
+
+	name, err := w.EntryName.GetText()
+	if err != nil {
+		proxychangerlib.Log.Errorf("Error getting data: %v", err)
+		goutils.ShowMessage(&w.Dialog.Window, gtk.MESSAGE_ERROR, proxychangerlib.MyGettextv("Error"), proxychangerlib.MyGettextv("Please review the LOG."))
+		return
+	}
+
+	address, err := w.EntryAddress.GetText()
+	if err != nil {
+		proxychangerlib.Log.Errorf("Error getting data: %v", err)
+		goutils.ShowMessage(&w.Dialog.Window, gtk.MESSAGE_ERROR, proxychangerlib.MyGettextv("Error"), proxychangerlib.MyGettextv("Please review the LOG."))
+		return
+	}
+
+	port := w.SpinButtonPort.GetValueAsInt()
+
+	username, err := w.EntryUsername.GetText()
+	if err != nil {
+		proxychangerlib.Log.Errorf("Error getting data: %v", err)
+		goutils.ShowMessage(&w.Dialog.Window, gtk.MESSAGE_ERROR, proxychangerlib.MyGettextv("Error"), proxychangerlib.MyGettextv("Please review the LOG."))
+		return
+	}
+
+	password, err := w.EntryPassword.GetText()
+	if err != nil {
+		proxychangerlib.Log.Errorf("Error getting data: %v", err)
+		goutils.ShowMessage(&w.Dialog.Window, gtk.MESSAGE_ERROR, proxychangerlib.MyGettextv("Error"), proxychangerlib.MyGettextv("Please review the LOG."))
+		return
+	}
+
+	exceptions, err := w.GetTextViewText(w.TextViewExceptions)
+	if err != nil {
+		proxychangerlib.Log.Errorf("Error getting data: %v", err)
+		goutils.ShowMessage(&w.Dialog.Window, gtk.MESSAGE_ERROR, proxychangerlib.MyGettextv("Error"), proxychangerlib.MyGettextv("Please review the LOG."))
+		return
+	}
+
+	cleanExceptions := []string{}
+	for _, v := range strings.Split(exceptions, "\n") {
+		cleanValue := strings.TrimSpace(v)
+		if cleanValue != "" {
+			cleanExceptions = append(cleanExceptions, cleanValue)
+		}
+	}
+
+	matchingIps, err := w.EntryMatchingIps.GetText()
+	if err != nil {
+		proxychangerlib.Log.Errorf("Error getting data: %v", err)
+		goutils.ShowMessage(&w.Dialog.Window, gtk.MESSAGE_ERROR, proxychangerlib.MyGettextv("Error"), proxychangerlib.MyGettextv("Please review the LOG."))
+		return
+	}
+
+	cleanMatchingIps := []string{}
+	for _, v := range strings.Split(matchingIps, ",") {
+		cleanValue := strings.TrimSpace(v)
+		if cleanValue != "" {
+			cleanMatchingIps = append(cleanMatchingIps, cleanValue)
+		}
+	}
+
+	activateScript, err := w.GetTextViewText(w.TextViewProxyActivateScript)
+	if err != nil {
+		proxychangerlib.Log.Errorf("Error getting data: %v", err)
+		goutils.ShowMessage(&w.Dialog.Window, gtk.MESSAGE_ERROR, proxychangerlib.MyGettextv("Error"), proxychangerlib.MyGettextv("Please review the LOG."))
+		return
+	}
+
+	schemeEndpoints := map[string]*proxychangerlib.ProxyEndpoint{}
+	for _, scheme := range []string{proxychangerlib.PROXY_SCHEME_HTTPS, proxychangerlib.PROXY_SCHEME_FTP, proxychangerlib.PROXY_SCHEME_SOCKS} {
+		text, err := w.EntrySchemeEndpoints[scheme].GetText()
+		if err != nil {
+			proxychangerlib.Log.Errorf("Error getting data: %v", err)
+			goutils.ShowMessage(&w.Dialog.Window, gtk.MESSAGE_ERROR, proxychangerlib.MyGettextv("Error"), proxychangerlib.MyGettextv("Please review the LOG."))
+			return
+		}
+		text = strings.TrimSpace(text)
+		if text == "" {
+			continue
+		}
+		e, err := proxychangerlib.ParseProxyEndpoint(scheme, text)
+		if err != nil {
+			goutils.ShowMessage(&w.Dialog.Window, gtk.MESSAGE_ERROR, proxychangerlib.MyGettextv("Error"), err.Error())
+			w.Dialog.SetFocus(&w.EntrySchemeEndpoints[scheme].Widget)
+			return
+		}
+		schemeEndpoints[scheme] = e
+	}
+
+	includedIds, excludedIds, overrides, err := w.GetApplicationSettings()
+	if err != nil {
+		goutils.ShowMessage(&w.Dialog.Window, gtk.MESSAGE_ERROR, proxychangerlib.MyGettextv("Error"), err.Error())
+		w.Dialog.SetFocus(&w.TreeViewApps.Widget)
+		return
+	}
+
+	p := w.Proxy
+	if p == nil {
+		p = proxychangerlib.NewEmptyProxy(w.ConfigWindow.Indicator.Config)
+	}
+	var field string
+	w.ConfigWindow.Indicator.Config.Update(func() {
+		err, field = w.ConfigWindow.Indicator.Config.UpdateProxyFromData(
+			false,
+			p,
+			true, slug,
+			true, name,
+			true, "http",
+			true, address,
+			true, port,
+			true, username,
+			true, password,
+			true, cleanExceptions,
+			true, cleanMatchingIps,
+			true, activateScript,
+		)
+		if err != nil {
+			return
+		}
+		p.SchemeEndpoints = schemeEndpoints
+		p.CredentialSafe = w.SwitchCredentialSafe.GetActive()
+		err, _ = w.ConfigWindow.Indicator.Config.UpdateProxyApplicationSettings(true, p, includedIds, excludedIds, overrides)
+		if err != nil {
+			field = "applications"
+		}
+	})
+	if err != nil {
+		goutils.ShowMessage(&w.Dialog.Window, gtk.MESSAGE_ERROR, proxychangerlib.MyGettextv("Error"), err.Error())
+		if field == "slug" {
+			w.Dialog.SetFocus(&w.EntrySlug.Widget)
+		} else if field == "name" {
+			w.Dialog.SetFocus(&w.EntryName.Widget)
+		} else if field == "address" {
+			w.Dialog.SetFocus(&w.EntryAddress.Widget)
+		} else if field == "port" {
+			w.Dialog.SetFocus(&w.SpinButtonPort.Widget)
+		} else if field == "username" {
+			w.Dialog.SetFocus(&w.EntryUsername.Widget)
+		} else if field == "password" {
+			w.Dialog.SetFocus(&w.EntryPassword.Widget)
+		} else if field == "exceptions" {
+			w.Dialog.SetFocus(&w.TextViewExceptions.Widget)
+		} else if field == "matchingips" {
+			w.Dialog.SetFocus(&w.EntryMatchingIps.Widget)
+		} else if field == "applications" {
+			w.Dialog.SetFocus(&w.TreeViewApps.Widget)
+		}
+		return
+	}
+	if w.AskToSetAfter {
+		if goutils.ConfirmMessage(
+			nil,
+			proxychangerlib.MyGettextv("Set proxy"),
+			proxychangerlib.MyGettextv("Do you want to activate this proxy?"),
+		) {
+			w.ConfigWindow.Indicator.Config.Update(func() {
+				w.ConfigWindow.Indicator.Config.SetActiveProxy(p, proxychangerlib.MyGettextv("Proxy %v added", p.Name), true)
+			})
+		}
+	}
+	w.Dialog.Response(gtk.RESPONSE_OK)
+}
+
+func (w *ProxyDialog) OnButtonCancelClicked() {
+	w.Dialog.Response(gtk.RESPONSE_CANCEL)
+}
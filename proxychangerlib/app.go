@@ -2,6 +2,10 @@ package proxychangerlib
 
 import (
 	"fmt"
+	"os/exec"
+	"strings"
+
+	"github.com/okelet/goutils"
 )
 
 var ProxifiedApplications []ProxifiedApplication
@@ -12,12 +16,32 @@ func init() {
 
 type ProxifiedApplication interface {
 	Apply(p *Proxy) *AppProxyChangeResult
+	// Returns if the application is installed, its version (if known) and the files where its proxy settings are stored
+	Detect() (installed bool, version string, configPaths []string)
 	GetId() string
 	GetSimpleName() string
 	GetDescription() string
 	GetHomepage() string
 }
 
+// Applications that can store the proxy password using their own secret mechanism, used by the proxies
+// in credential-safe mode; a nil result means that the mechanism is not available
+type CredentialSafeApplication interface {
+	ApplyCredentialSafe(p *Proxy) *AppProxyChangeResult
+}
+
+// Applications whose current proxy settings can be read, to discover the proxies already configured by the
+// user; an empty list means that the application has no proxy
+type ProxyReaderApplication interface {
+	ReadProxies() ([]*DiscoveredProxy, error)
+}
+
+// Applications that can report if their current settings match a proxy (or no proxy, if nil), shown in the
+// list of applications; the result has no messages if they match
+type ProxyStatusApplication interface {
+	Status(p *Proxy) *AppProxyChangeResult
+}
+
 func RegisterProxifiedApplication(p ProxifiedApplication) {
 	for _, a := range ProxifiedApplications {
 		if a.GetId() == p.GetId() {
@@ -26,3 +50,44 @@ func RegisterProxifiedApplication(p ProxifiedApplication) {
 	}
 	ProxifiedApplications = append(ProxifiedApplications, p)
 }
+
+func UnregisterProxifiedApplication(id string) {
+	newApplications := []ProxifiedApplication{}
+	for _, a := range ProxifiedApplications {
+		if a.GetId() != id {
+			newApplications = append(newApplications, a)
+		}
+	}
+	ProxifiedApplications = newApplications
+}
+
+func GetProxifiedApplication(id string) ProxifiedApplication {
+	for _, a := range ProxifiedApplications {
+		if a.GetId() == id {
+			return a
+		}
+	}
+	return nil
+}
+
+// Detects if the command is available, and gets its version running it with versionArgs; the version
+// is the first line of the output.
+func DetectCommand(command string, versionArgs ...string) (bool, string) {
+	commandPath, err := exec.LookPath(command)
+	if err != nil {
+		return false, ""
+	}
+	if len(versionArgs) == 0 {
+		return true, ""
+	}
+	err, _, _, outBuff, errBuff := goutils.RunCommandAndWait("", nil, commandPath, versionArgs, map[string]string{})
+	if err != nil {
+		Log.Debugf("Error getting version of command %v: %v", command, err)
+		return true, ""
+	}
+	output := strings.TrimSpace(outBuff)
+	if output == "" {
+		output = strings.TrimSpace(errBuff)
+	}
+	return true, strings.TrimSpace(strings.SplitN(output, "\n", 2)[0])
+}
@@ -2,6 +2,7 @@ package proxychangerlib
 
 import (
 	"os/exec"
+	"path"
 	"strings"
 
 	"github.com/okelet/goutils"
@@ -58,6 +59,11 @@ func (a *ApmProxySetter) Apply(p *Proxy) *AppProxyChangeResult {
 	return &AppProxyChangeResult{a, "", "", ""}
 }
 
+func (a *ApmProxySetter) Detect() (bool, string, []string) {
+	installed, version := DetectCommand("apm", "--version")
+	return installed, version, []string{path.Join(HOME_DIR, ".atom", ".apmrc")}
+}
+
 func (a *ApmProxySetter) GetId() string {
 	return "apm"
 }
@@ -5,10 +5,20 @@ import (
 	"fmt"
 	"io/ioutil"
 	"os"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
 )
 
 const DEBIAN_ETC_VERSION = "/etc/debian_version"
 const APT_PROXY_FILE = "/etc/apt/apt.conf.d/90proxy"
+const APT_CONF_FILE = "/etc/apt/apt.conf"
+const APT_CONF_DIR = "/etc/apt/apt.conf.d"
+
+// Proxy of a scheme in the APT settings; the proxies of a host (Acquire::http::proxy::host) are not matched
+var APT_PROXY_REGEXP = regexp.MustCompile(`(?i)Acquire::(https?|ftp)::proxy\s+"([^"]+)"\s*;`)
 
 // Register this application in the list of applications
 func init() {
@@ -25,12 +35,19 @@ func NewAptProxySetter() *AptProxySetter {
 func (a *AptProxySetter) Apply(p *Proxy) *AppProxyChangeResult {
 
 	var err error
-	var url string
+	urls := map[string]string{}
+	credentials := false
 
 	if p != nil {
-		url, err = p.ToUrl(true)
+		for _, scheme := range []string{PROXY_SCHEME_HTTP, PROXY_SCHEME_HTTPS, PROXY_SCHEME_FTP} {
+			urls[scheme], err = p.GetSchemeUrl(scheme, true)
+			if err != nil {
+				return &AppProxyChangeResult{a, "", "", MyGettextv("Error generating proxy URL: %v", err)}
+			}
+		}
+		credentials, err = p.HasPassword()
 		if err != nil {
-			return &AppProxyChangeResult{a, "", "", MyGettextv("Error generating proxy URL: %v", err)}
+			return &AppProxyChangeResult{a, "", "", MyGettextv("Error getting proxy password: %v", err)}
 		}
 	}
 
@@ -48,17 +65,76 @@ func (a *AptProxySetter) Apply(p *Proxy) *AppProxyChangeResult {
 
 	buff := bytes.NewBufferString("")
 	if p != nil {
-		for _, proxyType := range []string{"http", "https", "ftp"} {
-			buff.WriteString(fmt.Sprintf("Acquire::%v::proxy \"%v\";\n", proxyType, url))
+		for _, proxyType := range []string{PROXY_SCHEME_HTTP, PROXY_SCHEME_HTTPS, PROXY_SCHEME_FTP} {
+			buff.WriteString(fmt.Sprintf("Acquire::%v::proxy \"%v\";\n", proxyType, urls[proxyType]))
 		}
 	}
-	err = ioutil.WriteFile(APT_PROXY_FILE, buff.Bytes(), 0666)
+	// Avoid asking for the authorization if nothing has changed
+	oldData, err := ioutil.ReadFile(APT_PROXY_FILE)
+	if (err == nil && bytes.Equal(oldData, buff.Bytes())) || (os.IsNotExist(err) && buff.Len() == 0) {
+		return &AppProxyChangeResult{a, "", "", ""}
+	}
+
+	// With a password, the file is only readable by root; APT run by other users (apt list, for example)
+	// warns that it can't read it
+	if credentials {
+		err = WriteSystemCredentialsFile(APT_PROXY_FILE, buff.Bytes())
+	} else {
+		err = WriteSystemFile(APT_PROXY_FILE, buff.Bytes(), 0644)
+	}
 	if err != nil {
 		return &AppProxyChangeResult{a, "", "", MyGettextv("Error writing the file %v: %v; <a href=\"%v\">click here</a> for possible solutions", APT_PROXY_FILE, err, "https://github.com/okelet/proxychanger/wiki/APT")}
 	}
 	return &AppProxyChangeResult{a, "", "", ""}
 }
 
+// Reads the proxies of all the APT settings files, not only the one written by this application
+func (a *AptProxySetter) ReadProxies() ([]*DiscoveredProxy, error) {
+
+	filenames := []string{APT_CONF_FILE}
+	files, err := ioutil.ReadDir(APT_CONF_DIR)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, errors.Wrapf(err, "Error listing directory %v", APT_CONF_DIR)
+	}
+	for _, f := range files {
+		if !f.IsDir() {
+			filenames = append(filenames, path.Join(APT_CONF_DIR, f.Name()))
+		}
+	}
+
+	proxies := []*DiscoveredProxy{}
+	for _, filename := range filenames {
+		data, err := ioutil.ReadFile(filename)
+		if err != nil {
+			if !os.IsNotExist(err) {
+				Log.Warningf("Error reading file %v: %v", filename, err)
+			}
+			continue
+		}
+		for _, match := range APT_PROXY_REGEXP.FindAllStringSubmatch(string(data), -1) {
+			if strings.ToUpper(match[2]) == "DIRECT" {
+				continue
+			}
+			d, err := ParseDiscoveredProxy(a.GetId(), match[2], []string{})
+			if err != nil {
+				Log.Warningf("Ignoring proxy of file %v: %v", filename, err)
+				continue
+			}
+			proxies = MergeDiscoveredProxy(proxies, d)
+		}
+	}
+	return proxies, nil
+
+}
+
+func (a *AptProxySetter) Detect() (bool, string, []string) {
+	data, err := ioutil.ReadFile(DEBIAN_ETC_VERSION)
+	if err != nil {
+		return false, "", []string{}
+	}
+	return true, strings.TrimSpace(string(data)), []string{APT_PROXY_FILE}
+}
+
 func (a *AptProxySetter) GetId() string {
 	return "apt"
 }
@@ -0,0 +1,514 @@
+package proxychangerlib
+
+import (
+	"bytes"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/go-ini/ini"
+	"github.com/okelet/goutils"
+	"github.com/pkg/errors"
+)
+
+const CUSTOM_ACTION_COMMAND = "command"
+const CUSTOM_ACTION_INI = "ini"
+const CUSTOM_ACTION_JSON = "json"
+const CUSTOM_ACTION_FILE = "file"
+
+// Data available in the templates of the custom applications actions
+type ProxyTemplateData struct {
+	Name          string
+	Slug          string
+	Url           string
+	SimpleUrl     string
+	Protocol      string
+	Host          string
+	Port          int
+	Username      string
+	Password      string
+	Exceptions    []string
+	ExceptionsCsv string
+
+	// URL without the password, used to know if a template includes it
+	urlWithoutPassword string
+}
+
+func NewProxyTemplateData(p *Proxy) (*ProxyTemplateData, error) {
+	url, err := p.ToUrl(true)
+	if err != nil {
+		return nil, errors.Wrap(err, MyGettextv("Error generating proxy URL"))
+	}
+	urlWithoutPassword, err := p.ToUrl(false)
+	if err != nil {
+		return nil, errors.Wrap(err, MyGettextv("Error generating proxy URL"))
+	}
+	password, err := p.GetPassword()
+	if err != nil {
+		return nil, errors.Wrap(err, MyGettextv("Error getting the proxy password"))
+	}
+	d := ProxyTemplateData{
+		Name:          p.Name,
+		Slug:          p.Slug,
+		Url:           url,
+		SimpleUrl:     p.ToSimpleUrl(),
+		Protocol:      p.Protocol,
+		Host:          p.Address,
+		Port:          p.Port,
+		Username:      p.Username,
+		Password:      password,
+		Exceptions:    p.Exceptions,
+		ExceptionsCsv: strings.Join(p.Exceptions, ","),
+
+		urlWithoutPassword: urlWithoutPassword,
+	}
+	return &d, nil
+}
+
+type CustomApplicationAction struct {
+	Type string
+	// Command to run when the proxy is activated, and when it is deactivated (command)
+	Command           []string
+	DeactivateCommand []string
+	// File affected by the action (ini, json, file)
+	File string
+	// Section and key of the INI file (ini), or dotted path in the JSON file (json)
+	Section string
+	Key     string
+	// Value of the key (ini, json) or content of the file (file)
+	Value string
+	// How to store the value in the JSON file: string, int or bool (json)
+	ValueType string
+}
+
+func NewCustomApplicationActionFromMap(h *goutils.MapHelper) (*CustomApplicationAction, error) {
+	a := CustomApplicationAction{
+		Type:              h.GetString("type", ""),
+		Command:           h.GetListOfStrings("command", []string{}),
+		DeactivateCommand: h.GetListOfStrings("deactivate_command", []string{}),
+		File:              h.GetString("file", ""),
+		Section:           h.GetString("section", ""),
+		Key:               h.GetString("key", ""),
+		Value:             h.GetString("value", ""),
+		ValueType:         h.GetString("value_type", "string"),
+	}
+	switch a.Type {
+	case CUSTOM_ACTION_COMMAND:
+		if len(a.Command) == 0 && len(a.DeactivateCommand) == 0 {
+			return nil, errors.New(MyGettextv("Action of type %v needs a command or a deactivate command", a.Type))
+		}
+	case CUSTOM_ACTION_INI, CUSTOM_ACTION_JSON:
+		if a.File == "" || a.Key == "" {
+			return nil, errors.New(MyGettextv("Action of type %v needs a file and a key", a.Type))
+		}
+		if a.ValueType != "string" && a.ValueType != "int" && a.ValueType != "bool" {
+			return nil, errors.New(MyGettextv("Invalid value type %v", a.ValueType))
+		}
+	case CUSTOM_ACTION_FILE:
+		if a.File == "" {
+			return nil, errors.New(MyGettextv("Action of type %v needs a file", a.Type))
+		}
+	default:
+		return nil, errors.New(MyGettextv("Invalid action type %v", a.Type))
+	}
+	return &a, nil
+}
+
+// Application defined by the user in the configuration file or in a file in the directory CUSTOM_APPS_DIR
+type CustomProxySetter struct {
+	Id            string
+	Name          string
+	Description   string
+	Homepage      string
+	DetectCommand string
+	DetectPath    string
+	// Arguments to get the version running the detection command
+	VersionArgs []string
+	Actions     []*CustomApplicationAction
+	// File where the application has been defined; empty if defined in the configuration file
+	Source string
+	// Original definition, to save it back in the configuration file
+	Definition *goutils.MapHelper
+}
+
+func NewCustomProxySetterFromMap(h *goutils.MapHelper, source string) (*CustomProxySetter, error) {
+	a := CustomProxySetter{
+		Id:            h.GetString("id", ""),
+		Name:          h.GetString("name", ""),
+		Description:   h.GetString("description", ""),
+		Homepage:      h.GetString("homepage", ""),
+		DetectCommand: h.GetString("detect_command", ""),
+		DetectPath:    h.GetString("detect_path", ""),
+		VersionArgs:   h.GetListOfStrings("version_args", []string{}),
+		Actions:       []*CustomApplicationAction{},
+		Source:        source,
+		Definition:    h,
+	}
+	if a.Id == "" {
+		return nil, errors.New(MyGettextv("Custom application without id"))
+	}
+	if a.Name == "" {
+		a.Name = a.Id
+	}
+	for i, v := range h.GetListOfHelpers("actions") {
+		action, err := NewCustomApplicationActionFromMap(v)
+		if err != nil {
+			return nil, errors.Wrapf(err, MyGettextv("Invalid action %v of custom application %v", i+1, a.Id))
+		}
+		a.Actions = append(a.Actions, action)
+	}
+	if len(a.Actions) == 0 {
+		return nil, errors.New(MyGettextv("Custom application %v doesn't define any action", a.Id))
+	}
+	return &a, nil
+}
+
+// Loads the custom applications defined in the files of the directory dir; each file contains the
+// definition of a single application. Invalid files are logged and ignored.
+func LoadCustomProxySettersFromDir(dir string) []*CustomProxySetter {
+	apps := []*CustomProxySetter{}
+	files, err := filepath.Glob(path.Join(dir, "*.json"))
+	if err != nil {
+		Log.Errorf("Error listing custom applications in %v: %v", dir, err)
+		return apps
+	}
+	sort.Strings(files)
+	for _, f := range files {
+		h, err := goutils.NewMapHelperFromJsonFile(f, true)
+		if err != nil {
+			Log.Errorf("Error loading custom application from %v: %v", f, err)
+			continue
+		}
+		a, err := NewCustomProxySetterFromMap(h, f)
+		if err != nil {
+			Log.Errorf("Error loading custom application from %v: %v", f, err)
+			continue
+		}
+		apps = append(apps, a)
+	}
+	return apps
+}
+
+func (a *CustomProxySetter) Apply(p *Proxy) *AppProxyChangeResult {
+
+	var err error
+	var data *ProxyTemplateData
+
+	if a.DetectCommand != "" {
+		_, err = exec.LookPath(a.DetectCommand)
+		if err != nil {
+			return &AppProxyChangeResult{a, MyGettextv("Command %v not found", a.DetectCommand), "", ""}
+		}
+	}
+
+	if a.DetectPath != "" {
+		detectPath := ExpandHomeDir(a.DetectPath)
+		_, err = os.Stat(detectPath)
+		if err != nil {
+			if os.IsNotExist(err) {
+				return &AppProxyChangeResult{a, MyGettextv("Path %v not found", detectPath), "", ""}
+			}
+			return &AppProxyChangeResult{a, "", "", MyGettextv("Error checking if path %v exists: %v", detectPath, err)}
+		}
+	}
+
+	if p != nil {
+		data, err = NewProxyTemplateData(p)
+		if err != nil {
+			return &AppProxyChangeResult{a, "", "", err.Error()}
+		}
+	}
+
+	for i, action := range a.Actions {
+		switch action.Type {
+		case CUSTOM_ACTION_COMMAND:
+			err = a.runCommandAction(action, data)
+		case CUSTOM_ACTION_INI:
+			err = a.runIniAction(action, data)
+		case CUSTOM_ACTION_JSON:
+			err = a.runJsonAction(action, data)
+		case CUSTOM_ACTION_FILE:
+			err = a.runFileAction(action, data)
+		}
+		if err != nil {
+			return &AppProxyChangeResult{a, "", "", MyGettextv("Error running action %v (%v): %v", i+1, action.Type, err)}
+		}
+	}
+
+	return &AppProxyChangeResult{a, "", "", ""}
+
+}
+
+func (a *CustomProxySetter) runCommandAction(action *CustomApplicationAction, data *ProxyTemplateData) error {
+
+	command := action.Command
+	if data == nil {
+		command = action.DeactivateCommand
+	}
+	if len(command) == 0 {
+		return nil
+	}
+
+	params := []string{}
+	for _, v := range command {
+		param, err := RenderProxyTemplate(v, data)
+		if err != nil {
+			return err
+		}
+		params = append(params, param)
+	}
+
+	err, _, exitCode, outBuff, errBuff := goutils.RunCommandAndWait("", nil, params[0], params[1:], map[string]string{})
+	if err != nil {
+		// Don't show the parameters, as they could include the password
+		return errors.New(MyGettextv("Error running command %v (%v): %v", params[0], exitCode, goutils.CombineStdErrOutput(outBuff, errBuff)))
+	}
+	return nil
+
+}
+
+func (a *CustomProxySetter) runIniAction(action *CustomApplicationAction, data *ProxyTemplateData) error {
+
+	file := ExpandHomeDir(action.File)
+	err := os.MkdirAll(path.Dir(file), 0755)
+	if err != nil {
+		return errors.Wrapf(err, MyGettextv("Error creating directory %v", path.Dir(file)))
+	}
+
+	cfg, err := ini.LoadSources(ini.LoadOptions{Loose: true}, file)
+	if err != nil {
+		return errors.Wrapf(err, MyGettextv("Error reading file %v", file))
+	}
+
+	credentials := false
+	section := cfg.Section(action.Section)
+	if data != nil {
+		var value string
+		value, credentials, err = RenderProxyTemplateWithCredentials(action.Value, data)
+		if err != nil {
+			return err
+		}
+		section.Key(action.Key).SetValue(value)
+	} else {
+		section.DeleteKey(action.Key)
+	}
+
+	perm := os.FileMode(0644)
+	if credentials {
+		perm = CREDENTIALS_FILE_MODE
+	}
+	err = SafeSaveFile(file, perm, credentials, cfg.SaveTo)
+	if err != nil {
+		return errors.Wrapf(err, MyGettextv("Error writing the file %v", file))
+	}
+	return nil
+
+}
+
+func (a *CustomProxySetter) runJsonAction(action *CustomApplicationAction, data *ProxyTemplateData) error {
+
+	file := ExpandHomeDir(action.File)
+	err := os.MkdirAll(path.Dir(file), 0755)
+	if err != nil {
+		return errors.Wrapf(err, MyGettextv("Error creating directory %v", path.Dir(file)))
+	}
+
+	confData, err := goutils.LoadJsonFileAsMap(file, false)
+	if err != nil {
+		return errors.Wrapf(err, MyGettextv("Error reading file %v", file))
+	}
+
+	credentials := false
+	keys := strings.Split(action.Key, ".")
+	if data != nil {
+		var rendered string
+		rendered, credentials, err = RenderProxyTemplateWithCredentials(action.Value, data)
+		if err != nil {
+			return err
+		}
+		var value interface{}
+		switch action.ValueType {
+		case "int":
+			value, err = strconv.Atoi(rendered)
+		case "bool":
+			value, err = strconv.ParseBool(rendered)
+		default:
+			value = rendered
+		}
+		if err != nil {
+			return errors.Wrapf(err, MyGettextv("Error converting value of key %v to %v", action.Key, action.ValueType))
+		}
+		SetJsonPath(confData, keys, value)
+	} else {
+		DeleteJsonPath(confData, keys)
+	}
+
+	perm := os.FileMode(0644)
+	if credentials {
+		perm = CREDENTIALS_FILE_MODE
+	}
+	err = SafeSaveFile(file, perm, credentials, func(tmpFilename string) error {
+		return goutils.SaveMapAsJsonFile(tmpFilename, confData)
+	})
+	if err != nil {
+		return errors.Wrapf(err, MyGettextv("Error saving file %v", file))
+	}
+	return nil
+
+}
+
+func (a *CustomProxySetter) runFileAction(action *CustomApplicationAction, data *ProxyTemplateData) error {
+
+	file := ExpandHomeDir(action.File)
+
+	if data == nil {
+		err := os.Remove(file)
+		if err != nil && !os.IsNotExist(err) {
+			return errors.Wrapf(err, MyGettextv("Error deleting file %v", file))
+		}
+		return nil
+	}
+
+	content, err := RenderProxyTemplate(action.Value, data)
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(path.Dir(file), 0755)
+	if err != nil {
+		return errors.Wrapf(err, MyGettextv("Error creating directory %v", path.Dir(file)))
+	}
+
+	// The file could contain the password, so keep it private
+	err = SafeWriteCredentialsFile(file, []byte(content))
+	if err != nil {
+		return errors.Wrapf(err, MyGettextv("Error writing the file %v", file))
+	}
+	return nil
+
+}
+
+func (a *CustomProxySetter) Detect() (bool, string, []string) {
+	installed := true
+	version := ""
+	if a.DetectCommand != "" {
+		installed, version = DetectCommand(a.DetectCommand, a.VersionArgs...)
+	}
+	if installed && a.DetectPath != "" {
+		_, err := os.Stat(ExpandHomeDir(a.DetectPath))
+		installed = err == nil
+	}
+	configPaths := []string{}
+	for _, action := range a.Actions {
+		if action.File != "" && !goutils.ListContainsString(configPaths, ExpandHomeDir(action.File)) {
+			configPaths = append(configPaths, ExpandHomeDir(action.File))
+		}
+	}
+	return installed, version, configPaths
+}
+
+func (a *CustomProxySetter) GetId() string {
+	return a.Id
+}
+
+func (a *CustomProxySetter) GetSimpleName() string {
+	return a.Name
+}
+
+func (a *CustomProxySetter) GetDescription() string {
+	if a.Description != "" {
+		return a.Description
+	}
+	if a.Source != "" {
+		return MyGettextv("Custom application defined in %v", a.Source)
+	}
+	return MyGettextv("Custom application defined in the configuration")
+}
+
+func (a *CustomProxySetter) GetHomepage() string {
+	if a.Homepage != "" {
+		return a.Homepage
+	}
+	return "https://github.com/okelet/proxychanger"
+}
+
+// Renders the template text with the proxy data; when deactivating the proxy (data is nil), all
+// the values are empty.
+func RenderProxyTemplate(text string, data *ProxyTemplateData) (string, error) {
+	if data == nil {
+		data = &ProxyTemplateData{Exceptions: []string{}}
+	}
+	t, err := template.New("").Funcs(template.FuncMap{"join": strings.Join}).Parse(text)
+	if err != nil {
+		return "", errors.Wrapf(err, MyGettextv("Error parsing template %v", text))
+	}
+	buff := bytes.NewBufferString("")
+	err = t.Execute(buff, data)
+	if err != nil {
+		return "", errors.Wrapf(err, MyGettextv("Error rendering template %v", text))
+	}
+	return buff.String(), nil
+}
+
+// Renders the template like RenderProxyTemplate, and returns if the result has the password of the proxy, directly
+// or in the URL, so the file is written only readable by the user
+func RenderProxyTemplateWithCredentials(text string, data *ProxyTemplateData) (string, bool, error) {
+	rendered, err := RenderProxyTemplate(text, data)
+	if err != nil || data == nil || data.Password == "" {
+		return rendered, false, err
+	}
+	withoutPassword := *data
+	withoutPassword.Password = ""
+	withoutPassword.Url = data.urlWithoutPassword
+	renderedWithoutPassword, err := RenderProxyTemplate(text, &withoutPassword)
+	if err != nil {
+		return "", false, err
+	}
+	return rendered, rendered != renderedWithoutPassword, nil
+}
+
+// Replaces a leading ~ with the home directory of the user
+func ExpandHomeDir(p string) string {
+	if p == "~" {
+		return HOME_DIR
+	}
+	if strings.HasPrefix(p, "~/") {
+		return path.Join(HOME_DIR, p[2:])
+	}
+	return p
+}
+
+// Sets the value in the nested maps of data following the keys, creating the intermediate maps if needed
+func SetJsonPath(data map[string]interface{}, keys []string, value interface{}) {
+	current := data
+	for _, k := range keys[:len(keys)-1] {
+		next, ok := current[k].(map[string]interface{})
+		if !ok {
+			next = map[string]interface{}{}
+			current[k] = next
+		}
+		current = next
+	}
+	current[keys[len(keys)-1]] = value
+}
+
+// Deletes the value in the nested maps of data following the keys, removing the maps that become empty
+func DeleteJsonPath(data map[string]interface{}, keys []string) {
+	if len(keys) == 1 {
+		delete(data, keys[0])
+		return
+	}
+	next, ok := data[keys[0]].(map[string]interface{})
+	if !ok {
+		return
+	}
+	DeleteJsonPath(next, keys[1:])
+	if len(next) == 0 {
+		delete(data, keys[0])
+	}
+}
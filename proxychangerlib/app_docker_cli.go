@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/okelet/goutils"
+	"github.com/pkg/errors"
 )
 
 // Register this application in the list of applications
@@ -51,14 +52,21 @@ func (a *DockerCliProxySetter) Apply(p *Proxy) *AppProxyChangeResult {
 	}
 
 	if p != nil {
-		var url string
-		url, err = p.ToUrl(true)
-		if err != nil {
-			return &AppProxyChangeResult{a, "", "", MyGettextv("Error generating proxy URL: %v", err)}
+		for _, v := range []struct {
+			Key    string
+			Scheme string
+		}{{"httpProxy", PROXY_SCHEME_HTTP}, {"httpsProxy", PROXY_SCHEME_HTTPS}, {"ftpProxy", PROXY_SCHEME_FTP}, {"allProxy", PROXY_SCHEME_SOCKS}} {
+			var url string
+			url, err = p.GetSchemeUrl(v.Scheme, true)
+			if err != nil {
+				return &AppProxyChangeResult{a, "", "", MyGettextv("Error generating proxy URL: %v", err)}
+			}
+			if url != "" {
+				helper.GetHelper("proxies").GetHelper("default").SetString(v.Key, url)
+			} else {
+				helper.GetHelper("proxies").GetHelper("default").Delete(v.Key)
+			}
 		}
-		helper.GetHelper("proxies").GetHelper("default").SetString("httpProxy", url)
-		helper.GetHelper("proxies").GetHelper("default").SetString("httpsProxy", url)
-		helper.GetHelper("proxies").GetHelper("default").SetString("ftpProxy", url)
 		if len(p.Exceptions) > 0 {
 			helper.GetHelper("proxies").GetHelper("default").SetString("noProxy", strings.Join(p.Exceptions, ","))
 		} else {
@@ -73,7 +81,9 @@ func (a *DockerCliProxySetter) Apply(p *Proxy) *AppProxyChangeResult {
 		}
 	}
 
-	err = helper.SaveToJson(true)
+	err = SafeSaveFile(dockerConfFilePath, CREDENTIALS_FILE_MODE, true, func(tmpFilename string) error {
+		return helper.SaveToJsonFile(tmpFilename, true)
+	})
 	if err != nil {
 		return &AppProxyChangeResult{a, "", "", MyGettextv("Error saving file %v: %v", dockerConfFilePath, err)}
 	}
@@ -82,6 +92,40 @@ func (a *DockerCliProxySetter) Apply(p *Proxy) *AppProxyChangeResult {
 
 }
 
+// Reads the default proxies of the containers from the Docker CLI settings
+func (a *DockerCliProxySetter) ReadProxies() ([]*DiscoveredProxy, error) {
+
+	dockerConfFilePath := path.Join(HOME_DIR, ".docker", "config.json")
+	helper, err := goutils.NewMapHelperFromJsonFile(dockerConfFilePath, false)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Error loading file %v", dockerConfFilePath)
+	}
+
+	proxies := []*DiscoveredProxy{}
+	if !helper.Exists("proxies") || !helper.GetHelper("proxies").Exists("default") {
+		return proxies, nil
+	}
+	settings := helper.GetHelper("proxies").GetHelper("default")
+	exceptions := strings.Split(settings.GetString("noProxy", ""), ",")
+	for _, key := range []string{"httpProxy", "httpsProxy", "ftpProxy", "allProxy"} {
+		if value := settings.GetString(key, ""); value != "" {
+			d, err := ParseDiscoveredProxy(a.GetId(), value, exceptions)
+			if err != nil {
+				Log.Warningf("Ignoring proxy %v of file %v: %v", key, dockerConfFilePath, err)
+				continue
+			}
+			proxies = MergeDiscoveredProxy(proxies, d)
+		}
+	}
+	return proxies, nil
+
+}
+
+func (a *DockerCliProxySetter) Detect() (bool, string, []string) {
+	installed, version := DetectCommand("docker", "--version")
+	return installed, version, []string{path.Join(HOME_DIR, ".docker", "config.json")}
+}
+
 func (a *DockerCliProxySetter) GetId() string {
 	return "docker-cli"
 }
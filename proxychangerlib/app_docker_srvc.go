@@ -4,14 +4,14 @@ import (
 	"bytes"
 	"fmt"
 	"io/ioutil"
-	"os"
 	"os/exec"
-	"path"
-	"strings"
 
 	"github.com/okelet/goutils"
 )
 
+const DOCKER_SRVC_PROXY_DIR = "/etc/systemd/system/docker.service.d"
+const DOCKER_SRVC_PROXY_FILE = "/etc/systemd/system/docker.service.d/http-proxy.conf"
+
 // Register this application in the list of applications
 func init() {
 	RegisterProxifiedApplication(NewDockerSrvcProxySetter())
@@ -28,10 +28,20 @@ func (a *DockerSrvcProxySetter) Apply(p *Proxy) *AppProxyChangeResult {
 
 	var err error
 	var url string
+	var httpsUrl string
 	helpUrl := "https://github.com/okelet/proxychanger/wiki/Docker-Service-Daemon"
 
+	credentials := false
 	if p != nil {
-		url, err = p.ToUrl(true)
+		credentials, err = p.HasPassword()
+		if err != nil {
+			return &AppProxyChangeResult{a, "", "", MyGettextv("Error getting proxy password: %v", err)}
+		}
+		url, err = p.GetSchemeUrl(PROXY_SCHEME_HTTP, true)
+		if err != nil {
+			return &AppProxyChangeResult{a, "", "", MyGettextv("Error generating proxy URL: %v", err)}
+		}
+		httpsUrl, err = p.GetSchemeUrl(PROXY_SCHEME_HTTPS, true)
 		if err != nil {
 			return &AppProxyChangeResult{a, "", "", MyGettextv("Error generating proxy URL: %v", err)}
 		}
@@ -39,29 +49,15 @@ func (a *DockerSrvcProxySetter) Apply(p *Proxy) *AppProxyChangeResult {
 
 	_, err = exec.LookPath("dockerd")
 	if err != nil {
-		return &AppProxyChangeResult{a, "", MyGettextv("Command %v not found", "dockerd"), ""}
+		return &AppProxyChangeResult{a, MyGettextv("Command %v not found", "dockerd"), "", ""}
 	}
 
-	systemctlPath, err := exec.LookPath("systemctl")
+	_, err = exec.LookPath("systemctl")
 	if err != nil {
 		return &AppProxyChangeResult{a, "", MyGettextv("Command %v not found", "systemctl"), ""}
 	}
 
-	// Create dirs
-	dockerConfDirPath := path.Join("/etc/systemd/system/docker.service.d")
-	exists, err := goutils.DirExists(dockerConfDirPath)
-	if err != nil {
-		return &AppProxyChangeResult{a, "", "", MyGettextv("Error checking if directory %v exists: %v; <a href=\"%v\">click here</a> for possible solutions", dockerConfDirPath, err, helpUrl)}
-	}
-
-	if !exists {
-		err = os.MkdirAll(dockerConfDirPath, 0777)
-		if err != nil {
-			return &AppProxyChangeResult{a, "", "", MyGettextv("Error creating the directory %v: %v; <a href=\"%v\">click here</a> for possible solutions", dockerConfDirPath, err, helpUrl)}
-		}
-	}
-
-	dockerConfFilePath := path.Join(dockerConfDirPath, "http-proxy.conf")
+	dockerConfFilePath := DOCKER_SRVC_PROXY_FILE
 	var oldContent []byte
 
 	fileExists, err := goutils.FileExists(dockerConfFilePath)
@@ -80,6 +76,7 @@ func (a *DockerSrvcProxySetter) Apply(p *Proxy) *AppProxyChangeResult {
 	if p != nil {
 		buff.WriteString("[Service]\n")
 		buff.WriteString(fmt.Sprintf("Environment=HTTP_PROXY=%v\n", url))
+		buff.WriteString(fmt.Sprintf("Environment=HTTPS_PROXY=%v\n", httpsUrl))
 	}
 	newContent := buff.Bytes()
 
@@ -88,23 +85,18 @@ func (a *DockerSrvcProxySetter) Apply(p *Proxy) *AppProxyChangeResult {
 
 		Log.Debugf("%v content must be updated", dockerConfFilePath)
 
-		err = ioutil.WriteFile(dockerConfFilePath, buff.Bytes(), 0666)
-		if err != nil {
-			return &AppProxyChangeResult{a, "", "", MyGettextv("Error writing the file %v: %v; <a href=\"%v\">click here</a> for possible solutions", dockerConfFilePath, err, helpUrl)}
+		if credentials {
+			err = WriteSystemCredentialsFile(dockerConfFilePath, newContent)
+		} else {
+			err = WriteSystemFile(dockerConfFilePath, newContent, 0644)
 		}
-
-		err, _, exitCode, outBuff, errBuff := goutils.RunCommandAndWait("", nil, "sudo", []string{"-n", systemctlPath, "daemon-reload"}, map[string]string{})
-		fullCommand := strings.Join([]string{"sudo", "-n", systemctlPath, "daemon-reload"}, " ")
-		Log.Debugf("Running command %v...", fullCommand)
 		if err != nil {
-			return &AppProxyChangeResult{a, "", "", MyGettextv("Error running command %v (%v): %v; <a href=\"%v\">click here</a> for possible solutions", fullCommand, exitCode, goutils.CombineStdErrOutput(outBuff, errBuff), helpUrl)}
+			return &AppProxyChangeResult{a, "", "", MyGettextv("Error writing the file %v: %v; <a href=\"%v\">click here</a> for possible solutions", dockerConfFilePath, err, helpUrl)}
 		}
 
-		err, _, exitCode, outBuff, errBuff = goutils.RunCommandAndWait("", nil, "sudo", []string{"-n", systemctlPath, "restart", "docker.service"}, map[string]string{})
-		fullCommand = strings.Join([]string{"sudo", "-n", systemctlPath, "restart", "docker.service"}, " ")
-		Log.Debugf("Running command %v...", fullCommand)
+		err = RestartSystemService("docker.service")
 		if err != nil {
-			return &AppProxyChangeResult{a, "", "", MyGettextv("Error running command %v (%v): %v; <a href=\"%v\">click here</a> for possible solutions", fullCommand, exitCode, goutils.CombineStdErrOutput(outBuff, errBuff), helpUrl)}
+			return &AppProxyChangeResult{a, "", "", MyGettextv("Error restarting the service %v: %v; <a href=\"%v\">click here</a> for possible solutions", "docker.service", err, helpUrl)}
 		}
 
 	}
@@ -113,6 +105,11 @@ func (a *DockerSrvcProxySetter) Apply(p *Proxy) *AppProxyChangeResult {
 
 }
 
+func (a *DockerSrvcProxySetter) Detect() (bool, string, []string) {
+	installed, version := DetectCommand("dockerd", "--version")
+	return installed, version, []string{DOCKER_SRVC_PROXY_FILE}
+}
+
 func (a *DockerSrvcProxySetter) GetId() string {
 	return "docker-srvc"
 }
@@ -20,22 +20,26 @@ func NewEnvProxySetter() *EnvProxySetter {
 func (a *EnvProxySetter) Apply(p *Proxy) *AppProxyChangeResult {
 
 	var err error
-	var url string
 
 	if p != nil {
-		url, err = p.ToUrl(true)
-		if err != nil {
-			return &AppProxyChangeResult{a, "", "", MyGettextv("Error generating proxy URL: %v", err)}
-		}
-	}
-
-	if p != nil {
-		for _, v := range []string{"http_proxy", "https_proxy", "ftp_proxy"} {
-			if err = os.Setenv(v, url); err != nil {
-				return &AppProxyChangeResult{a, "", "", MyGettextv("Error setting environment variable %v: %v", v, err)}
+		for _, v := range []struct {
+			Variable string
+			Scheme   string
+		}{{"http_proxy", PROXY_SCHEME_HTTP}, {"https_proxy", PROXY_SCHEME_HTTPS}, {"ftp_proxy", PROXY_SCHEME_FTP}, {"all_proxy", PROXY_SCHEME_SOCKS}} {
+			url, err := p.GetSchemeUrl(v.Scheme, true)
+			if err != nil {
+				return &AppProxyChangeResult{a, "", "", MyGettextv("Error generating proxy URL: %v", err)}
 			}
-			if err = os.Setenv(strings.ToUpper(v), url); err != nil {
-				return &AppProxyChangeResult{a, "", "", MyGettextv("Error setting environment variable %v: %v", strings.ToUpper(v), err)}
+			for _, name := range []string{v.Variable, strings.ToUpper(v.Variable)} {
+				if url != "" {
+					if err = os.Setenv(name, url); err != nil {
+						return &AppProxyChangeResult{a, "", "", MyGettextv("Error setting environment variable %v: %v", name, err)}
+					}
+				} else {
+					if err = os.Unsetenv(name); err != nil {
+						return &AppProxyChangeResult{a, "", "", MyGettextv("Error unsetting environment variable %v: %v", name, err)}
+					}
+				}
 			}
 		}
 		if len(p.Exceptions) > 0 {
@@ -55,7 +59,7 @@ func (a *EnvProxySetter) Apply(p *Proxy) *AppProxyChangeResult {
 		}
 
 	} else {
-		for _, v := range []string{"http_proxy", "https_proxy", "ftp_proxy", "no_proxy"} {
+		for _, v := range []string{"http_proxy", "https_proxy", "ftp_proxy", "all_proxy", "no_proxy"} {
 			if err = os.Unsetenv(v); err != nil {
 				return &AppProxyChangeResult{a, "", "", MyGettextv("Error unsetting environment variable %v: %v", v, err)}
 			}
@@ -67,6 +71,33 @@ func (a *EnvProxySetter) Apply(p *Proxy) *AppProxyChangeResult {
 	return &AppProxyChangeResult{a, "", "", ""}
 }
 
+// Reads the proxy variables of the environment, in lower or upper case
+func (a *EnvProxySetter) ReadProxies() ([]*DiscoveredProxy, error) {
+	getenv := func(name string) string {
+		if value := os.Getenv(name); value != "" {
+			return value
+		}
+		return os.Getenv(strings.ToUpper(name))
+	}
+	exceptions := strings.Split(getenv("no_proxy"), ",")
+	proxies := []*DiscoveredProxy{}
+	for _, v := range []string{"http_proxy", "https_proxy", "ftp_proxy", "all_proxy"} {
+		if value := getenv(v); value != "" {
+			d, err := ParseDiscoveredProxy(a.GetId(), value, exceptions)
+			if err != nil {
+				Log.Warningf("Ignoring environment variable %v: %v", v, err)
+				continue
+			}
+			proxies = MergeDiscoveredProxy(proxies, d)
+		}
+	}
+	return proxies, nil
+}
+
+func (a *EnvProxySetter) Detect() (bool, string, []string) {
+	return true, "", []string{}
+}
+
 func (a *EnvProxySetter) GetId() string {
 	return "env"
 }
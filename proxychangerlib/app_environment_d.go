@@ -0,0 +1,162 @@
+package proxychangerlib
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"os/exec"
+	"path"
+	"strings"
+
+	"github.com/godbus/dbus"
+	"github.com/okelet/goutils"
+	"github.com/pkg/errors"
+)
+
+const SYSTEMD_DBUS_NAME = "org.freedesktop.systemd1"
+const SYSTEMD_DBUS_PATH = "/org/freedesktop/systemd1"
+const SYSTEMD_DBUS_MANAGER_INTERFACE = "org.freedesktop.systemd1.Manager"
+
+// Register this application in the list of applications
+func init() {
+	RegisterProxifiedApplication(NewEnvironmentDProxySetter())
+}
+
+// Sets the proxy in the environment of the systemd user session: in the environment.d file, read in the next
+// login, and in the running user manager, so the services and applications started from now on use it
+type EnvironmentDProxySetter struct {
+}
+
+func NewEnvironmentDProxySetter() *EnvironmentDProxySetter {
+	return &EnvironmentDProxySetter{}
+}
+
+func (a *EnvironmentDProxySetter) GetFile() string {
+	return path.Join(CONFIG_DIR, "environment.d", "90-proxychanger.conf")
+}
+
+func (a *EnvironmentDProxySetter) Apply(p *Proxy) *AppProxyChangeResult {
+
+	_, err := exec.LookPath("systemctl")
+	if err != nil {
+		return &AppProxyChangeResult{a, MyGettextv("Command %v not found", "systemctl"), "", ""}
+	}
+
+	variables := []*EnvironmentVariable{}
+	if p != nil {
+		variables, err = ProxyEnvironmentVariables(p)
+		if err != nil {
+			return &AppProxyChangeResult{a, "", "", MyGettextv("Error generating proxy URL: %v", err)}
+		}
+	}
+
+	file := a.GetFile()
+	if p != nil {
+		err = os.MkdirAll(path.Dir(file), 0755)
+		if err != nil {
+			return &AppProxyChangeResult{a, "", "", MyGettextv("Error creating directory %v: %v", path.Dir(file), err)}
+		}
+		buff := bytes.NewBufferString("# Generated by proxychanger; changes will be overwritten\n")
+		for _, v := range variables {
+			buff.WriteString(fmt.Sprintf("%v=%v\n", v.Name, EscapeEnvironmentDValue(v.Value)))
+		}
+		credentials, err := p.HasPassword()
+		if err != nil {
+			return &AppProxyChangeResult{a, "", "", MyGettextv("Error getting proxy password: %v", err)}
+		}
+		if credentials {
+			err = SafeWriteCredentialsFile(file, buff.Bytes())
+		} else {
+			err = SafeWriteFile(file, buff.Bytes(), 0644)
+		}
+		if err != nil {
+			return &AppProxyChangeResult{a, "", "", MyGettextv("Error writing the file %v: %v", file, err)}
+		}
+	} else {
+		err = os.Remove(file)
+		if err != nil && !os.IsNotExist(err) {
+			return &AppProxyChangeResult{a, "", "", MyGettextv("Error deleting file %v: %v", file, err)}
+		}
+	}
+
+	err = SetSystemdUserEnvironment(variables)
+	if err != nil {
+		return &AppProxyChangeResult{a, "", "", MyGettextv("Error setting the environment of the systemd user manager: %v", err)}
+	}
+
+	return &AppProxyChangeResult{a, "", "", ""}
+
+}
+
+// The values of environment.d files are expanded like in the shell, so the dollar signs (that could be in
+// the password) are percent-encoded, that is equivalent in the URLs
+func EscapeEnvironmentDValue(value string) string {
+	return strings.Replace(value, "$", "%24", -1)
+}
+
+// Sets the variables, and removes the rest of proxy variables, in the running systemd user manager; D-Bus is
+// used (UnsetAndSetEnvironment, that is SetEnvironment and UnsetEnvironment in one call), and systemctl if
+// D-Bus fails
+func SetSystemdUserEnvironment(variables []*EnvironmentVariable) error {
+
+	assignments := []string{}
+	names := []string{}
+	for _, v := range variables {
+		assignments = append(assignments, v.Name+"="+v.Value)
+		names = append(names, v.Name)
+	}
+	unsetNames := []string{}
+	for _, name := range ProxyEnvironmentVariableNames() {
+		if !goutils.ListContainsString(names, name) {
+			unsetNames = append(unsetNames, name)
+		}
+	}
+
+	conn, err := dbus.SessionBus()
+	if err == nil {
+		obj := conn.Object(SYSTEMD_DBUS_NAME, SYSTEMD_DBUS_PATH)
+		call := obj.Call(fmt.Sprintf("%v.%v", SYSTEMD_DBUS_MANAGER_INTERFACE, "UnsetAndSetEnvironment"), 0, unsetNames, assignments)
+		if call.Err == nil {
+			return nil
+		}
+		err = call.Err
+	}
+	Log.Debugf("Error setting the environment of the systemd user manager using D-Bus (%v); using systemctl", err)
+
+	for _, args := range [][]string{
+		append([]string{"--user", "unset-environment"}, unsetNames...),
+		append([]string{"--user", "set-environment"}, assignments...),
+	} {
+		if len(args) == 2 {
+			continue
+		}
+		err, _, exitCode, outBuff, errBuff := goutils.RunCommandAndWait("", nil, "systemctl", args, map[string]string{})
+		if err != nil {
+			return errors.New(MyGettextv("Error running command %v (%v): %v", "systemctl "+strings.Join(args[:2], " "), exitCode, goutils.CombineStdErrOutput(outBuff, errBuff)))
+		}
+	}
+
+	return nil
+
+}
+
+func (a *EnvironmentDProxySetter) Detect() (bool, string, []string) {
+	installed, version := DetectCommand("systemctl", "--version")
+	return installed, version, []string{a.GetFile()}
+}
+
+func (a *EnvironmentDProxySetter) GetId() string {
+	return "environment-d"
+}
+
+func (a *EnvironmentDProxySetter) GetSimpleName() string {
+	return MyGettextv("systemd user environment")
+}
+
+func (a *EnvironmentDProxySetter) GetDescription() string {
+	return MyGettextv("Sets the proxy in the file %v and in the running systemd user manager", a.GetFile())
+}
+
+func (a *EnvironmentDProxySetter) GetHomepage() string {
+	return "https://github.com/okelet/proxychanger"
+}
@@ -0,0 +1,98 @@
+package proxychangerlib
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+)
+
+const ETC_ENVIRONMENT_FILE = "/etc/environment"
+
+// Register this application in the list of applications
+func init() {
+	RegisterProxifiedApplication(NewEtcEnvironmentProxySetter())
+}
+
+// Sets the proxy variables for all the users in /etc/environment (read by pam_env in the login), in a managed
+// block; the file is changed using the privileged helper
+type EtcEnvironmentProxySetter struct {
+}
+
+func NewEtcEnvironmentProxySetter() *EtcEnvironmentProxySetter {
+	return &EtcEnvironmentProxySetter{}
+}
+
+func (a *EtcEnvironmentProxySetter) Apply(p *Proxy) *AppProxyChangeResult {
+
+	if !IsPrivilegedHelperAvailable() {
+		return &AppProxyChangeResult{a, MyGettextv("The privileged helper %v is not installed", PRIVILEGED_HELPER_PATH), "", ""}
+	}
+
+	// The file is readable by all the users, so the password is never written in it, and the settings of the
+	// previous proxy are removed; the local endpoints of the credential-safe mode can't be used either, as they
+	// only accept connections of this user
+	var warning string
+	if p != nil {
+		hasPassword, err := p.HasPassword()
+		if err != nil {
+			return &AppProxyChangeResult{a, "", "", MyGettextv("Error getting the proxy password: %v", err)}
+		}
+		if hasPassword {
+			warning = MyGettextv("The proxy has a password, that would be readable by all the users in the file %v; the proxy has been removed from it", ETC_ENVIRONMENT_FILE)
+			p = nil
+		}
+	}
+
+	blockLines := []string{}
+	if p != nil {
+		variables, err := ProxyEnvironmentVariables(p)
+		if err != nil {
+			return &AppProxyChangeResult{a, "", "", MyGettextv("Error generating proxy URL: %v", err)}
+		}
+		// pam_env doesn't expand nor unescape the values of this file, so they are written as they are
+		for _, v := range variables {
+			blockLines = append(blockLines, fmt.Sprintf("%v=%v", v.Name, v.Value))
+		}
+	}
+
+	data, err := ioutil.ReadFile(ETC_ENVIRONMENT_FILE)
+	if err != nil && !os.IsNotExist(err) {
+		return &AppProxyChangeResult{a, "", "", MyGettextv("Error reading file %v: %v", ETC_ENVIRONMENT_FILE, err)}
+	}
+
+	newData, changed, err := RenderRcFileBlock(ETC_ENVIRONMENT_FILE, data, blockLines, false)
+	if err != nil {
+		return &AppProxyChangeResult{a, "", "", err.Error()}
+	}
+	if !changed {
+		return &AppProxyChangeResult{a, "", warning, ""}
+	}
+
+	err = WriteSystemFile(ETC_ENVIRONMENT_FILE, newData, 0644)
+	if err != nil {
+		return &AppProxyChangeResult{a, "", "", MyGettextv("Error writing the file %v: %v", ETC_ENVIRONMENT_FILE, err)}
+	}
+
+	return &AppProxyChangeResult{a, "", warning, ""}
+
+}
+
+func (a *EtcEnvironmentProxySetter) Detect() (bool, string, []string) {
+	return true, "", []string{ETC_ENVIRONMENT_FILE}
+}
+
+func (a *EtcEnvironmentProxySetter) GetId() string {
+	return "etc-environment"
+}
+
+func (a *EtcEnvironmentProxySetter) GetSimpleName() string {
+	return MyGettextv("System environment")
+}
+
+func (a *EtcEnvironmentProxySetter) GetDescription() string {
+	return MyGettextv("Sets the proxy for all the users in the file %v", ETC_ENVIRONMENT_FILE)
+}
+
+func (a *EtcEnvironmentProxySetter) GetHomepage() string {
+	return "https://github.com/okelet/proxychanger"
+}
@@ -0,0 +1,51 @@
+package proxychangerlib
+
+import (
+	"os/exec"
+	"path"
+)
+
+// Register this application in the list of applications
+func init() {
+	RegisterProxifiedApplication(NewFishProxySetter())
+}
+
+type FishProxySetter struct {
+}
+
+func NewFishProxySetter() *FishProxySetter {
+	return &FishProxySetter{}
+}
+
+func (a *FishProxySetter) GetFile() string {
+	return path.Join(CONFIG_DIR, "fish", "conf.d", "proxychanger.fish")
+}
+
+func (a *FishProxySetter) Apply(p *Proxy) *AppProxyChangeResult {
+	_, err := exec.LookPath("fish")
+	if err != nil {
+		return &AppProxyChangeResult{a, MyGettextv("Command %v not found", "fish"), "", ""}
+	}
+	return ApplyProxyToRcFile(a, p, a.GetFile(), SHELL_SYNTAX_FISH, false)
+}
+
+func (a *FishProxySetter) Detect() (bool, string, []string) {
+	installed, version := DetectCommand("fish", "--version")
+	return installed, version, []string{a.GetFile()}
+}
+
+func (a *FishProxySetter) GetId() string {
+	return "fish"
+}
+
+func (a *FishProxySetter) GetSimpleName() string {
+	return MyGettextv("fish")
+}
+
+func (a *FishProxySetter) GetDescription() string {
+	return MyGettextv("Sets the proxy in the file %v", a.GetFile())
+}
+
+func (a *FishProxySetter) GetHomepage() string {
+	return "https://github.com/okelet/proxychanger"
+}
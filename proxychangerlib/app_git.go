@@ -1,10 +1,16 @@
 package proxychangerlib
 
 import (
+	"fmt"
+	"net"
+	"net/url"
 	"os/exec"
+	"path"
+	"strconv"
 	"strings"
 
 	"github.com/okelet/goutils"
+	"github.com/pkg/errors"
 )
 
 // Register this application in the list of applications
@@ -36,8 +42,43 @@ func (a *GitProxySetter) Apply(p *Proxy) *AppProxyChangeResult {
 		}
 	}
 
+	return a.SetProxyUrl(gitPath, p != nil, url)
+}
+
+// Stores the password using the credential helper configured in git, if any, and sets the proxy URL
+// with only the username
+func (a *GitProxySetter) ApplyCredentialSafe(p *Proxy) *AppProxyChangeResult {
+
+	gitPath, err := exec.LookPath("git")
+	if err != nil {
+		return &AppProxyChangeResult{a, MyGettextv("Command %v not found", "git"), "", ""}
+	}
+
+	err, _, _, outBuff, _ := goutils.RunCommandAndWait("", nil, gitPath, []string{"config", "--global", "--get", "credential.helper"}, map[string]string{})
+	if err != nil || strings.TrimSpace(outBuff) == "" {
+		return nil
+	}
+
+	password, err := p.GetPassword()
+	if err != nil {
+		return &AppProxyChangeResult{a, "", "", MyGettextv("Error getting the proxy password: %v", err)}
+	}
+
+	host := net.JoinHostPort(p.Address, strconv.Itoa(p.Port))
+	credential := fmt.Sprintf("protocol=%v\nhost=%v\nusername=%v\npassword=%v\n\n", p.Protocol, host, p.Username, password)
+	err, _, exitCode, outBuff, errBuff := goutils.RunCommandAndWait("", strings.NewReader(credential), gitPath, []string{"credential", "approve"}, map[string]string{})
+	if err != nil {
+		return &AppProxyChangeResult{a, "", "", MyGettextv("Error running command %v (%v): %v/%v", gitPath+" credential approve", exitCode, outBuff, errBuff)}
+	}
+
+	proxyUrl := url.URL{Scheme: p.Protocol, User: url.User(p.Username), Host: host}
+	return a.SetProxyUrl(gitPath, true, proxyUrl.String())
+
+}
+
+func (a *GitProxySetter) SetProxyUrl(gitPath string, set bool, url string) *AppProxyChangeResult {
 	var params [][]string
-	if p != nil {
+	if set {
 		params = [][]string{
 			{"config", "--global", "http.proxy", url},
 			{"config", "--global", "https.proxy", url},
@@ -58,6 +99,41 @@ func (a *GitProxySetter) Apply(p *Proxy) *AppProxyChangeResult {
 	return &AppProxyChangeResult{a, "", "", ""}
 }
 
+// Reads the proxies of the global Git settings
+func (a *GitProxySetter) ReadProxies() ([]*DiscoveredProxy, error) {
+	proxies := []*DiscoveredProxy{}
+	gitPath, err := exec.LookPath("git")
+	if err != nil {
+		return proxies, nil
+	}
+	for _, key := range []string{"http.proxy", "https.proxy"} {
+		commandParams := []string{"config", "--global", "--get", key}
+		err, _, exitCode, outBuff, errBuff := goutils.RunCommandAndWait("", nil, gitPath, commandParams, map[string]string{})
+		if err != nil {
+			// The exit code is 1 if the key is not set
+			if exitCode == 1 {
+				continue
+			}
+			fullCommand := strings.Join(append([]string{gitPath}, commandParams...), " ")
+			return nil, errors.Errorf("Error running command %v (%v): %v/%v", fullCommand, exitCode, outBuff, errBuff)
+		}
+		if value := strings.TrimSpace(outBuff); value != "" {
+			d, err := ParseDiscoveredProxy(a.GetId(), value, []string{})
+			if err != nil {
+				Log.Warningf("Ignoring Git setting %v: %v", key, err)
+				continue
+			}
+			proxies = MergeDiscoveredProxy(proxies, d)
+		}
+	}
+	return proxies, nil
+}
+
+func (a *GitProxySetter) Detect() (bool, string, []string) {
+	installed, version := DetectCommand("git", "--version")
+	return installed, version, []string{path.Join(HOME_DIR, ".gitconfig")}
+}
+
 func (a *GitProxySetter) GetId() string {
 	return "git"
 }
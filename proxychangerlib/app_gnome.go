@@ -1,7 +1,12 @@
 package proxychangerlib
 
 import (
+	"fmt"
+	"strconv"
+	"strings"
+
 	"github.com/okelet/goutils"
+	"github.com/pkg/errors"
 )
 
 // Register this application in the list of applications
@@ -20,6 +25,9 @@ func (a *GnomeProxySetter) Apply(p *Proxy) *AppProxyChangeResult {
 	var err error
 	if p != nil {
 		err = goutils.SetGnomeProxy(p.Proxy)
+		if err == nil {
+			err = a.SetSchemeEndpoints(p)
+		}
 	} else {
 		err = goutils.SetGnomeProxy(nil)
 	}
@@ -29,6 +37,125 @@ func (a *GnomeProxySetter) Apply(p *Proxy) *AppProxyChangeResult {
 	return &AppProxyChangeResult{a, "", "", ""}
 }
 
+// Sets the hosts of the schemes with their own endpoint; the SOCKS proxy is removed if not defined, as it
+// doesn't fall back to the main proxy
+func (a *GnomeProxySetter) SetSchemeEndpoints(p *Proxy) error {
+	for _, scheme := range PROXY_SCHEMES {
+		host := ""
+		port := 0
+		if e, ok := p.SchemeEndpoints[scheme]; ok {
+			host = e.Address
+			port = e.Port
+		} else if scheme != PROXY_SCHEME_SOCKS {
+			continue
+		}
+		schema := fmt.Sprintf("org.gnome.system.proxy.%v", scheme)
+		for _, args := range [][]string{{"set", schema, "host", host}, {"set", schema, "port", strconv.Itoa(port)}} {
+			err, _, exitCode, outBuff, errBuff := goutils.RunCommandAndWait("", nil, "gsettings", args, map[string]string{})
+			if err != nil {
+				return errors.Errorf("gsettings %v (%v): %v", strings.Join(args, " "), exitCode, goutils.CombineStdErrOutput(outBuff, errBuff))
+			}
+		}
+	}
+	return nil
+}
+
+// Reads the manual proxy settings of Gnome; the automatic configuration (PAC) is not supported
+func (a *GnomeProxySetter) ReadProxies() ([]*DiscoveredProxy, error) {
+
+	proxies := []*DiscoveredProxy{}
+	if installed, _ := DetectCommand("gsettings"); !installed {
+		return proxies, nil
+	}
+
+	mode, err := a.GetSetting("org.gnome.system.proxy", "mode")
+	if err != nil {
+		return nil, err
+	}
+	if mode != "manual" {
+		return proxies, nil
+	}
+
+	ignoreHosts, err := a.GetSetting("org.gnome.system.proxy", "ignore-hosts")
+	if err != nil {
+		return nil, err
+	}
+	exceptions := []string{}
+	for _, h := range strings.Split(strings.Trim(strings.TrimPrefix(ignoreHosts, "@as "), "[]"), ",") {
+		exceptions = append(exceptions, strings.Trim(strings.TrimSpace(h), "'"))
+	}
+
+	var httpProxy *DiscoveredProxy
+	for _, scheme := range PROXY_SCHEMES {
+		schema := fmt.Sprintf("org.gnome.system.proxy.%v", scheme)
+		host, err := a.GetSetting(schema, "host")
+		if err != nil {
+			return nil, err
+		}
+		port, err := a.GetSetting(schema, "port")
+		if err != nil {
+			return nil, err
+		}
+		if host == "" || port == "0" {
+			continue
+		}
+		protocol := "http"
+		if scheme == PROXY_SCHEME_SOCKS {
+			protocol = "socks5"
+		}
+		d, err := ParseDiscoveredProxy(a.GetId(), fmt.Sprintf("%v://%v:%v", protocol, host, port), exceptions)
+		if err != nil {
+			Log.Warningf("Ignoring Gnome %v proxy: %v", scheme, err)
+			continue
+		}
+		// Only the HTTP proxy has credentials; they are also used by the other schemes with the same host, so
+		// the proxy is not shown twice when the same proxy is used for all the schemes
+		if httpProxy != nil && d.Address == httpProxy.Address && d.Port == httpProxy.Port {
+			d.Username = httpProxy.Username
+			d.Password = httpProxy.Password
+		}
+		if scheme == PROXY_SCHEME_HTTP {
+			useAuthentication, err := a.GetSetting(schema, "use-authentication")
+			if err != nil {
+				return nil, err
+			}
+			if useAuthentication == "true" {
+				d.Username, err = a.GetSetting(schema, "authentication-user")
+				if err != nil {
+					return nil, err
+				}
+				d.Password, err = a.GetSetting(schema, "authentication-password")
+				if err != nil {
+					return nil, err
+				}
+			}
+			httpProxy = d
+		}
+		proxies = MergeDiscoveredProxy(proxies, d)
+	}
+	return proxies, nil
+
+}
+
+// Returns the value of the setting, without the quotes of the strings
+func (a *GnomeProxySetter) GetSetting(schema string, key string) (string, error) {
+	args := []string{"get", schema, key}
+	err, _, exitCode, outBuff, errBuff := goutils.RunCommandAndWait("", nil, "gsettings", args, map[string]string{})
+	if err != nil {
+		return "", errors.Errorf("gsettings %v (%v): %v", strings.Join(args, " "), exitCode, goutils.CombineStdErrOutput(outBuff, errBuff))
+	}
+	return strings.Trim(strings.TrimSpace(outBuff), "'"), nil
+}
+
+func (a *GnomeProxySetter) Detect() (bool, string, []string) {
+	installed, _ := DetectCommand("gsettings")
+	if !installed {
+		return false, "", []string{}
+	}
+	_, version := DetectCommand("gnome-shell", "--version")
+	return true, version, []string{}
+}
+
 func (a *GnomeProxySetter) GetId() string {
 	return "gnome"
 }
@@ -55,7 +55,9 @@ func (a *MsVsCodeProxySetter) Apply(p *Proxy) *AppProxyChangeResult {
 		delete(confData, "http.proxy")
 	}
 
-	err = goutils.SaveMapAsJsonFile(codeConfFilePath, confData)
+	err = SafeSaveFile(codeConfFilePath, 0644, false, func(tmpFilename string) error {
+		return goutils.SaveMapAsJsonFile(tmpFilename, confData)
+	})
 	if err != nil {
 		return &AppProxyChangeResult{a, "", "", MyGettextv("Error saving file %v: %v", codeConfFilePath, err)}
 	}
@@ -64,6 +66,11 @@ func (a *MsVsCodeProxySetter) Apply(p *Proxy) *AppProxyChangeResult {
 
 }
 
+func (a *MsVsCodeProxySetter) Detect() (bool, string, []string) {
+	installed, version := DetectCommand("code", "--version")
+	return installed, version, []string{path.Join(HOME_DIR, ".config", "Code", "User", "settings.json")}
+}
+
 func (a *MsVsCodeProxySetter) GetId() string {
 	return "msvscode"
 }
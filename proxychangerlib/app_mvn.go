@@ -1,6 +1,12 @@
 package proxychangerlib
 
 import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
 	"os"
 	"os/exec"
 	"path"
@@ -9,6 +15,7 @@ import (
 
 	"github.com/beevik/etree"
 	"github.com/okelet/goutils"
+	"github.com/pkg/errors"
 )
 
 // Register this application in the list of applications
@@ -40,6 +47,52 @@ func (a *MavenProxySetter) Apply(p *Proxy) *AppProxyChangeResult {
 		return &AppProxyChangeResult{a, MyGettextv("Command %v not found", "mvn"), "", ""}
 	}
 
+	return a.WriteSettings(p, password)
+
+}
+
+// Writes the password encrypted with the master password of settings-security.xml, if that file exists
+func (a *MavenProxySetter) ApplyCredentialSafe(p *Proxy) *AppProxyChangeResult {
+
+	_, err := exec.LookPath("mvn")
+	if err != nil {
+		return &AppProxyChangeResult{a, MyGettextv("Command %v not found", "mvn"), "", ""}
+	}
+
+	securityFilePath := path.Join(HOME_DIR, ".m2", "settings-security.xml")
+	securityFileExists, err := goutils.FileExists(securityFilePath)
+	if err != nil || !securityFileExists {
+		return nil
+	}
+
+	password, err := p.GetPassword()
+	if err != nil {
+		return &AppProxyChangeResult{a, "", "", MyGettextv("Error getting the proxy password: %v", err)}
+	}
+
+	// The password is encrypted here instead of running "mvn --encrypt-password", that would show it in the
+	// arguments of the process
+	var encryptedPassword string
+	if password != "" {
+		masterPassword, err := ReadMavenMasterPassword(securityFilePath)
+		if err != nil {
+			return &AppProxyChangeResult{a, "", "", MyGettextv("Error reading the Maven master password: %v", err)}
+		}
+		encryptedPassword, err = MavenEncryptPassword(password, masterPassword)
+		if err != nil {
+			return &AppProxyChangeResult{a, "", "", MyGettextv("Error encrypting the proxy password: %v", err)}
+		}
+	}
+
+	return a.WriteSettings(p, encryptedPassword)
+
+}
+
+// Writes the proxy settings, using password (plain or encrypted) as the proxy password
+func (a *MavenProxySetter) WriteSettings(p *Proxy, password string) *AppProxyChangeResult {
+
+	var err error
+
 	// Create dirs
 	mvnConfDirPath := path.Join(HOME_DIR, ".m2")
 	dirExists, err := goutils.DirExists(mvnConfDirPath)
@@ -97,13 +150,14 @@ func (a *MavenProxySetter) Apply(p *Proxy) *AppProxyChangeResult {
 	}
 	if p != nil {
 		proxies = settings.CreateElement("proxies")
-		for _, proxyType := range []string{"http", "https"} {
+		for _, proxyType := range []string{PROXY_SCHEME_HTTP, PROXY_SCHEME_HTTPS} {
+			endpoint := p.GetSchemeEndpoint(proxyType)
 			proxy := proxies.CreateElement("proxy")
 			proxy.CreateElement("id").SetText(proxyType)
 			proxy.CreateElement("protocol").SetText(proxyType)
 			proxy.CreateElement("active").SetText("true")
-			proxy.CreateElement("host").SetText(p.Address)
-			proxy.CreateElement("port").SetText(strconv.Itoa(p.Port))
+			proxy.CreateElement("host").SetText(endpoint.Address)
+			proxy.CreateElement("port").SetText(strconv.Itoa(endpoint.Port))
 			if p.Username != "" {
 				proxy.CreateElement("username").SetText(p.Username)
 				if password != "" {
@@ -118,7 +172,7 @@ func (a *MavenProxySetter) Apply(p *Proxy) *AppProxyChangeResult {
 
 	doc.Indent(2)
 
-	err = doc.WriteToFile(mvnConfFilePath)
+	err = SafeSaveFile(mvnConfFilePath, CREDENTIALS_FILE_MODE, true, doc.WriteToFile)
 	if err != nil {
 		return &AppProxyChangeResult{a, "", "", MyGettextv("Error writing the file %v: %v", mvnConfFilePath, err)}
 	}
@@ -127,6 +181,77 @@ func (a *MavenProxySetter) Apply(p *Proxy) *AppProxyChangeResult {
 
 }
 
+// Reads the active proxies of the Maven user settings; the passwords encrypted with the master password are not
+// read
+func (a *MavenProxySetter) ReadProxies() ([]*DiscoveredProxy, error) {
+
+	proxies := []*DiscoveredProxy{}
+	mvnConfFilePath := path.Join(HOME_DIR, ".m2", "settings.xml")
+	fileExists, err := goutils.FileExists(mvnConfFilePath)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Error checking if file %v exists", mvnConfFilePath)
+	}
+	if !fileExists {
+		return proxies, nil
+	}
+
+	doc := etree.NewDocument()
+	err = doc.ReadFromFile(mvnConfFilePath)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Error reading file %v", mvnConfFilePath)
+	}
+
+	for _, proxy := range doc.FindElements("./settings/proxies/proxy") {
+		if active := proxy.SelectElement("active"); active != nil && strings.TrimSpace(active.Text()) == "false" {
+			continue
+		}
+		d := DiscoveredProxy{
+			Sources:    []string{a.GetId()},
+			Protocol:   "http",
+			Exceptions: []string{},
+		}
+		if e := proxy.SelectElement("protocol"); e != nil && strings.TrimSpace(e.Text()) != "" {
+			d.Protocol = strings.ToLower(strings.TrimSpace(e.Text()))
+		}
+		if e := proxy.SelectElement("host"); e != nil {
+			d.Address = strings.TrimSpace(e.Text())
+		}
+		if e := proxy.SelectElement("port"); e != nil {
+			d.Port, _ = strconv.Atoi(strings.TrimSpace(e.Text()))
+		}
+		if d.Address == "" || d.Port <= 0 || d.Port >= 65535 {
+			Log.Warningf("Ignoring proxy of file %v without valid host and port", mvnConfFilePath)
+			continue
+		}
+		if e := proxy.SelectElement("username"); e != nil {
+			d.Username = strings.TrimSpace(e.Text())
+		}
+		if e := proxy.SelectElement("password"); e != nil {
+			password := strings.TrimSpace(e.Text())
+			if strings.HasPrefix(password, "{") && strings.HasSuffix(password, "}") {
+				Log.Warningf("Not importing the encrypted password of proxy %v of file %v", d.Key(), mvnConfFilePath)
+			} else {
+				d.Password = password
+			}
+		}
+		if e := proxy.SelectElement("nonProxyHosts"); e != nil {
+			for _, h := range strings.Split(e.Text(), "|") {
+				if h = strings.TrimSpace(h); h != "" {
+					d.Exceptions = goutils.AddStringToList(d.Exceptions, h)
+				}
+			}
+		}
+		proxies = MergeDiscoveredProxy(proxies, &d)
+	}
+	return proxies, nil
+
+}
+
+func (a *MavenProxySetter) Detect() (bool, string, []string) {
+	installed, version := DetectCommand("mvn", "--version")
+	return installed, version, []string{path.Join(HOME_DIR, ".m2", "settings.xml")}
+}
+
 func (a *MavenProxySetter) GetId() string {
 	return "mvn"
 }
@@ -142,3 +267,138 @@ func (a *MavenProxySetter) GetDescription() string {
 func (a *MavenProxySetter) GetHomepage() string {
 	return "https://github.com/okelet/proxychanger"
 }
+
+// Passphrase that encrypts the master password in settings-security.xml
+const MAVEN_MASTER_PASSWORD_PASSPHRASE = "settings.security"
+
+// Sizes used by the Maven (plexus-cipher) password encryption
+const MAVEN_CIPHER_SALT_SIZE = 8
+const MAVEN_CIPHER_CHUNK_SIZE = 16
+
+// Returns the decrypted master password of a Maven settings-security.xml file, following its relocation if it
+// has one
+func ReadMavenMasterPassword(securityFilePath string) (string, error) {
+
+	doc := etree.NewDocument()
+	err := doc.ReadFromFile(securityFilePath)
+	if err != nil {
+		return "", errors.Wrapf(err, "Error reading file %v", securityFilePath)
+	}
+
+	settings := doc.SelectElement("settingsSecurity")
+	if settings == nil {
+		return "", errors.Errorf("File %v has no settingsSecurity element", securityFilePath)
+	}
+
+	if relocation := settings.SelectElement("relocation"); relocation != nil && strings.TrimSpace(relocation.Text()) != "" {
+		relocationPath := ExpandHomeDir(strings.TrimSpace(relocation.Text()))
+		if relocationPath != securityFilePath {
+			return ReadMavenMasterPassword(relocationPath)
+		}
+	}
+
+	master := settings.SelectElement("master")
+	if master == nil || strings.TrimSpace(master.Text()) == "" {
+		return "", errors.Errorf("File %v has no master password", securityFilePath)
+	}
+
+	return MavenDecryptPassword(master.Text(), MAVEN_MASTER_PASSWORD_PASSPHRASE)
+
+}
+
+// Encrypts the password with the master password the way "mvn --encrypt-password" does, returning it between
+// braces
+func MavenEncryptPassword(password string, masterPassword string) (string, error) {
+
+	salt := make([]byte, MAVEN_CIPHER_SALT_SIZE)
+	_, err := rand.Read(salt)
+	if err != nil {
+		return "", errors.Wrap(err, "Error generating the salt")
+	}
+
+	block, iv, err := newMavenCipher(masterPassword, salt)
+	if err != nil {
+		return "", err
+	}
+
+	// PKCS5 padding
+	data := []byte(password)
+	padding := aes.BlockSize - len(data)%aes.BlockSize
+	data = append(data, bytes.Repeat([]byte{byte(padding)}, padding)...)
+	encrypted := make([]byte, len(data))
+	cipher.NewCBCEncrypter(block, iv).CryptBlocks(encrypted, data)
+
+	// The result is the salt, the length of the random padding, the encrypted data and the random padding
+	padLen := MAVEN_CIPHER_CHUNK_SIZE - (MAVEN_CIPHER_SALT_SIZE+len(encrypted)+1)%MAVEN_CIPHER_CHUNK_SIZE
+	randomPadding := make([]byte, padLen)
+	_, err = rand.Read(randomPadding)
+	if err != nil {
+		return "", errors.Wrap(err, "Error generating the padding")
+	}
+	result := append([]byte{}, salt...)
+	result = append(result, byte(padLen))
+	result = append(result, encrypted...)
+	result = append(result, randomPadding...)
+
+	return "{" + base64.StdEncoding.EncodeToString(result) + "}", nil
+
+}
+
+// Decrypts a password encrypted by Maven (the text between braces) with the master password
+func MavenDecryptPassword(encryptedPassword string, masterPassword string) (string, error) {
+
+	start := strings.Index(encryptedPassword, "{")
+	end := strings.LastIndex(encryptedPassword, "}")
+	if start < 0 || end < start {
+		return "", errors.New("The password is not encrypted")
+	}
+
+	data, err := base64.StdEncoding.DecodeString(encryptedPassword[start+1 : end])
+	if err != nil {
+		return "", errors.Wrap(err, "Error decoding the password")
+	}
+	if len(data) < MAVEN_CIPHER_SALT_SIZE+1 {
+		return "", errors.New("The encrypted password is too short")
+	}
+
+	salt := data[:MAVEN_CIPHER_SALT_SIZE]
+	padLen := int(data[MAVEN_CIPHER_SALT_SIZE])
+	if len(data)-MAVEN_CIPHER_SALT_SIZE-1 < padLen {
+		return "", errors.New("The encrypted password is too short")
+	}
+	encrypted := data[MAVEN_CIPHER_SALT_SIZE+1 : len(data)-padLen]
+	if len(encrypted) == 0 || len(encrypted)%aes.BlockSize != 0 {
+		return "", errors.New("The encrypted password has an invalid length")
+	}
+
+	block, iv, err := newMavenCipher(masterPassword, salt)
+	if err != nil {
+		return "", err
+	}
+	decrypted := make([]byte, len(encrypted))
+	cipher.NewCBCDecrypter(block, iv).CryptBlocks(decrypted, encrypted)
+
+	padding := int(decrypted[len(decrypted)-1])
+	if padding == 0 || padding > aes.BlockSize || padding > len(decrypted) {
+		return "", errors.New("The master password is not valid")
+	}
+	for _, b := range decrypted[len(decrypted)-padding:] {
+		if int(b) != padding {
+			return "", errors.New("The master password is not valid")
+		}
+	}
+
+	return string(decrypted[:len(decrypted)-padding]), nil
+
+}
+
+// Returns the AES cipher and the IV derived from the password and the salt, as plexus-cipher does: the SHA-256
+// of the password and the salt gives the 16 bytes of the key followed by the 16 bytes of the IV
+func newMavenCipher(password string, salt []byte) (cipher.Block, []byte, error) {
+	digest := sha256.Sum256(append([]byte(password), salt...))
+	block, err := aes.NewCipher(digest[:16])
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "Error creating the cipher")
+	}
+	return block, digest[16:], nil
+}
@@ -0,0 +1,45 @@
+package proxychangerlib
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+)
+
+func TestMavenEncryptPassword(t *testing.T) {
+	for _, password := range []string{"a", "secret", "0123456789abcdef", "contraseña with spaces and {braces}"} {
+		encrypted, err := MavenEncryptPassword(password, "master")
+		if err != nil {
+			t.Fatal(err)
+		}
+		decrypted, err := MavenDecryptPassword(encrypted, "master")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if decrypted != password {
+			t.Errorf("decrypted password %q, expected %q", decrypted, password)
+		}
+	}
+}
+
+func TestReadMavenMasterPassword(t *testing.T) {
+	dir := tempDir(t)
+	encrypted, err := MavenEncryptPassword("master", MAVEN_MASTER_PASSWORD_PASSPHRASE)
+	if err != nil {
+		t.Fatal(err)
+	}
+	relocated := filepath.Join(dir, "relocated.xml")
+	writeFile(t, relocated, fmt.Sprintf("<settingsSecurity><master>%v</master></settingsSecurity>", encrypted), 0600)
+	security := filepath.Join(dir, "settings-security.xml")
+	writeFile(t, security, fmt.Sprintf("<settingsSecurity><relocation>%v</relocation></settingsSecurity>", relocated), 0600)
+
+	for _, file := range []string{relocated, security} {
+		master, err := ReadMavenMasterPassword(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if master != "master" {
+			t.Errorf("master password of %v is %q", file, master)
+		}
+	}
+}
@@ -2,6 +2,7 @@ package proxychangerlib
 
 import (
 	"os/exec"
+	"path"
 	"strings"
 
 	"github.com/okelet/goutils"
@@ -58,6 +59,11 @@ func (a *NpmProxySetter) Apply(p *Proxy) *AppProxyChangeResult {
 	return &AppProxyChangeResult{a, "", "", ""}
 }
 
+func (a *NpmProxySetter) Detect() (bool, string, []string) {
+	installed, version := DetectCommand("npm", "--version")
+	return installed, version, []string{path.Join(HOME_DIR, ".npmrc")}
+}
+
 func (a *NpmProxySetter) GetId() string {
 	return "npm"
 }
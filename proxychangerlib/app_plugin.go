@@ -0,0 +1,269 @@
+package proxychangerlib
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Requests that a plugin must answer; the request is the only argument passed to the plugin
+const PLUGIN_REQUEST_DESCRIBE = "describe"
+const PLUGIN_REQUEST_DETECT = "detect"
+const PLUGIN_REQUEST_APPLY = "apply"
+const PLUGIN_REQUEST_STATUS = "status"
+
+// Statuses that a plugin can return when applying the proxy or reporting the status
+const PLUGIN_STATUS_OK = "ok"
+const PLUGIN_STATUS_SKIPPED = "skipped"
+const PLUGIN_STATUS_WARNING = "warning"
+const PLUGIN_STATUS_ERROR = "error"
+
+const PLUGIN_DESCRIBE_TIMEOUT = 5 * time.Second
+const PLUGIN_DETECT_TIMEOUT = 10 * time.Second
+const PLUGIN_APPLY_TIMEOUT = 60 * time.Second
+
+// Proxy details sent to the plugins in the standard input; the password is never passed as an argument
+type PluginProxy struct {
+	Name       string   `json:"name"`
+	Slug       string   `json:"slug"`
+	Url        string   `json:"url"`
+	SimpleUrl  string   `json:"simple_url"`
+	Protocol   string   `json:"protocol"`
+	Host       string   `json:"host"`
+	Port       int      `json:"port"`
+	Username   string   `json:"username"`
+	Password   string   `json:"password"`
+	Exceptions []string `json:"exceptions"`
+}
+
+type PluginRequest struct {
+	Request string `json:"request"`
+	// Nil when the proxy is being deactivated
+	Proxy *PluginProxy `json:"proxy"`
+}
+
+type PluginDescribeResponse struct {
+	Id          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Homepage    string `json:"homepage"`
+}
+
+type PluginDetectResponse struct {
+	Installed   bool     `json:"installed"`
+	Version     string   `json:"version"`
+	ConfigPaths []string `json:"config_paths"`
+}
+
+type PluginResultResponse struct {
+	Status  string `json:"status"`
+	Message string `json:"message"`
+}
+
+// Application implemented by an external executable in the directory PLUGINS_DIR
+type PluginProxySetter struct {
+	Executable  string
+	Id          string
+	Name        string
+	Description string
+	Homepage    string
+}
+
+func NewPluginProxySetter(executable string) (*PluginProxySetter, error) {
+	a := PluginProxySetter{Executable: executable}
+	var response PluginDescribeResponse
+	err := a.Call(PLUGIN_REQUEST_DESCRIBE, nil, PLUGIN_DESCRIBE_TIMEOUT, &response)
+	if err != nil {
+		return nil, err
+	}
+	if response.Id == "" {
+		return nil, errors.New(MyGettextv("Plugin %v returned an empty id", executable))
+	}
+	a.Id = response.Id
+	a.Name = response.Name
+	if a.Name == "" {
+		a.Name = a.Id
+	}
+	a.Description = response.Description
+	a.Homepage = response.Homepage
+	return &a, nil
+}
+
+// Loads the plugins in the directory dir; plugins that fail to describe themselves are logged and ignored
+func LoadPluginProxySettersFromDir(dir string) []*PluginProxySetter {
+	apps := []*PluginProxySetter{}
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			Log.Errorf("Error listing plugins in %v: %v", dir, err)
+		}
+		return apps
+	}
+	names := []string{}
+	for _, f := range files {
+		if f.IsDir() || f.Mode()&0111 == 0 {
+			continue
+		}
+		names = append(names, f.Name())
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		a, err := NewPluginProxySetter(path.Join(dir, name))
+		if err != nil {
+			Log.Errorf("Error loading plugin %v: %v", name, err)
+			continue
+		}
+		apps = append(apps, a)
+	}
+	return apps
+}
+
+// Runs the plugin with the request, sending the proxy details in the standard input and
+// decoding the standard output in response
+func (a *PluginProxySetter) Call(request string, p *Proxy, timeout time.Duration, response interface{}) error {
+
+	req := PluginRequest{Request: request}
+	if p != nil {
+		url, err := p.ToUrl(true)
+		if err != nil {
+			return errors.Wrap(err, MyGettextv("Error generating proxy URL"))
+		}
+		password, err := p.GetPassword()
+		if err != nil {
+			return errors.Wrap(err, MyGettextv("Error getting the proxy password"))
+		}
+		req.Proxy = &PluginProxy{
+			Name:       p.Name,
+			Slug:       p.Slug,
+			Url:        url,
+			SimpleUrl:  p.ToSimpleUrl(),
+			Protocol:   p.Protocol,
+			Host:       p.Address,
+			Port:       p.Port,
+			Username:   p.Username,
+			Password:   password,
+			Exceptions: p.Exceptions,
+		}
+	}
+	input, err := json.Marshal(req)
+	if err != nil {
+		return errors.Wrap(err, "Error marshaling plugin request")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	stdout := bytes.NewBufferString("")
+	stderr := bytes.NewBufferString("")
+	cmd := exec.CommandContext(ctx, a.Executable, request)
+	cmd.Stdin = bytes.NewReader(input)
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+	err = cmd.Run()
+	if ctx.Err() == context.DeadlineExceeded {
+		return errors.New(MyGettextv("Plugin %v timed out after %v", path.Base(a.Executable), timeout))
+	}
+	if err != nil {
+		return errors.New(MyGettextv("Plugin %v failed (%v): %v", path.Base(a.Executable), err, strings.TrimSpace(stderr.String())))
+	}
+
+	err = json.Unmarshal(stdout.Bytes(), response)
+	if err != nil {
+		return errors.New(MyGettextv("Plugin %v returned an invalid response: %v", path.Base(a.Executable), err))
+	}
+	return nil
+
+}
+
+func (a *PluginProxySetter) Apply(p *Proxy) *AppProxyChangeResult {
+
+	var detectResponse PluginDetectResponse
+	err := a.Call(PLUGIN_REQUEST_DETECT, nil, PLUGIN_DETECT_TIMEOUT, &detectResponse)
+	if err != nil {
+		return &AppProxyChangeResult{a, "", "", err.Error()}
+	}
+	if !detectResponse.Installed {
+		return &AppProxyChangeResult{a, MyGettextv("Application not installed"), "", ""}
+	}
+
+	var response PluginResultResponse
+	err = a.Call(PLUGIN_REQUEST_APPLY, p, PLUGIN_APPLY_TIMEOUT, &response)
+	if err != nil {
+		return &AppProxyChangeResult{a, "", "", err.Error()}
+	}
+	return a.resultFromResponse(&response)
+
+}
+
+func (a *PluginProxySetter) Detect() (bool, string, []string) {
+	var response PluginDetectResponse
+	err := a.Call(PLUGIN_REQUEST_DETECT, nil, PLUGIN_DETECT_TIMEOUT, &response)
+	if err != nil {
+		Log.Errorf("Error detecting application %v: %v", a.Id, err)
+		return false, "", []string{}
+	}
+	if response.ConfigPaths == nil {
+		response.ConfigPaths = []string{}
+	}
+	return response.Installed, response.Version, response.ConfigPaths
+}
+
+// Asks the plugin if its current settings match the proxy p (or no proxy, if nil)
+func (a *PluginProxySetter) Status(p *Proxy) *AppProxyChangeResult {
+	var response PluginResultResponse
+	err := a.Call(PLUGIN_REQUEST_STATUS, p, PLUGIN_DETECT_TIMEOUT, &response)
+	if err != nil {
+		return &AppProxyChangeResult{a, "", "", err.Error()}
+	}
+	return a.resultFromResponse(&response)
+}
+
+func (a *PluginProxySetter) resultFromResponse(response *PluginResultResponse) *AppProxyChangeResult {
+	message := response.Message
+	if message == "" {
+		message = MyGettextv("Plugin %v returned status %v", path.Base(a.Executable), response.Status)
+	}
+	switch response.Status {
+	case PLUGIN_STATUS_OK:
+		return &AppProxyChangeResult{a, "", "", ""}
+	case PLUGIN_STATUS_SKIPPED:
+		return &AppProxyChangeResult{a, message, "", ""}
+	case PLUGIN_STATUS_WARNING:
+		return &AppProxyChangeResult{a, "", message, ""}
+	case PLUGIN_STATUS_ERROR:
+		return &AppProxyChangeResult{a, "", "", message}
+	default:
+		return &AppProxyChangeResult{a, "", "", MyGettextv("Plugin %v returned an invalid status %v", path.Base(a.Executable), response.Status)}
+	}
+}
+
+func (a *PluginProxySetter) GetId() string {
+	return a.Id
+}
+
+func (a *PluginProxySetter) GetSimpleName() string {
+	return a.Name
+}
+
+func (a *PluginProxySetter) GetDescription() string {
+	if a.Description != "" {
+		return a.Description
+	}
+	return MyGettextv("Plugin %v", a.Executable)
+}
+
+func (a *PluginProxySetter) GetHomepage() string {
+	if a.Homepage != "" {
+		return a.Homepage
+	}
+	return "https://github.com/okelet/proxychanger"
+}
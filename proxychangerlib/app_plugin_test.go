@@ -0,0 +1,45 @@
+package proxychangerlib
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+// Plugin that reports that its settings only match when there is no proxy
+const TEST_PLUGIN = `#!/bin/sh
+input=$(cat)
+case "$1" in
+describe) echo '{"id": "test-plugin", "name": "Test plugin"}' ;;
+detect) echo '{"installed": true}' ;;
+apply) echo '{"status": "ok"}' ;;
+status)
+	case "$input" in
+	*'"proxy":null'*) echo '{"status": "ok"}' ;;
+	*) echo '{"status": "warning", "message": "proxy not set"}' ;;
+	esac ;;
+esac
+`
+
+func TestPluginStatus(t *testing.T) {
+	dir := tempDir(t)
+	executable := filepath.Join(dir, "test-plugin")
+	writeFile(t, executable, TEST_PLUGIN, 0755)
+
+	a, err := NewPluginProxySetter(executable)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a.GetId() != "test-plugin" {
+		t.Errorf("plugin id is %v", a.GetId())
+	}
+
+	if r := a.Status(nil); r.SkippedMessage != "" || r.WarningMessage != "" || r.ErrorMessage != "" {
+		t.Errorf("unexpected status without proxy: %+v", r)
+	}
+
+	c := newTestConfiguration(t)
+	p := newTestProxy(c, "Test")
+	if r := a.Status(p); r.WarningMessage != "proxy not set" {
+		t.Errorf("unexpected status with proxy: %+v", r)
+	}
+}
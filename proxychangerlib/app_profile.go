@@ -0,0 +1,73 @@
+package proxychangerlib
+
+import (
+	"path"
+
+	"github.com/okelet/goutils"
+)
+
+// Register this application in the list of applications
+func init() {
+	RegisterProxifiedApplication(NewProfileProxySetter())
+}
+
+type ProfileProxySetter struct {
+}
+
+func NewProfileProxySetter() *ProfileProxySetter {
+	return &ProfileProxySetter{}
+}
+
+func (a *ProfileProxySetter) GetFile() string {
+	return path.Join(HOME_DIR, ".profile")
+}
+
+func (a *ProfileProxySetter) Apply(p *Proxy) *AppProxyChangeResult {
+	// The proxy is always cleared, in case it was set before, even if the login shell doesn't read the file
+	if p != nil {
+		skippedMessage := a.GetSkippedMessage(GetLoginShell())
+		if skippedMessage != "" {
+			result := ApplyProxyToRcFile(a, nil, a.GetFile(), SHELL_SYNTAX_POSIX, false)
+			if result.ErrorMessage != "" {
+				return result
+			}
+			return &AppProxyChangeResult{a, skippedMessage, "", ""}
+		}
+	}
+	return ApplyProxyToRcFile(a, p, a.GetFile(), SHELL_SYNTAX_POSIX, false)
+}
+
+// Bash doesn't read ~/.profile if ~/.bash_profile or ~/.bash_login exist, and zsh and fish never read it
+func (a *ProfileProxySetter) GetSkippedMessage(loginShell string) string {
+	if loginShell == "bash" {
+		for _, f := range []string{".bash_profile", ".bash_login"} {
+			exists, err := goutils.FileExists(path.Join(HOME_DIR, f))
+			if err == nil && exists {
+				return MyGettextv("The login shell %v reads %v instead of %v", loginShell, path.Join(HOME_DIR, f), a.GetFile())
+			}
+		}
+	} else if loginShell == "zsh" || loginShell == "fish" {
+		return MyGettextv("The login shell %v doesn't read %v", loginShell, a.GetFile())
+	}
+	return ""
+}
+
+func (a *ProfileProxySetter) Detect() (bool, string, []string) {
+	return true, "", []string{a.GetFile()}
+}
+
+func (a *ProfileProxySetter) GetId() string {
+	return "profile"
+}
+
+func (a *ProfileProxySetter) GetSimpleName() string {
+	return MyGettextv("Profile")
+}
+
+func (a *ProfileProxySetter) GetDescription() string {
+	return MyGettextv("Sets the proxy in the file %v, read by the login shells", a.GetFile())
+}
+
+func (a *ProfileProxySetter) GetHomepage() string {
+	return "https://github.com/okelet/proxychanger"
+}
@@ -0,0 +1,97 @@
+package proxychangerlib
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"strings"
+)
+
+const PROFILE_D_PROXY_FILE = "/etc/profile.d/proxychanger.sh"
+
+// Register this application in the list of applications
+func init() {
+	RegisterProxifiedApplication(NewProfileDProxySetter())
+}
+
+// Sets the proxy variables for the login shells of all the users; the file is changed using the privileged
+// helper
+type ProfileDProxySetter struct {
+}
+
+func NewProfileDProxySetter() *ProfileDProxySetter {
+	return &ProfileDProxySetter{}
+}
+
+func (a *ProfileDProxySetter) Apply(p *Proxy) *AppProxyChangeResult {
+
+	if !IsPrivilegedHelperAvailable() {
+		return &AppProxyChangeResult{a, MyGettextv("The privileged helper %v is not installed", PRIVILEGED_HELPER_PATH), "", ""}
+	}
+
+	// As in /etc/environment, a proxy with password is removed instead of applied, as any user can read the file
+	var warning string
+	if p != nil {
+		hasPassword, err := p.HasPassword()
+		if err != nil {
+			return &AppProxyChangeResult{a, "", "", MyGettextv("Error getting the proxy password: %v", err)}
+		}
+		if hasPassword {
+			warning = MyGettextv("The proxy has a password, that would be readable by all the users in the file %v; the proxy has been removed from it", PROFILE_D_PROXY_FILE)
+			p = nil
+		}
+	}
+
+	if p == nil {
+		_, err := os.Stat(PROFILE_D_PROXY_FILE)
+		if os.IsNotExist(err) {
+			return &AppProxyChangeResult{a, "", warning, ""}
+		}
+		err = RemoveSystemFile(PROFILE_D_PROXY_FILE)
+		if err != nil {
+			return &AppProxyChangeResult{a, "", "", MyGettextv("Error deleting file %v: %v", PROFILE_D_PROXY_FILE, err)}
+		}
+		return &AppProxyChangeResult{a, "", warning, ""}
+	}
+
+	variables, err := ProxyEnvironmentVariables(p)
+	if err != nil {
+		return &AppProxyChangeResult{a, "", "", MyGettextv("Error generating proxy URL: %v", err)}
+	}
+	buff := bytes.NewBufferString("# Generated by proxychanger; changes will be overwritten\n")
+	buff.WriteString(strings.Join(ShellExportLines(SHELL_SYNTAX_POSIX, variables), "\n") + "\n")
+
+	// Avoid asking for the authorization if nothing has changed
+	oldData, err := ioutil.ReadFile(PROFILE_D_PROXY_FILE)
+	if err == nil && bytes.Equal(oldData, buff.Bytes()) {
+		return &AppProxyChangeResult{a, "", warning, ""}
+	}
+
+	err = WriteSystemFile(PROFILE_D_PROXY_FILE, buff.Bytes(), 0644)
+	if err != nil {
+		return &AppProxyChangeResult{a, "", "", MyGettextv("Error writing the file %v: %v", PROFILE_D_PROXY_FILE, err)}
+	}
+
+	return &AppProxyChangeResult{a, "", warning, ""}
+
+}
+
+func (a *ProfileDProxySetter) Detect() (bool, string, []string) {
+	return true, "", []string{PROFILE_D_PROXY_FILE}
+}
+
+func (a *ProfileDProxySetter) GetId() string {
+	return "profile-d"
+}
+
+func (a *ProfileDProxySetter) GetSimpleName() string {
+	return MyGettextv("System profile")
+}
+
+func (a *ProfileDProxySetter) GetDescription() string {
+	return MyGettextv("Sets the proxy for the login shells of all the users in the file %v", PROFILE_D_PROXY_FILE)
+}
+
+func (a *ProfileDProxySetter) GetHomepage() string {
+	return "https://github.com/okelet/proxychanger"
+}
@@ -0,0 +1,38 @@
+package proxychangerlib
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestProfileSkippedRemovesBlock(t *testing.T) {
+	loginShell := GetLoginShell()
+	if loginShell != "bash" && loginShell != "zsh" && loginShell != "fish" {
+		t.Skipf("login shell %v always reads ~/.profile", loginShell)
+	}
+
+	previousHomeDir := HOME_DIR
+	HOME_DIR = tempDir(t)
+	defer func() {
+		HOME_DIR = previousHomeDir
+	}()
+	// Bash reads ~/.bash_profile instead of ~/.profile
+	writeFile(t, filepath.Join(HOME_DIR, ".bash_profile"), "", 0644)
+
+	a := NewProfileProxySetter()
+	writeFile(t, a.GetFile(), "umask 022\n", 0644)
+	err := UpdateRcFileBlock(a.GetFile(), []string{"export http_proxy='http://proxy:3128'"}, false, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c := newTestConfiguration(t)
+	result := a.Apply(newTestProxy(c, "Proxy"))
+	if result.SkippedMessage == "" || result.ErrorMessage != "" {
+		t.Errorf("result not skipped: %+v", result)
+	}
+	if data := readFile(t, a.GetFile()); strings.Contains(data, "http_proxy") {
+		t.Errorf("proxy block not removed: %q", data)
+	}
+}
@@ -2,7 +2,6 @@ package proxychangerlib
 
 import (
 	"fmt"
-	"io/ioutil"
 	"os"
 	"os/exec"
 	"path/filepath"
@@ -66,7 +65,7 @@ func (a *SshConfigProxySetter) Apply(p *Proxy) *AppProxyChangeResult {
 	}
 
 	if username != "" && password != "" {
-		err = ioutil.WriteFile(sshProxyAuthFile, []byte(fmt.Sprintf("%v:%v\n", username, password)), 0600)
+		err = SafeWriteCredentialsFile(sshProxyAuthFile, []byte(fmt.Sprintf("%v:%v\n", username, password)))
 		if err != nil {
 			return &AppProxyChangeResult{a, "", "", MyGettextv("Error writing the file %v: %v", sshProxyAuthFile, err)}
 		}
@@ -132,7 +131,7 @@ func (a *SshConfigProxySetter) Apply(p *Proxy) *AppProxyChangeResult {
 	}
 	sshConfig.SetLeadingSpace(4)
 
-	err = ioutil.WriteFile(sshConfigFile, []byte(sshConfig.String()), 0666)
+	err = SafeWriteFile(sshConfigFile, []byte(sshConfig.String()), 0644)
 	if err != nil {
 		return &AppProxyChangeResult{a, "", "", MyGettextv("Error writing the file %v: %v", sshConfigFile, err)}
 	}
@@ -145,6 +144,12 @@ func (a *SshConfigProxySetter) Apply(p *Proxy) *AppProxyChangeResult {
 
 }
 
+func (a *SshConfigProxySetter) Detect() (bool, string, []string) {
+	installed, version := DetectCommand("ssh", "-V")
+	sshConfigDir := filepath.Join(HOME_DIR, ".ssh")
+	return installed, version, []string{filepath.Join(sshConfigDir, "config"), filepath.Join(sshConfigDir, "proxyauth")}
+}
+
 func (a *SshConfigProxySetter) GetId() string {
 	return "ssh-config"
 }
@@ -74,7 +74,14 @@ func (a *S3tpcProxySetter) Apply(p *Proxy) *AppProxyChangeResult {
 		delete(confData, "proxy_password")
 	}
 
-	err = goutils.SaveMapAsJsonFile(sublConfFilePath, confData)
+	// The password is written in plain text
+	perm, credentials := os.FileMode(0644), password != ""
+	if credentials {
+		perm = CREDENTIALS_FILE_MODE
+	}
+	err = SafeSaveFile(sublConfFilePath, perm, credentials, func(tmpFilename string) error {
+		return goutils.SaveMapAsJsonFile(tmpFilename, confData)
+	})
 	if err != nil {
 		return &AppProxyChangeResult{a, "", "", MyGettextv("Error saving file %v: %v", sublConfFilePath, err)}
 	}
@@ -83,6 +90,11 @@ func (a *S3tpcProxySetter) Apply(p *Proxy) *AppProxyChangeResult {
 
 }
 
+func (a *S3tpcProxySetter) Detect() (bool, string, []string) {
+	installed, version := DetectCommand("subl", "--version")
+	return installed, version, []string{path.Join(HOME_DIR, ".config", "sublime-text-3", "Packages", "User", "settings.json")}
+}
+
 func (a *S3tpcProxySetter) GetId() string {
 	return "st3pc"
 }
@@ -103,7 +103,7 @@ func (a *SvnProxySetter) Apply(p *Proxy) *AppProxyChangeResult {
 		section.DeleteKey("http-proxy-password")
 	}
 
-	err = cfg.SaveTo(svnConfigFile)
+	err = SafeSaveFile(svnConfigFile, CREDENTIALS_FILE_MODE, true, cfg.SaveTo)
 	if err != nil {
 		return &AppProxyChangeResult{a, "", "", MyGettextv("Error writing the file %v: %v", svnConfigFile, err)}
 	}
@@ -112,6 +112,11 @@ func (a *SvnProxySetter) Apply(p *Proxy) *AppProxyChangeResult {
 
 }
 
+func (a *SvnProxySetter) Detect() (bool, string, []string) {
+	installed, version := DetectCommand("svn", "--version", "--quiet")
+	return installed, version, []string{path.Join(HOME_DIR, ".subversion", "servers")}
+}
+
 func (a *SvnProxySetter) GetId() string {
 	return "svn"
 }
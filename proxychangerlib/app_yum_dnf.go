@@ -1,13 +1,23 @@
 package proxychangerlib
 
 import (
-	"github.com/go-ini/ini"
+	"bytes"
+	"io/ioutil"
+	"regexp"
+	"strings"
+
 	"github.com/okelet/goutils"
+	"github.com/pkg/errors"
 )
 
 const YUM_CONF_PATH = "/etc/yum.conf"
 const DNF_CONF_PATH = "/etc/dnf/dnf.conf"
 
+// Keys of the proxy in the section main
+var YUM_DNF_PROXY_KEYS = []string{"proxy", "proxy_username", "proxy_password"}
+
+var YUM_DNF_PROXY_LINE_REGEXP = regexp.MustCompile(`^\s*(proxy|proxy_username|proxy_password)\s*=`)
+
 // Register this application in the list of applications
 func init() {
 	RegisterProxifiedApplication(NewYumDnfProxySetter())
@@ -49,30 +59,35 @@ func (a *YumDnfProxySetter) Apply(p *Proxy) *AppProxyChangeResult {
 		}
 	}
 
-	cfg, err := ini.LoadSources(ini.LoadOptions{Loose: true}, confFile)
+	oldData, err := ioutil.ReadFile(confFile)
 	if err != nil {
 		return &AppProxyChangeResult{a, "", "", MyGettextv("Error reading file %v: %v", confFile, err)}
 	}
 
-	section := cfg.Section("main")
+	// Yum/Dnf only have a proxy for all the repositories, that nowadays are mostly served using HTTPS
+	values := map[string]string{}
 	if p != nil {
-		section.Key("proxy").SetValue(p.ToSimpleUrl())
+		values["proxy"] = p.GetSchemeSimpleUrl(PROXY_SCHEME_HTTPS)
 		if p.Username != "" {
-			section.Key("proxy_username").SetValue(p.Username)
+			values["proxy_username"] = p.Username
 			if password != "" {
-				section.Key("proxy_password").SetValue(password)
+				values["proxy_password"] = password
 			}
+		}
+	}
+	newData, err := RenderYumDnfConf(confFile, oldData, values)
+	// Avoid asking for the authorization if nothing has changed
+	if err == nil && bytes.Equal(oldData, newData) {
+		return &AppProxyChangeResult{a, "", "", ""}
+	}
+	if err == nil {
+		// The helper keeps the mode of the file, that is readable by all the users by default
+		if _, ok := values["proxy_password"]; ok {
+			err = WriteSystemCredentialsFile(confFile, newData)
 		} else {
-			section.DeleteKey("proxy_username")
-			section.DeleteKey("proxy_password")
+			err = WriteSystemFile(confFile, newData, 0644)
 		}
-	} else {
-		section.DeleteKey("proxy")
-		section.DeleteKey("proxy_username")
-		section.DeleteKey("proxy_password")
 	}
-
-	err = cfg.SaveTo(confFile)
 	if err != nil {
 		return &AppProxyChangeResult{a, "", "", MyGettextv("Error writing the file %v: %v; <a href=\"%v\">click here</a> for possible solutions", confFile, err, "https://github.com/okelet/proxychanger/wiki/Yum")}
 	}
@@ -81,6 +96,68 @@ func (a *YumDnfProxySetter) Apply(p *Proxy) *AppProxyChangeResult {
 
 }
 
+// Returns the content of the file (data) with the proxy keys of the section main replaced by the values (the keys
+// not in values are removed); the rest of lines are kept as they are, as the privileged helper only allows
+// changing the proxy keys
+func RenderYumDnfConf(filename string, data []byte, values map[string]string) ([]byte, error) {
+
+	lines := []string{}
+	if len(data) > 0 {
+		lines = strings.Split(strings.TrimSuffix(string(data), "\n"), "\n")
+	}
+
+	begin, end := -1, len(lines)
+	for i, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		if begin == -1 && trimmed == "[main]" {
+			begin = i
+		} else if begin != -1 && strings.HasPrefix(trimmed, "[") {
+			end = i
+			break
+		}
+	}
+	if begin == -1 {
+		return nil, errors.New(MyGettextv("The file %v doesn't have the section %v", filename, "[main]"))
+	}
+
+	section := []string{}
+	for _, line := range lines[begin+1 : end] {
+		if !YUM_DNF_PROXY_LINE_REGEXP.MatchString(line) {
+			section = append(section, line)
+		}
+	}
+	// The keys are added after the last non empty line of the section
+	last := len(section)
+	for last > 0 && strings.TrimSpace(section[last-1]) == "" {
+		last--
+	}
+	keyLines := []string{}
+	for _, key := range YUM_DNF_PROXY_KEYS {
+		if value, ok := values[key]; ok {
+			keyLines = append(keyLines, key+"="+value)
+		}
+	}
+	section = append(append(append([]string{}, section[:last]...), keyLines...), section[last:]...)
+
+	newLines := append(append(append([]string{}, lines[:begin+1]...), section...), lines[end:]...)
+	return []byte(strings.Join(newLines, "\n") + "\n"), nil
+
+}
+
+func (a *YumDnfProxySetter) Detect() (bool, string, []string) {
+	for _, v := range []struct {
+		Command  string
+		ConfPath string
+	}{{"yum", YUM_CONF_PATH}, {"dnf", DNF_CONF_PATH}} {
+		exists, err := goutils.FileExists(v.ConfPath)
+		if err == nil && exists {
+			_, version := DetectCommand(v.Command, "--version")
+			return true, version, []string{v.ConfPath}
+		}
+	}
+	return false, "", []string{}
+}
+
 func (a *YumDnfProxySetter) GetId() string {
 	return "yum-dnf"
 }
@@ -0,0 +1,36 @@
+package proxychangerlib
+
+import (
+	"testing"
+)
+
+func TestRenderYumDnfConf(t *testing.T) {
+	original := "# Yum settings\n[main]\ngpgcheck = 1\nproxy=http://old:3128\n\n[repo]\nbaseurl=http://repo\n"
+
+	data, err := RenderYumDnfConf("yum.conf", []byte(original), map[string]string{
+		"proxy":          "http://proxy:3128",
+		"proxy_username": "user",
+		"proxy_password": "p#ss word",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := "# Yum settings\n[main]\ngpgcheck = 1\nproxy=http://proxy:3128\nproxy_username=user\nproxy_password=p#ss word\n\n[repo]\nbaseurl=http://repo\n"
+	if string(data) != expected {
+		t.Errorf("proxy set as %q", data)
+	}
+
+	data, err = RenderYumDnfConf("yum.conf", data, map[string]string{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected = "# Yum settings\n[main]\ngpgcheck = 1\n\n[repo]\nbaseurl=http://repo\n"
+	if string(data) != expected {
+		t.Errorf("proxy removed as %q", data)
+	}
+
+	_, err = RenderYumDnfConf("yum.conf", []byte("[repo]\nbaseurl=http://repo\n"), map[string]string{})
+	if err == nil {
+		t.Error("expected an error without the section main")
+	}
+}
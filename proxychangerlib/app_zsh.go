@@ -0,0 +1,65 @@
+package proxychangerlib
+
+import (
+	"os"
+	"os/exec"
+	"path"
+
+	"github.com/okelet/goutils"
+)
+
+// Register this application in the list of applications
+func init() {
+	RegisterProxifiedApplication(NewZshProxySetter())
+}
+
+type ZshProxySetter struct {
+}
+
+func NewZshProxySetter() *ZshProxySetter {
+	return &ZshProxySetter{}
+}
+
+// Returns ~/.zshenv if it exists (it is read by all the shells, even the non interactive ones), or ~/.zshrc;
+// ZDOTDIR is respected
+func (a *ZshProxySetter) GetFile() string {
+	dir := os.Getenv("ZDOTDIR")
+	if dir == "" {
+		dir = HOME_DIR
+	}
+	zshenv := path.Join(dir, ".zshenv")
+	exists, err := goutils.FileExists(zshenv)
+	if err == nil && exists {
+		return zshenv
+	}
+	return path.Join(dir, ".zshrc")
+}
+
+func (a *ZshProxySetter) Apply(p *Proxy) *AppProxyChangeResult {
+	_, err := exec.LookPath("zsh")
+	if err != nil {
+		return &AppProxyChangeResult{a, MyGettextv("Command %v not found", "zsh"), "", ""}
+	}
+	return ApplyProxyToRcFile(a, p, a.GetFile(), SHELL_SYNTAX_POSIX, false)
+}
+
+func (a *ZshProxySetter) Detect() (bool, string, []string) {
+	installed, version := DetectCommand("zsh", "--version")
+	return installed, version, []string{a.GetFile()}
+}
+
+func (a *ZshProxySetter) GetId() string {
+	return "zsh"
+}
+
+func (a *ZshProxySetter) GetSimpleName() string {
+	return MyGettextv("zsh")
+}
+
+func (a *ZshProxySetter) GetDescription() string {
+	return MyGettextv("Sets the proxy in the file %v", a.GetFile())
+}
+
+func (a *ZshProxySetter) GetHomepage() string {
+	return "https://github.com/okelet/proxychanger"
+}
@@ -1,306 +0,0 @@
-// Code generated by go-bindata.
-// sources:
-// proxychangerlib/assets/config.glade
-// proxychangerlib/assets/config.glade~
-// proxychangerlib/assets/proxy.glade
-// proxychangerlib/assets/proxy.glade~
-// DO NOT EDIT!
-
-package proxychangerlib
-
-import (
-	"bytes"
-	"compress/gzip"
-	"fmt"
-	"io"
-	"io/ioutil"
-	"os"
-	"path/filepath"
-	"strings"
-	"time"
-)
-
-func bindataRead(data []byte, name string) ([]byte, error) {
-	gz, err := gzip.NewReader(bytes.NewBuffer(data))
-	if err != nil {
-		return nil, fmt.Errorf("Read %q: %v", name, err)
-	}
-
-	var buf bytes.Buffer
-	_, err = io.Copy(&buf, gz)
-	clErr := gz.Close()
-
-	if err != nil {
-		return nil, fmt.Errorf("Read %q: %v", name, err)
-	}
-	if clErr != nil {
-		return nil, err
-	}
-
-	return buf.Bytes(), nil
-}
-
-type asset struct {
-	bytes []byte
-	info  os.FileInfo
-}
-
-type bindataFileInfo struct {
-	name    string
-	size    int64
-	mode    os.FileMode
-	modTime time.Time
-}
-
-func (fi bindataFileInfo) Name() string {
-	return fi.name
-}
-func (fi bindataFileInfo) Size() int64 {
-	return fi.size
-}
-func (fi bindataFileInfo) Mode() os.FileMode {
-	return fi.mode
-}
-func (fi bindataFileInfo) ModTime() time.Time {
-	return fi.modTime
-}
-func (fi bindataFileInfo) IsDir() bool {
-	return false
-}
-func (fi bindataFileInfo) Sys() interface{} {
-	return nil
-}
-
-var _assetsConfigGlade = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xec\x5d\x5d\x73\xdb\xba\xd1\xbe\x3f\xbf\x82\xaf\x2e\xce\xbc\x9d\x8e\xac\xe3\xe4\x9c\x34\xed\xb1\x9d\x49\x1c\x27\xe3\x69\x26\xc9\xd8\x4e\x73\xc9\x81\x48\x48\x42\x45\x11\x2c\x08\xf9\xa3\xbf\xbe\x4b\x80\xfa\x24\x00\x02\xa0\x6c\x53\x16\xaf\x6c\x8a\xd8\x07\xc0\x62\x77\xb1\xbb\x5c\x82\x27\xef\xee\x67\x49\x70\x8b\x59\x4e\x68\x7a\xda\x3b\x3e\xfa\xad\x17\xe0\x34\xa2\x31\x49\xc7\xa7\xbd\x1f\x37\x9f\xfa\x6f\x7b\xef\xce\x7e\x39\xf9\xbf\x7e\x3f\xf8\x8c\x53\xcc\x10\xc7\x71\x70\x47\xf8\x24\x18\x27\x28\xc6\xc1\xeb\xa3\xe3\xb7\x47\xaf\x83\x7e\x1f\x1a\x91\x94\x63\x36\x42\x11\x0e\x62\x3a\x43\x04\xf0\x32\x46\xef\x1f\xa2\x09\x4a\xc7\x98\xf5\xce\x7e\x09\x82\x13\x86\xff\x33\x27\x0c\xe7\x41\x42\x86\xa7\xbd\x31\x9f\xfe\xb5\xb7\xea\x1e\xc0\x5e\xf5\x06\xa2\x1d\x1d\xfe\x1b\x47\x3c\x88\x12\x94\xe7\xa7\xbd\xcf\x7c\xfa\x85\xe4\xfc\x9a\x53\x86\x7b\x01\x89\x4f\x7b\x09\x5c\xe6\xc5\x65\x88\xb2\x2c\x17\xd8\x40\x15\xd1\x64\x3e\x4b\x73\x79\x05\xd7\xc5\xb0\xe5\x6f\xfd\x14\xcd\x70\x30\x9f\x93\x58\x8c\xb5\xbc\x2f\xef\x05\xfc\x21\xc3\x30\x18\x18\x28\x43\x8c\xa1\x07\x39\x06\x25\x42\x4c\xf2\x2c\x41\x0f\xcd\x40\x70\x8a\x86\x09\x36\x8c\x64\x48\x69\x82\x51\x6a\x1a\x07\xce\x23\x46\x32\x0e\x7c\x73\x18\xcb\xc9\x60\x8d\x43\x27\x03\xc9\x64\x47\x7e\x93\x2c\x4c\x69\x38\x43\x3c\x9a\xd8\xb2\x7d\xd1\x3e\x6c\xca\xfd\x25\x90\xfb\x32\x6c\x4c\x1d\x2e\x63\xc4\xd1\x92\x96\xd1\xbb\xc5\xff\x12\x49\xcc\xf9\xb7\xde\xd9\x14\xe3\x4c\x50\x2a\x6e\x1f\xf7\x02\xce\x50\x9a\x27\x80\x04\xcb\x79\xda\x7b\xc0\x20\x88\xff\x04\x8a\x20\x9a\x33\x86\x53\x1e\x08\xf9\xdf\xa0\x3f\x19\xac\xf5\xa5\xef\x37\xc6\x28\xe2\xe4\x16\xb4\xcd\xad\xf7\x8f\x4b\x3a\x73\xdf\x27\x83\xc5\xfc\x3d\xa5\x20\xa1\xe3\x30\xc1\xb7\x38\xb1\x95\x01\xd1\xb8\xb1\x00\x48\x94\xa7\x5a\xfd\x1f\x5f\xaf\xbf\x5f\x9c\x5f\x7e\xba\xbc\xf8\xe8\xb6\x0c\xef\x93\xc4\x67\xd5\x6f\xae\xde\x9f\x5f\xb8\xf5\x74\xc3\xc0\xde\xfa\xf4\xf5\xf1\xe2\xc3\x8f\xcf\xae\xc2\x35\x9c\x8f\x7d\xfa\xba\xfc\xfa\xe9\x9b\x5b\x57\x97\xe9\x88\xfa\xf4\xf4\xf3\xfd\xd5\xd7\xcb\xaf\x8e\xf3\xfa\x89\x58\x0a\xfb\x9d\x4f\x7f\x17\x57\x57\xdf\xae\xdc\x7a\xbb\x60\x8c\x32\x9f\xbe\xce\xaf\x2e\x6f\x2e\xcf\xdf\x7f\x71\xeb\xee\x9c\x11\x4e\x22\xa4\x95\xc7\xc6\x96\xa0\xb0\x33\x04\xb7\x7a\x0b\x76\xd8\xf6\x6e\xf0\x3d\xff\x30\x1f\x8d\xc0\x5f\x11\xf3\xe4\x70\x3d\x14\xd7\x62\xa2\x0f\xa1\xf4\x66\x42\xb9\xfb\x2e\x26\x9d\x93\x71\x8a\x92\xa0\x18\xde\x69\x4f\xb6\x88\x7b\x01\xfc\x8d\x13\xcc\x4e\x7b\x34\x0d\xcd\x38\xe1\x92\x26\xbf\x03\x7f\x06\x43\xc7\x29\x2d\x3d\xa1\x66\xc3\x5d\x6d\x24\xcd\x87\x5c\xc1\x7a\xbc\x61\xef\x6e\xd0\xbb\x1a\xf2\x4f\x92\xc6\xf4\x4e\x0e\x37\x63\x18\x3a\x00\x3f\x19\xe7\xe1\x9d\xfc\xbd\x1c\x20\xf4\x99\x61\xc6\x1f\x16\x43\x44\x69\x38\xa2\xd1\x1c\xb4\xe3\x13\x4a\x72\x30\xd4\x8b\x06\xea\xf6\x9c\xf0\x04\xab\xd5\x98\xa6\x23\x32\x9e\x83\xff\x0d\x1e\x5f\x05\x66\x83\x2f\x31\x4e\x30\xc7\x7d\xd8\x29\x53\xbe\xc9\x1c\x39\xd6\x50\x36\x50\xb1\xa0\x50\xa5\x09\x49\xe2\xa5\x62\x55\xd8\xf0\x81\xde\x4b\x1e\x0c\xe9\xfd\xab\xde\x9a\x15\xda\x9a\xc9\x2d\xc9\x09\x8c\xbe\xd8\xa0\xe6\x95\x69\xfb\xb0\x4a\x45\x33\x43\x6c\x4c\x52\xf0\x43\x46\x20\x21\x7f\x38\x50\x30\x32\x9e\x38\x92\x70\x9a\xb9\x11\x0c\x29\xe7\x74\x66\x49\x43\x19\x81\xd5\x12\x8b\xdb\x3b\x83\x78\xa8\x34\xd9\xf5\x84\x79\x86\x22\xd8\xbb\x74\xdd\x6c\xac\xa6\x7a\x45\x8b\xad\xf6\x03\x2a\x15\x91\xc0\xc5\x10\xb1\x8a\x7b\xe7\xbd\xcc\x2a\x22\x10\xba\x30\x83\xf8\x50\xc8\xb7\x1b\xa9\x8d\x94\xac\x26\x1e\x88\x88\x14\x34\xa3\x2f\x2e\xa1\xe7\xa8\xe0\x70\x88\x18\x46\x5b\x13\x53\xca\xfa\x1c\x56\x30\x5d\x4a\x7c\xc9\x9b\xfe\x1a\xc8\x71\x05\xc5\x7f\xc8\xc6\xd5\x7d\xe3\x42\x05\x9b\x23\x9d\xf3\x30\xe7\x0f\x05\x7b\x71\x1a\x1b\x89\x2b\x22\xb2\x82\x4d\xc0\xb7\x9c\xd0\x24\xc6\x6c\xa0\x20\x1c\x28\x29\xd7\xad\xe8\x26\x1a\x8a\xa6\x30\x97\xfa\xe1\xe3\xfb\x0c\x6c\x96\x07\xb7\x46\x24\x49\x3c\xc8\x32\x9a\x13\xa9\x78\xbf\xe9\xc9\xe0\x8e\x6a\xfc\x4a\x26\x68\x84\x2f\xa2\xf0\x43\xca\xad\xa5\x6f\x5b\xee\xd6\xe9\x1f\x5b\xf0\x26\x8b\x45\x30\x29\xa7\x8a\x70\x29\xb1\xc7\x66\x91\xd5\x4b\x5d\xd5\xf3\x44\x43\xb0\x43\x6a\xf3\x14\x26\xe2\xa6\x0a\xc8\xd3\x58\xed\x82\x9b\x2a\x7a\x39\x50\x65\xb0\x18\x00\x2a\x6c\x15\x01\xcc\x4a\x06\xb8\xb0\x69\xe7\xc1\x10\xe3\x34\xc8\x31\x0f\x46\x0c\xee\xf1\x09\x06\x27\x78\x36\x83\x45\x09\x12\x92\xe2\xa3\xe0\x06\x7e\x59\x51\x14\x0d\x27\xe0\x93\x04\x77\xa0\x04\x40\x7f\x5b\x24\x4b\x02\x0c\x8e\x50\xc4\x8f\x5c\xc7\x7a\xc7\x50\x66\xc3\x25\x9d\xae\x4b\x4c\x9d\xbe\xab\x7a\xb4\xd6\x79\x15\xb1\xd4\x7b\x9f\x45\xb5\x52\x7d\x39\x53\xbd\xf9\xea\xec\xa0\x92\x09\x2a\x06\xa8\x27\xef\x35\x71\x77\x21\x70\x9e\xaf\x62\xae\x95\x79\xda\x78\x58\x5f\x29\xc7\x43\x4a\xa7\xd2\x86\xa5\xe5\xd5\xb6\x09\xdf\x89\x6b\xb5\x66\xa5\x5c\xc8\xac\xcc\x7d\x65\x80\x56\x44\x6a\xe5\x30\xc6\x15\xbf\x5b\x6c\x6e\xd6\xb6\x7c\x97\xbb\xa2\xb5\x97\x6e\x9e\xbd\x9a\x03\x9f\x18\xf4\x21\x79\x30\x2a\xfe\x55\x6d\xf1\x8d\x38\xd1\x94\x1b\x2a\xfa\xfa\x08\xcc\x82\xda\x14\x8d\x59\x90\xeb\x23\x33\x0b\x62\x63\x94\x66\xa2\x17\xfb\x79\x78\x8f\x12\x08\xbd\x6b\xb7\x0f\x85\x9f\x34\x41\x45\x28\x5e\x64\xac\x7a\x67\xa4\x12\xd1\x57\xc8\xb5\x82\x24\xee\x56\x84\xe9\x33\x23\xb1\x94\xa5\x31\xfc\xa7\x13\x25\xd5\xc0\x9c\xc4\x49\x05\xe0\x2a\x52\x2a\x0c\x37\xb1\x32\x20\xd8\x8a\x96\x01\x42\x88\xd7\xab\x06\x00\xd6\x22\xa6\xc2\x60\x20\x24\xe6\x18\xbf\x0e\x41\xa6\x3e\x5d\x41\x8c\xf2\x26\x5a\x98\xfc\x74\xa1\x1c\x6f\x0c\x52\xa7\x1a\xa8\xb3\xe4\x29\x67\xeb\x21\x7d\x2a\x9c\x49\xa9\xd8\x35\xd1\xb3\x09\x42\xef\xf1\x5f\x73\xc4\x38\xc4\x87\x31\xec\x1f\x9c\x32\xf9\x3c\x3d\xc7\x79\xae\xc8\xed\x69\xfa\x32\x78\xde\xab\xf1\x98\x3c\x70\xed\xa8\x41\xe7\x42\xc4\x39\x2a\x1e\xef\xd6\x9a\x35\x1d\x0a\x28\x8d\x2b\x88\xc1\xc5\x5e\x35\xa9\x11\x4b\x1f\xb1\xbd\x06\xee\xc3\x30\x85\xdc\xe6\xe2\xff\x90\xcd\x41\x5f\x8a\x35\x9a\x67\xcf\x2c\xc3\x4d\x60\x16\x22\x2c\x66\x62\x0d\xb2\x91\x4a\x06\x52\x8e\xfb\x10\x5b\x6e\xe6\x91\xab\x6c\x0a\x45\xcb\x50\xb4\x54\x64\x95\x35\x7d\x3d\x8d\x10\x1f\x1f\x80\x10\x6f\xdb\xde\x57\x9d\xed\xd5\xd9\xde\x09\xbd\xdb\xac\xcf\x08\x64\x69\x09\xbe\xe7\x01\xa7\x01\x89\x5e\xa2\x0d\xb6\xd4\x81\x16\xd9\xe0\x1c\xd6\xa9\x7c\x84\x57\x4c\xa7\xb3\xc3\x7a\x3b\xbc\xc5\xaa\x17\x6f\x8b\x5b\x2e\xcc\xdb\xb6\xf8\x75\x67\x8b\x35\xb6\xf8\xcb\xb7\xcf\x32\x7d\xfd\xf2\x0c\xae\x55\xc0\xd8\x1e\x19\xfd\x5b\x27\xa3\xba\xa7\x33\x73\x70\x0a\x64\xd1\x48\xe9\x2e\x0c\x51\x8e\xe3\x80\xa6\xc5\xf3\x98\x6c\xce\x31\x0b\x2e\xbf\xe7\x2f\x4f\x82\x5f\xb7\x5b\x82\x15\x2e\x83\x2c\xab\x0e\x11\xac\x58\x59\xe6\xd3\x79\x0d\x0a\xaf\x41\xc1\xa6\x97\xe9\x27\xb4\x5c\x82\xb7\x6d\xb0\xa2\xb2\xde\x76\xd6\x87\x63\x8e\x7f\x4e\x90\x08\xd3\x62\x88\xd4\x46\xc5\x23\x6e\xc1\xae\x97\x67\x7d\x7f\x6f\xb7\xec\x9e\xd3\xd9\x90\x2e\x9f\xd9\x45\xc5\xd5\x90\xde\x6b\xcb\xc6\xea\x66\xde\x2e\xf9\xb5\xad\x7d\x31\x81\xcc\x68\x5c\x70\x41\xf1\xc2\x84\x2f\x22\x89\x43\x99\xca\x77\x91\xb0\xfa\x82\xd9\xea\xda\x19\x4a\x64\x0d\x3d\xd5\x0a\x91\x68\x55\x15\x24\x9c\x24\x57\x60\x29\x30\xc3\xac\xa8\x0a\x2e\x05\x0a\x7e\x65\xe5\xaf\x45\x45\xef\x71\x5d\xf7\x00\x0d\x9a\xc3\xc8\x10\xfc\xb1\xbc\xae\xe9\x7a\xe3\x85\xea\x15\x5d\x17\xbb\xce\xf2\x46\x6d\x7f\x03\xdb\x0e\x6b\x15\x70\xbf\x76\xd5\x96\x47\x36\x1a\xcb\xf4\x52\xf6\xd6\x47\xb1\x4d\x6b\xcc\x69\xab\x75\x5a\x1b\x62\x0b\xed\xd3\xab\xce\x3e\xb5\xc6\x3e\xb5\xdc\x73\x52\xc4\xad\xd1\x04\x47\xd3\x70\x9e\xc5\x10\xae\xe5\x5d\xc8\xaa\x4f\x74\x6f\x30\xca\xc3\x0c\xec\x93\x18\x5b\x95\x69\xb4\x27\x78\x7d\xbb\xd7\xbb\xea\x63\x46\xac\xe7\x85\xd0\x06\x23\xca\x82\x52\x70\x5f\x5e\xb0\xfa\x64\xb2\x6a\x66\x8a\x91\xb8\x7c\x29\x43\xbe\x22\x6a\x7a\x6b\xc0\x46\xda\x55\x15\xaa\x4b\xea\xa6\x92\xfe\x18\x05\x75\xe0\x35\xc6\x9e\xf5\x63\x7a\xc9\x96\x07\x82\x58\x04\xb4\xde\xcb\xd6\xbd\x60\x60\xe2\x4f\x93\xea\x62\x5d\x81\x48\x57\x5d\xdc\x8a\xea\x62\xa7\x38\xcf\xab\x30\x5f\x47\xdc\xee\xba\xe6\xf5\xd7\x04\xfe\xd8\x37\x23\xdc\xba\xaa\xe6\x26\x00\x8d\xaa\x9a\x9d\xd3\x18\x8d\x44\x5c\x05\xf0\xf8\xe5\xd0\xd7\x11\xa3\x49\x82\xe3\xf5\x83\x03\xf2\xf2\x37\xf9\x22\xbe\xa9\x2c\x5f\x39\xe9\x36\xc5\x7a\xbb\x48\x44\x39\xaf\xa2\x0a\xc4\xd1\xe2\x2c\x61\x3c\x13\x42\x37\x0c\xe3\x7f\x11\x5c\x2e\x28\x87\xab\x5b\xb8\xda\x3a\x05\xc5\x89\x09\x3e\xab\xaa\x02\xf2\x5c\x59\x15\x54\x25\x43\x58\x4e\xaf\x09\xe6\x04\xa3\x18\xb3\x3c\x5c\xce\xd7\x29\x64\x53\x21\x96\x0f\xb2\x73\x8c\x58\x11\x85\xb8\xe3\x6d\xa4\x1e\x18\xbd\xeb\x2f\x0e\x0b\xd9\x3e\x54\x64\x6b\x95\xc3\xe2\xad\x8c\xb5\xb6\x0e\x79\x48\xd1\xaf\xfa\xfd\xf0\x1c\x27\x38\x92\xce\x62\x2d\x84\x4e\x32\xaf\x97\x18\x4a\xf1\x0c\xdd\xfa\xb0\x4a\xd1\xea\xfb\xf0\x4a\xd4\x96\xfd\x5a\x44\xbf\x8b\x96\x56\x8a\x6c\xab\xf0\xa2\xa5\x56\xe9\xcf\x65\x8a\x7b\x83\xb7\x32\xed\x5d\x67\xcb\x97\xd8\xdb\x61\xb4\xf6\xc4\x97\xef\xcb\xda\x64\x17\x99\x76\x9b\xa9\x7a\xb6\x76\x39\xef\xd7\x76\x2b\x29\xba\x70\xc9\x7d\x57\x89\xbc\x73\xe0\x4b\x28\xeb\x5c\xf8\x8a\xc2\x5e\x5a\x76\x2d\xaa\x76\x80\xcf\x9a\xb5\x77\x8a\xa6\x75\x20\xf6\x51\xb5\x0e\xc1\x3a\xba\x5e\x71\xe4\x79\xb2\xa5\xeb\x61\xcb\x5e\xbf\x16\x77\xeb\x95\xe2\xdf\x02\x71\x7e\x8d\xda\x04\xe6\xe6\xc8\x0b\x04\x4f\xcf\x4f\x1e\x07\x54\xae\xa3\xf8\x7f\x71\xc4\x58\x1c\x7b\xf8\x7d\x65\x0e\x72\xcc\xa7\x7d\x00\x68\xe2\x07\xb5\xd1\x83\x64\x38\xc2\xe4\x16\xbc\x81\x18\x8f\xd0\x3c\xe1\xcd\x11\xe7\x79\xf1\x66\x03\x8d\xa6\x1e\x50\x9b\x5e\x4c\x42\xa2\xe9\xb6\x17\xb3\xbd\xa0\xe1\xb2\x95\x93\xef\x62\xbb\x19\xd8\x99\x5d\x15\x1b\xbc\x4c\xaf\x0a\xc8\xdd\xfc\xaa\x50\x9c\x4d\xb0\x00\xa9\x37\xc3\xb2\x99\x85\xa6\xee\x5c\x9b\x71\x4c\x78\x33\x75\x2e\x10\x5a\xa1\xcf\x39\x4e\x8b\xd5\xb9\xdd\x45\xb0\xd5\xd9\x86\x95\x78\x74\xc6\xc1\x0e\x65\x65\x1c\xac\x1f\x89\xb7\xdc\x38\x30\x3c\xa3\xb7\x75\xaf\x15\x08\x4c\xbd\x79\x90\xc7\x6f\x76\x06\x42\x0f\xb5\xd7\x06\x42\x8a\x48\x67\x22\xec\x50\x56\x26\xc2\xb2\xae\x54\x80\xec\xcc\x44\x1c\x50\x88\xfc\x64\x6f\xcd\xb6\xa5\x48\x63\xef\x9e\x0f\x3e\x4a\x91\xc6\x77\xdb\xcc\x7d\x57\xa4\x51\xab\x23\xee\x45\x1a\x6a\xde\x98\x4e\x8d\x95\xb2\x0f\x2b\x68\x71\x44\xec\xb6\xc4\xdb\x9c\x0a\xfb\x2c\x07\xe7\x35\xa8\x21\x6a\x7e\x8e\x26\x74\x17\x5a\x1d\x89\xa9\x59\x5e\xc3\x6a\xd5\x2f\xd1\x7a\xe6\xaf\xb5\xcb\xf3\xa4\xe7\x1a\xea\x6a\xe7\xba\xca\xa3\xae\xf2\xa8\xc5\x95\x47\xf5\xe5\x1c\xa6\x33\x97\x1e\xd3\xdf\xf0\x85\xe8\xca\x91\x5a\x54\x8e\xe4\x5e\xc4\xe2\xf3\x1c\x4a\x53\xbc\xb2\xf6\x09\x3d\xeb\x19\xb7\xa8\x16\xa9\x52\xad\x52\xcc\xc7\x17\x8c\x61\xca\x62\x70\x49\x1a\x4f\xae\x49\x75\x4a\x35\x4f\x53\x80\x78\xbc\x61\xb7\xed\x0a\x51\x9a\x70\x92\x2d\x81\x2c\x4f\x48\x08\x9a\x97\xac\x38\x95\xab\xf4\x97\xb8\xb5\x45\x06\x8f\x9a\x06\xac\x2f\xfb\xa8\x3b\xdb\x49\xe0\x6e\x2f\x26\xf9\xaf\x78\x60\x59\x1c\xc0\x01\xff\x37\x4a\x9f\xe8\xcb\x47\x2e\xe4\x37\x26\xdf\x35\x4a\xe9\x15\x39\x2c\x77\x4d\x50\x4e\x9a\x32\x1e\x2e\x4f\x3c\xdd\x11\x5e\x79\xb4\x2d\x89\x9d\xb2\x47\xb6\xf2\x20\x5a\x9a\x8b\x63\xe8\x78\x9c\x60\x45\x79\x8c\xf8\xdd\xba\x24\x68\x23\xc1\x28\x69\xb7\x12\x8c\x60\xd0\x92\x82\x73\x64\x79\x7c\x4b\x1c\x2e\x1b\x3e\x62\x71\x55\xe0\x5e\xba\x53\x29\xdb\x11\x75\x72\x58\xac\x90\x53\xe1\x8e\x5b\xd1\xce\x53\xd7\xd6\x3c\xa6\x51\xa9\x3b\xbc\x53\xe0\x5a\x1b\x82\xf7\x2b\xd9\x71\xae\x26\xdb\x63\x83\xe0\xf0\xc4\x69\x87\x06\x41\x5b\x2d\xf7\xc6\x52\x35\x1b\xab\x9b\x5f\x95\x5c\xbb\x95\xad\x1e\x68\x4f\x52\xd2\xc7\x26\x7f\xe1\x70\x72\xd2\xa5\x6f\x32\xf8\x48\x72\xf9\x21\xec\xb5\xfd\xad\xcb\x54\x1b\x28\x5b\xfd\xbd\x22\xab\x34\xba\x7b\x8e\xb5\x59\x46\xbc\xb5\xdf\x92\x31\x9c\x38\x69\xa5\x0c\x4f\xb4\x5e\x2d\x4e\xa7\xff\xbd\xad\x4b\xeb\x9e\x4e\xdf\xce\x47\xd1\x19\x1d\xe3\x14\x53\x9b\xcc\x48\x93\x5c\xbc\x6e\x3b\xea\x72\xf1\xad\xc8\xc5\xef\x7b\x46\xfc\xb9\x8e\x49\xe8\x32\xe2\x5d\x46\x5c\xdd\x42\xf9\x25\xf2\xb5\x8c\x38\x5c\x2d\xdf\x65\x53\x7f\xeb\xdd\x9a\x01\x2d\x4a\x90\x67\xe4\x1e\x27\x79\x88\x86\x45\x61\x5a\xf1\xf5\xd0\xdc\x25\xf5\xab\x06\x03\xcb\x04\xcb\xd3\x14\x4c\x9c\x45\x23\x05\xb6\x01\x8a\x50\xb7\xe6\x30\xf2\xbb\xf1\xbd\x33\xf3\xb7\xfa\x7d\xd1\x51\x14\xe1\x8c\xe7\xa1\x70\x64\x1d\x9e\x06\xbc\x9c\xe0\xd7\xf4\xe6\xd3\xe1\x04\xbf\x1f\xf0\x88\xb2\xc5\x59\xf2\xe5\xc1\xf2\x52\xb2\x82\xff\xff\x35\xe1\x7f\xa2\x60\xc2\xf0\x08\x4c\x30\xe7\x59\xfe\x8f\xc1\x60\x4c\xf8\x64\x3e\x3c\x8a\xe8\x6c\x40\xa7\x45\xe9\xf0\x40\x90\x4a\x4a\x36\xb8\x23\x53\x32\xb8\x16\xf4\x79\xef\xd7\x31\xff\x73\x82\x93\xac\xc0\x19\xa0\xe2\xea\x2f\x3e\x83\x2f\xaa\x65\x41\x9b\xa6\x73\xab\xaf\x00\x0b\x84\x2e\x24\x6f\xdb\x09\x3f\xba\xf2\xc7\xce\xb7\x6f\x85\x6f\xdf\xd5\xd9\x88\xbb\x1e\x51\xc5\x73\xe5\x50\xbb\xa8\xa2\x8b\x2a\xd4\x2d\xdc\xa2\x8a\x18\x2f\x8e\x0e\xe9\x22\x8b\x43\x8f\x2c\x2a\xb2\xd0\x45\x17\xea\x5e\xea\xa3\x0b\xd3\x27\xac\x0e\x27\xba\xf8\x96\x06\x2b\x99\x2a\x83\x8c\x2e\xba\x78\x01\xd1\xc5\xce\x5f\x4d\x69\x18\x5d\xe8\xaa\x9b\xba\xf0\xa2\x0b\x2f\xf6\x3b\xbc\x30\xd5\xed\x75\xf1\x85\x19\xa2\x8b\x2f\x9e\x3f\xbe\xe8\xa2\x0b\x13\xd8\x21\x45\x17\x5d\x6c\xb1\xab\xd8\xe2\xb9\xf6\x84\xd6\x05\x17\x5d\x68\x61\x41\x78\xe8\xa1\xc5\x2e\x6b\xd3\x0c\x2f\x76\x3c\x71\x2d\x61\x69\x45\x55\x6f\xca\xb5\xa2\xec\x4c\xaf\xb7\xa5\x96\x3d\x4d\x31\xa1\xf1\x4d\x9c\xe7\x2c\x26\xcc\x12\x14\xe1\x09\x4d\x62\x30\x3e\x3b\x91\x18\x27\x40\x15\x8b\xd5\xec\xf5\xb2\x0f\xee\x76\xc1\xd9\x1e\x28\x96\xa0\x32\xcf\x2a\xeb\xcd\x35\x9c\xdb\xcf\x72\xbc\x14\xc9\x57\x89\x7c\x8a\x4e\x55\x59\xc6\x56\x68\xbf\x75\xec\x53\x09\x59\xac\x0e\x50\x75\xc9\x15\x29\x4e\x4f\x83\xc1\x51\xa6\x0b\x45\xec\x0d\xd9\x85\x80\x71\x4e\x67\xec\x28\x05\xe5\x43\xee\x77\x94\x59\x65\x71\x5d\x8e\xdd\xb5\x3f\xb0\x4c\xae\x8a\xc3\x51\x65\x2f\xc8\xe9\x79\xe6\x6a\x0d\x85\x8e\x90\xd9\x4e\x74\xe4\x72\x76\x20\x3a\x62\x2d\xe6\x92\xb1\x87\x29\xe6\x2d\xf4\xed\xad\x79\xe2\xc7\x0f\x2f\x95\xdf\x81\x7b\x69\x74\x1b\x54\x9f\xc7\x6c\x87\xdb\x60\xf7\xe9\xcb\xbd\xf2\x36\x6c\x1f\x4d\xad\x9d\xc9\x1a\x25\xd4\x5d\x3f\xf7\xd0\x66\xfa\xad\xbe\x8a\xd4\xf1\x44\x55\x7b\x6b\x2d\x96\xe2\x30\x8d\x75\x0b\x5f\xea\x7c\x36\x63\xbd\x93\x77\x48\xf7\x28\xe8\xd6\xa4\x4d\xea\x83\xee\xcd\x39\xae\xdd\x5c\xdd\x38\x19\x88\x83\x7b\x46\x28\xc2\x67\xbf\xfc\x2f\x00\x00\xff\xff\x13\xd0\xba\x4a\xf3\xb9\x00\x00")
-
-func assetsConfigGladeBytes() ([]byte, error) {
-	return bindataRead(
-		_assetsConfigGlade,
-		"assets/config.glade",
-	)
-}
-
-func assetsConfigGlade() (*asset, error) {
-	bytes, err := assetsConfigGladeBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "assets/config.glade", size: 47603, mode: os.FileMode(436), modTime: time.Unix(1519722112, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _assetsConfigGlade2 = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xec\x5d\x5d\x73\xdb\xba\xd1\xbe\x3f\xbf\x82\xaf\x2e\xce\xbc\x9d\x8e\xac\xe3\xe4\x9c\x34\xed\xb1\x9d\x49\x1c\x27\xe3\x69\x26\xc9\xd8\x4e\x73\xc9\x81\x48\x48\x42\x45\x11\x2c\x08\xf9\xa3\xbf\xbe\x4b\x80\xfa\x24\x00\x02\xa0\x6c\x53\x16\xaf\x6c\x8a\xd8\x05\xb0\x78\x76\xb1\xbb\x5c\x82\x27\xef\xee\x67\x49\x70\x8b\x59\x4e\x68\x7a\xda\x3b\x3e\xfa\xad\x17\xe0\x34\xa2\x31\x49\xc7\xa7\xbd\x1f\x37\x9f\xfa\x6f\x7b\xef\xce\x7e\x39\xf9\xbf\x7e\x3f\xf8\x8c\x53\xcc\x10\xc7\x71\x70\x47\xf8\x24\x18\x27\x28\xc6\xc1\xeb\xa3\xe3\xb7\x47\xaf\x83\x7e\x1f\x1a\x91\x94\x63\x36\x42\x11\x0e\x62\x3a\x43\x04\xf8\x65\x8c\xde\x3f\x44\x13\x94\x8e\x31\xeb\x9d\xfd\x12\x04\x27\x0c\xff\x67\x4e\x18\xce\x83\x84\x0c\x4f\x7b\x63\x3e\xfd\x6b\x6f\xd5\x3d\x30\x7b\xd5\x1b\x88\x76\x74\xf8\x6f\x1c\xf1\x20\x4a\x50\x9e\x9f\xf6\x3e\xf3\xe9\x17\x92\xf3\x6b\x4e\x19\xee\x05\x24\x3e\xed\x25\x70\x99\x17\x97\x21\xca\xb2\x5c\xf0\x06\xaa\x88\x26\xf3\x59\x9a\xcb\x2b\xb8\x2e\x86\x2d\x7f\xeb\xa7\x68\x86\x83\xf9\x9c\xc4\x62\xac\xe5\x7d\x79\x2f\xe0\x0f\x19\x86\xc1\xc0\x40\x19\x62\x0c\x3d\xc8\x31\x28\x39\xc4\x24\xcf\x12\xf4\xd0\x8c\x09\x4e\xd1\x30\xc1\x86\x91\x0c\x29\x4d\x30\x4a\x4d\xe3\xc0\x79\xc4\x48\xc6\x41\x6e\x0e\x63\x39\x19\xac\x49\xe8\x64\x20\x85\xec\x28\x6f\x92\x85\x29\x0d\x67\x88\x47\x13\x5b\xb1\x2f\xda\x87\x4d\xa5\xbf\x64\xe4\xbe\x0c\x1b\x53\x87\xcb\x18\x71\xb4\xa4\x65\xf4\x6e\xf1\xbf\xe4\x24\xe6\xfc\x5b\xef\x6c\x8a\x71\x26\x28\x15\xb7\x8f\x7b\x01\x67\x28\xcd\x13\xe0\x04\xcb\x79\xda\x7b\xc0\x00\xc4\x7f\x02\x45\x10\xcd\x19\xc3\x29\x0f\x04\xfe\x37\xe8\x4f\x06\x6b\x7d\xe9\xfb\x8d\x31\x8a\x38\xb9\x05\x6d\x73\xeb\xfd\xe3\x92\xce\xdc\xf7\xc9\x60\x31\x7f\x4f\x14\x24\x74\x1c\x26\xf8\x16\x27\xb6\x18\x10\x8d\x1b\x03\x40\x72\x79\xaa\xd5\xff\xf1\xf5\xfa\xfb\xc5\xf9\xe5\xa7\xcb\x8b\x8f\x6e\xcb\xf0\x3e\x49\x7c\x56\xfd\xe6\xea\xfd\xf9\x85\x5b\x4f\x37\x0c\xec\xad\x4f\x5f\x1f\x2f\x3e\xfc\xf8\xec\x0a\xae\xe1\x7c\xec\xd3\xd7\xe5\xd7\x4f\xdf\xdc\xba\xba\x4c\x47\xd4\xa7\xa7\x9f\xef\xaf\xbe\x5e\x7e\x75\x9c\xd7\x4f\xc4\x52\xd8\xef\x7c\xfa\xbb\xb8\xba\xfa\x76\xe5\xd6\xdb\x05\x63\x94\xf9\xf4\x75\x7e\x75\x79\x73\x79\xfe\xfe\x8b\x5b\x77\xe7\x8c\x70\x12\x21\x2d\x1e\x1b\x5b\x82\xc2\xce\x10\xdc\xea\x2d\xd8\x61\xdb\xbb\xc1\xf7\xfc\xc3\x7c\x34\x02\x7f\x45\xcc\x93\xc3\xf5\x50\x5c\x8b\x89\x3e\x84\xd2\x9b\x09\xe5\xee\xbb\x98\x74\x4e\xc6\x29\x4a\x82\x62\x78\xa7\x3d\xd9\x22\xee\x05\xf0\x37\x4e\x30\x3b\xed\xd1\x34\x34\xf3\x09\x97\x34\xf9\x1d\xf8\x33\x18\x3a\x4e\x69\xe9\x09\x35\x1b\xee\x6a\x23\x69\x3e\xe4\x0a\x2f\xef\x61\xff\x24\x69\x4c\xef\xe4\x90\x33\x86\xa1\x0b\x70\x3a\x71\x1e\xde\xc9\xdf\xcb\x21\x42\xaf\x19\x66\xfc\x61\x31\x48\x94\x86\x23\x1a\xcd\x01\x6a\x9f\x50\x92\x83\xd5\x5b\x34\x50\xb7\xe7\x84\x27\x58\xad\x13\x34\x1d\x91\xf1\x1c\x9c\x59\x70\x9f\x2a\x6c\x36\x24\x13\xe3\x04\x73\xdc\x87\x6d\x27\xe5\x9b\xe2\x91\x63\x0d\x65\x03\x95\x08\x0a\x5c\x4e\x48\x12\x2f\x51\x5a\x11\xc3\x07\x7a\x2f\x65\x30\xa4\xf7\xaf\x7a\x6b\x2a\xbd\x35\x93\x5b\x92\x13\x18\x7d\x61\xed\xe7\x95\x69\xfb\x88\x4a\x45\x33\x43\x6c\x4c\x52\xd8\xd4\x47\x80\x91\x3f\x1c\x28\x18\x19\x4f\x1c\x49\x38\xcd\xdc\x08\x86\x94\x73\x3a\xb3\xa4\xa1\x8c\xc0\x6a\x89\xc5\xed\x9d\x41\x70\x51\xda\xbf\x7a\xc2\x3c\x43\x11\x6c\x04\xba\x6e\x36\x56\x53\xbd\xa2\xc5\xbe\xf5\x01\x95\xca\x48\xe0\x62\x88\x58\xc5\x57\xf2\x5e\x66\x15\x11\x80\x2e\xcc\x20\xd8\x12\xf8\x76\x23\xb5\x41\xc9\x6a\xe2\x81\x08\xef\x40\x33\xfa\xe2\x12\x7a\x8e\x0a\x09\x87\x88\x61\xb4\x35\x31\x25\xd6\xe7\xb0\x82\xe9\x12\xf1\xa5\x6c\xfa\x6b\x4c\x8e\x2b\x5c\xfc\x87\x6c\x5c\xdd\x37\x2e\x54\xb0\xd3\xd0\x39\x0f\x73\xfe\x50\x88\x17\xa7\xb1\x91\xb8\x02\x91\x15\xdb\x04\x1c\xb5\x09\x4d\x62\xcc\x06\x0a\xc2\x81\x92\x72\xdd\x8a\x6e\x72\x43\xd1\x14\xe6\x52\x3f\x7c\x7c\x9f\x81\xcd\xf2\x90\xd6\x88\x24\x89\x07\x59\x46\x73\x22\x15\xef\x37\x3d\x19\xdc\x51\x8d\x5f\x29\x04\x0d\xf8\x22\x0a\x3f\xa4\xdc\x1a\x7d\xdb\xb8\x5b\xa7\x7f\x6c\xe0\x4d\x16\x8b\x60\x52\x4e\x15\xe1\x12\xb1\xc7\x66\xc8\xea\x51\x57\x75\xe3\xd0\x10\xec\x90\xda\x3c\x85\x89\xb8\xa9\x62\xe4\x69\xac\x76\x21\x4d\x15\xbd\x1c\xa8\x32\xf2\x82\xd8\x1b\xfc\xd2\x59\x00\xb3\x92\xd1\x22\x6c\xda\x79\x30\xc4\x38\x0d\x72\xcc\x83\x11\x83\x7b\x7c\x82\xc1\xa3\x9c\xcd\x60\x51\x82\x84\xa4\xf8\x28\xb8\x81\x5f\x56\x14\x45\xc3\x09\xf8\x24\xc1\x1d\x28\x01\xd0\xdf\x16\x99\x87\x00\x83\x2b\x14\xf1\x23\xd7\xb1\xde\x31\x94\xd9\x48\x49\xa7\xeb\x92\xa7\x4e\xdf\x55\x3d\x5a\xeb\xbc\x8a\x58\xea\xbd\xcf\xa2\x5a\xa9\xbe\x9c\xa9\xde\x7c\x75\x76\x50\x29\x04\x95\x00\xd4\x93\xf7\x9a\xb8\x3b\x08\x9c\xe7\xab\x98\x6b\x65\x9e\x36\x1e\xd6\x57\xca\xf1\x90\xd2\xa9\xb4\x61\x69\x79\xb5\x6d\xc2\x77\xe2\x5a\xad\x59\x29\x17\x32\x2b\x73\x5f\x19\xa0\x15\x91\x5a\x39\x8c\x71\xc5\xef\x16\x9b\x9b\xb5\x2d\xdf\xe5\xae\x68\xed\xa5\x9b\x67\xaf\x96\xc0\x27\x06\x7d\x48\x19\x8c\x8a\x7f\x55\x5b\x7c\x23\x49\x34\x95\x86\x8a\xbe\x3e\x02\xb3\xa0\x36\x45\x63\x16\xe4\xfa\xc8\xcc\x82\xd8\x18\xa5\x99\xe8\xc5\x7e\x1e\xde\xa3\x04\x42\xef\xda\xed\x43\xe1\x27\x4d\x50\x11\x8a\x17\xe9\x9f\xde\x19\xa9\x44\xf4\x15\x72\x2d\x90\xc4\xdd\x0a\x98\x3e\x33\x12\x4b\x2c\x8d\xe1\x3f\x1d\x94\x54\x03\x73\x82\x93\x8a\x81\x2b\xa4\x54\x3c\xdc\x60\x65\xe0\x60\x0b\x2d\x03\x0b\x01\xaf\x57\x0d\x18\x58\x43\x4c\xc5\x83\x01\x48\xcc\x31\x7e\x1d\x07\x99\x47\x74\x65\x62\xc4\x9b\x68\x61\xf2\xd3\x85\x72\xbc\x31\xa0\x4e\x35\x50\x67\xe4\x29\x67\xeb\x81\x3e\x15\x9f\x49\xa9\xd8\x35\xd1\xb3\x89\x85\xde\xe3\xbf\xe6\x88\x71\x88\x0f\x63\xd8\x3f\x38\x65\xf2\xe1\x74\x8e\xf3\x5c\x91\xdb\xd3\xf4\x65\xf0\xbc\x57\xe3\x31\x79\xe0\xda\x51\x83\xce\x85\x88\x73\x54\x3c\x2b\xad\x35\x6b\x3a\x2e\xa0\x34\xae\x4c\x0c\x2e\xf6\xaa\x49\x0d\x2c\x7d\x60\x7b\x0d\xd2\x87\x61\x0a\xdc\xe6\xe2\xff\x90\xcd\x41\x5f\x8a\x35\x9a\x67\xcf\x8c\xe1\x26\x6c\x16\x10\x16\x33\xb1\x66\xb2\x91\x4a\x06\x52\x8e\xfb\x10\x5b\x6e\xe6\x91\xab\x62\x0a\x45\xcb\x50\xb4\x54\x64\x95\x35\x7d\x3d\x0d\x88\x8f\x0f\x00\xc4\xdb\xb6\xf7\x55\x67\x7b\x75\xb6\x77\x42\xef\x36\x8b\x1d\x02\x59\xa7\x81\xef\x79\xc0\x69\x40\xa2\x97\x68\x83\x2d\x75\xa0\x45\x36\x38\x87\x75\x2a\x1f\xe2\x15\xd3\xe9\xec\xb0\xde\x0e\x6f\x89\xea\xc5\xdb\xe2\x96\x83\x79\xdb\x16\xbf\xee\x6c\xb1\xc6\x16\x7f\xf9\xf6\x59\xa6\xaf\x5f\x9e\xc1\xb5\x0a\x18\xdb\x83\xd1\xbf\x75\x18\xd5\x3d\x9d\x99\x83\x53\x20\x8b\x46\x4a\x77\x61\x88\x72\x1c\x07\x34\x2d\x9e\xc7\x64\x73\x8e\x59\x70\xf9\x3d\x7f\x79\x08\x7e\xdd\x6e\x04\x2b\x5c\x06\x59\xa3\x1c\x22\x58\xb1\xb2\xcc\xa7\xf3\x1a\x14\x5e\x83\x42\x4c\x2f\xd3\x4f\x68\x39\x82\xb7\x6d\xb0\xa2\x4c\xdd\x76\xd6\x87\x63\x8e\x7f\x4e\x90\x08\xd3\x62\x88\xd4\x46\xc5\x23\x6e\x21\xae\x97\x67\x7d\x7f\x6f\x37\x76\xcf\xe9\x6c\x48\x97\xcf\xec\xa2\xe2\x6a\x48\xef\xb5\x65\x63\x75\x33\x6f\x17\x7e\x6d\x6b\x5f\x4c\x4c\x66\x34\x2e\xa4\xa0\x78\xfb\xc0\x97\x23\x89\x43\x99\xca\x77\x41\x58\x7d\xc9\x6c\x75\xed\x0c\x25\xb2\x86\x9e\x6a\x41\x24\x5a\x55\x81\x84\x93\xe4\x0a\x2c\x05\x66\x98\x15\x95\xc1\x25\xa0\xe0\x57\x56\xfe\x5a\xd4\xf4\x1e\xd7\x75\x0f\xac\x41\x73\x18\x19\x82\x3f\x96\xd7\x35\x5d\x6f\xbc\x50\xbd\xa2\xeb\x62\xd7\x59\xde\xa8\xed\x6f\x60\xdb\x61\xad\x02\xee\xd7\xae\xda\xf2\xc8\x46\x63\x99\x5e\xca\xde\xfa\x28\xb6\x69\x4d\x38\x6d\xb5\x4e\x6b\x43\x6c\xa1\x7d\x7a\xd5\xd9\xa7\xd6\xd8\xa7\x96\x7b\x4e\x8a\xb8\x35\x9a\xe0\x68\x1a\xce\xb3\x18\xc2\xb5\xbc\x0b\x59\xf5\x89\xee\x0d\x41\x79\x98\x81\x7d\x82\xb1\x55\x99\x46\x7b\x82\xd7\xb7\x7b\xbd\xab\x3e\x66\xc4\x7a\x5e\x80\x36\x18\x51\x16\x94\xc0\x7d\x79\xc1\xea\x93\x61\xd5\x2c\x14\x23\x71\xf9\x52\x86\x7c\xdf\xd2\xf4\xd6\x80\x0d\xda\x55\x15\xaa\x4b\xea\xa6\x48\x7f\x8c\x82\x3a\xf0\x1a\x63\xcf\xfa\x31\x3d\xb2\xe5\xe9\x1a\x16\x01\xad\xf7\xb2\x75\x2f\x18\x98\xe4\xd3\xa4\xba\x58\x57\x20\xd2\x55\x17\xb7\xa2\xba\xd8\x29\xce\xf3\x2a\xcc\xd7\x11\xb7\xbb\xae\x79\xfd\x35\x81\x3f\xf6\xcd\x08\xb7\xae\xaa\xb9\x09\x83\x46\x55\xcd\xce\x69\x8c\x46\x10\x57\x31\x78\xfc\x72\xe8\xeb\x88\xd1\x24\xc1\xf1\xfa\xc1\x01\x79\xf9\x9b\x7c\x11\xdf\x54\x96\xaf\x9c\x74\x9b\x62\xbd\x5d\x24\xa2\x9c\x57\x51\xc5\xc4\xd1\xe2\x2c\xd9\x78\x26\x84\x6e\x18\xc6\xff\x22\xb8\x5c\x50\x0e\x57\xb7\x70\xb5\x75\xa4\x88\x93\x10\x7c\x56\x55\xc5\xc8\x73\x65\x55\xac\x2a\x19\xc2\x72\x7a\x4d\x78\x4e\x30\x8a\x31\xcb\xc3\xe5\x7c\x9d\x42\x36\x15\xc7\xf2\x41\x76\x8e\x11\x2b\xa2\x10\x77\x7e\x1b\xa9\x07\x46\xef\xfa\x8b\xc3\x42\xb6\x8f\x15\xd9\x5a\xe5\xb0\x78\x2b\x63\xad\xad\x43\x1e\x52\xf4\xab\x7e\x3f\x3c\xc7\x09\x8e\xa4\xb3\x58\xcb\x42\x87\xcc\xeb\x25\x0f\x25\x3c\x43\xb7\x3e\xac\x52\xb4\xfa\x3e\xbc\x12\xb5\x65\xbf\x16\xd1\xef\xa2\xa5\x95\x22\xdb\x2a\xbc\x68\xa9\x55\xfa\x73\x99\xe2\xde\x90\xad\x4c\x7b\xd7\xd9\xf2\x25\xef\xed\x30\x5a\x7b\xe2\x8b\xe4\xeb\x82\x67\xb7\x59\xaa\x67\x6a\x97\xef\x7e\x6d\xb7\x8a\xa2\x0b\x97\xbc\x77\x95\xc8\x3b\xff\xbd\x64\x65\x9d\x07\x5f\x51\xd8\x23\x65\xd7\x30\xb5\x63\xf8\xac\x19\x7b\xa7\x48\x5a\xc7\xc4\x3e\xa2\xd6\x71\xb0\x8e\xac\x57\x12\x79\x9e\x4c\xe9\x7a\xc8\xb2\xd7\xaf\xc4\xdd\x7a\xa5\xf7\xb7\x98\x38\xbf\x42\x6d\x62\xe6\xe6\xc4\x0b\x0e\x9e\x5e\x9f\x3c\x0a\xa8\x5c\x47\xf1\x7f\x59\x70\x8f\xe2\xd8\xc3\xe7\x2b\xf3\x8f\x63\x3e\xed\x03\x83\x26\x3e\x50\x1b\xbd\x47\x86\x23\x4c\x6e\xc1\x13\x88\xf1\x08\xcd\x13\xde\x9c\xe3\x3c\x2f\xde\x6a\xa0\xd1\xd4\x83\xd5\xa6\x07\x93\x90\x68\xba\xed\xc1\x6c\x2f\x68\xb8\x6c\xe5\xe4\xb7\xd8\x6e\x06\x76\x66\x57\x25\x06\x2f\xd3\xab\x62\xe4\x6e\x7e\x55\x5c\x9c\x4d\xb0\x60\x52\x6f\x86\x65\x33\x0b\x4d\xdd\xb9\x36\xe3\x98\xf0\x66\xea\x5c\x70\x68\x85\x3e\xe7\x38\x2d\x56\xe7\x76\x17\x81\x56\x67\x1b\x56\xf0\xe8\x8c\x83\x1d\x97\x95\x71\xb0\x7e\x1c\xde\x72\xe3\xc0\xf0\x8c\xde\xd6\xbd\x52\x20\x78\xea\xcd\x83\x3c\x7a\xb3\x33\x10\x7a\x56\x7b\x6d\x20\x24\x44\x3a\x13\x61\xc7\x65\x65\x22\x2c\x6b\x4a\x05\x93\x9d\x99\x88\x03\x0a\x91\x9f\xec\x8d\xd9\xb6\x14\x68\xec\xdd\xb3\xc1\x47\x29\xd0\xf8\x6e\x9b\xb5\xef\x0a\x34\x6a\x75\xc4\xbd\x40\x43\x2d\x1b\xd3\x89\xb1\x12\xfb\xb0\x82\x16\xc7\xc3\x6e\x23\xde\xe6\x44\xd8\x67\x39\x34\xaf\x41\xfd\x50\xf3\x33\x34\xa1\xbb\xd0\xea\x38\x4c\xcd\xf2\x1a\x56\xab\x7e\x89\xd6\x33\x7f\xad\x5d\x9e\x27\x3d\xd3\x50\x57\x37\xd7\x55\x1d\x75\x55\x47\x2d\xae\x3a\xaa\x2f\xe5\x30\x9d\xb7\xf4\x98\xfe\x86\x2f\x8b\xae\x14\xa9\x45\xa5\x48\xee\x05\x2c\x3e\xcf\xa1\x34\x85\x2b\x6b\xdf\xa2\xb3\x9e\x71\x8b\xea\x90\x2a\x95\x2a\xc5\x7c\x7c\x99\x31\x4c\x59\x0c\x2e\x49\xe3\xc9\x35\xa9\x4c\xa9\xe6\x69\x0a\x26\x1e\x6f\xd7\x6d\xbb\x42\x94\x26\x9c\x64\x4b\x46\x96\xa7\x23\x04\xcd\xcb\x55\x9c\x4a\x55\xfa\x4b\xbe\xb5\x45\x06\x8f\x9a\x06\xac\x2f\xf9\xa8\x3b\xd7\x49\xf0\xdd\x5e\x4c\xf2\x5f\xf1\xc0\xb2\x38\x7c\x03\xfe\x6f\x94\x3e\xd1\x97\x8e\x5c\xc8\x8f\x35\xbe\x6b\x94\xd2\x2b\x72\x58\xee\x9a\xa0\x9c\x34\x65\x3c\x5c\x9e\x76\xba\x23\x7e\xe5\xb1\xb6\x24\x76\xca\x1e\xd9\xe2\x41\xb4\x34\x17\xc7\xd0\xf1\x38\xc1\x8a\xf2\x18\xf1\xbb\x75\x39\xd0\x46\x82\x51\xd2\x6e\x25\x18\xc1\xa0\x25\x85\xe4\xc8\xf2\xe8\x96\x38\x5c\x36\x7c\xc4\xc2\xaa\xc0\xbd\x74\xa7\x52\xb6\x23\x6a\xe4\xb0\x58\x21\xa7\xc2\x1d\xb7\xa2\x9d\xa7\xae\xad\x79\x4c\xa3\x52\x77\x70\xa7\xe0\x6b\x6d\x08\xde\xaf\xb0\x23\xda\x1e\x88\x41\x70\x78\xe2\xb4\x43\x83\xa0\xad\x96\x7b\x63\xa9\x9a\x8d\xd5\xcd\xaf\x4a\xae\xdd\xca\x56\xcf\x68\x4f\x52\xd2\xc7\x26\x7f\xe1\x70\x72\xd2\xa5\x6f\x32\xf8\x48\x72\xf9\x45\xe9\xb5\xfd\xad\xcb\x54\x1b\x28\x5b\xfd\xad\x22\xab\x34\xba\x7b\x8e\xb5\x59\x46\xbc\xb5\xdf\x91\x31\x9c\x36\x69\xa5\x0c\x4f\xb4\x5e\x2d\x4e\xa7\xff\xbd\xad\x4b\xeb\x9e\x4e\xdf\xce\x47\xd1\x19\x1d\xe3\x14\x53\x9b\xcc\x48\x93\x5c\xbc\x6e\x3b\xea\x72\xf1\xad\xc8\xc5\xef\x7b\x46\xfc\xb9\x8e\x48\xe8\x32\xe2\x5d\x46\x5c\xdd\x42\xf9\x25\xf2\xb5\x8c\x38\x5c\x2d\xdf\x63\x53\x7f\x34\xdd\x5a\x00\x2d\x4a\x90\x67\xe4\x1e\x27\x79\x88\x86\x45\x61\x5a\xf1\xe5\xd0\xdc\x25\xf5\xab\x66\x06\x96\x09\x96\xa7\x29\x33\x71\x0e\x8d\x04\x6c\x03\x2e\x42\xdd\x9a\xb3\x91\x5f\x8d\xef\x9d\x99\x3f\x7a\xef\xcb\x1d\x45\x11\xce\x78\x1e\x0a\x47\xd6\xe1\x69\xc0\xcb\x09\x7e\x4d\x6f\x3e\x1d\x4e\xf0\xfb\x01\x8f\x28\x5b\x9c\x23\x5f\x1e\x2a\x2f\x91\x15\xfc\xff\xaf\x09\xff\x13\x05\x13\x86\x47\x60\x82\x39\xcf\xf2\x7f\x0c\x06\x63\xc2\x27\xf3\xe1\x51\x44\x67\x03\x3a\x2d\x4a\x87\x07\x82\x54\x52\xb2\xc1\x1d\x99\x92\xc1\xb5\xa0\xcf\x7b\xbf\x8e\xf9\x9f\x13\x9c\x64\x05\x9f\x01\x2a\xae\xfe\xe2\x33\xf8\xa2\x5a\x16\xb4\x69\x3a\xb7\xfa\x02\xb0\xe0\xd0\x85\xe4\x6d\x3b\xdd\x47\x57\xfe\xd8\xf9\xf6\xad\xf0\xed\xbb\x3a\x1b\x71\xd7\x23\xaa\x78\xae\x1c\x6a\x17\x55\x74\x51\x85\xba\x85\x5b\x54\x11\xe3\xc5\xb1\x21\x5d\x64\x71\xe8\x91\x45\x05\x0b\x5d\x74\xa1\xee\xa5\x3e\xba\x30\x7d\xbe\xea\x70\xa2\x8b\x6f\x69\xb0\xc2\x54\x19\x64\x74\xd1\xc5\x0b\x88\x2e\x9e\xe8\xd5\x14\xbf\x07\x48\x86\xea\xab\x27\x7e\xe0\x57\x9a\x51\x55\x39\x6b\x2b\x9e\x0d\x19\x3e\x4a\x2b\x07\xfe\x34\x4f\xfc\x8c\xe5\x72\xcf\xf9\xc4\x2f\x4b\x50\x84\x27\x34\x89\xc1\xf8\xec\x04\x31\x4e\x0c\x55\x22\x56\x8b\xd7\xcb\x3e\xb8\xdb\x05\x67\x7b\xa0\x58\x82\xca\x3c\xab\xa2\x37\x3f\x68\xdd\x0e\xb8\xbc\x14\xc9\x57\x89\x7c\x9e\x0c\xab\x5c\x81\x56\x68\xbf\x75\x00\x54\x89\x5b\xac\x4e\x39\x72\x49\x17\x29\x8e\x38\x80\xc1\x51\xa6\x8b\x46\x1c\xaa\x7b\x04\x1b\xe7\x94\xc6\x8e\xd2\x50\x3e\xe4\x7e\xe7\x0d\x54\x16\xd7\xe5\x6c\x2c\xfb\x53\x05\xe4\xaa\x38\x9c\x27\xf0\x82\x9c\x9e\x67\x4e\xa9\x2a\x74\x84\xcc\x76\xa2\x23\x97\xb3\x03\xd1\x11\x6b\x98\x4b\xc1\x1e\x26\xcc\x5b\xe8\xdb\x5b\xcb\xc4\x4f\x1e\x5e\x2a\xff\xd8\x05\x65\xaa\xef\xd7\xb4\xc3\x6d\xb0\xfb\x36\xcd\x5e\x79\x1b\xba\xb7\x63\xd4\x36\x54\x1c\x9c\x14\x25\xd4\x5d\x3f\xf7\xd0\x66\xfa\xad\xbe\x8a\xd4\xf1\xd8\x23\x7b\x6b\x2d\x96\xe2\x30\x8d\x75\x0b\x2b\xaf\x9f\xcd\x58\xef\xa4\xd0\x7b\x8f\x82\x6e\x4d\xda\xa4\x3e\xe8\xde\x9c\xe3\xda\xcd\xd5\x8d\x93\x81\x78\xbb\x76\x84\x22\x7c\xf6\xcb\xff\x02\x00\x00\xff\xff\xc5\xef\x02\x0a\xe1\xac\x00\x00")
-
-func assetsConfigGlade2Bytes() ([]byte, error) {
-	return bindataRead(
-		_assetsConfigGlade2,
-		"assets/config.glade~",
-	)
-}
-
-func assetsConfigGlade2() (*asset, error) {
-	bytes, err := assetsConfigGlade2Bytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "assets/config.glade~", size: 44257, mode: os.FileMode(436), modTime: time.Unix(1517508029, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _assetsProxyGlade = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xec\x5d\x5f\x8f\xdb\x36\x12\x7f\xf7\xa7\xe0\xe9\xa1\xb8\xcb\xc5\xf6\x7a\xb7\x49\xb6\xc9\xae\x8b\x16\xd7\x04\x01\x7a\xbd\x00\x9b\xde\x3d\x0a\xb4\x34\x96\x58\xd3\xa2\x4e\xa4\xfc\xe7\xdb\x97\xfa\x63\xaf\x6c\x91\x12\x25\xd9\x59\xdb\x35\x02\x24\x92\xc5\x19\x92\x33\xbf\x19\xce\x50\xd4\xe4\xe1\xc7\xd5\x9c\xa2\x05\x44\x9c\xb0\xe0\xd1\x1a\x0d\x6e\x2c\x04\x81\xc3\x5c\x12\x78\x8f\xd6\xef\x5f\x3f\xf6\xef\xad\x1f\xc7\xbd\x87\xbf\xf5\xfb\xe8\x13\x04\x10\x61\x01\x2e\x5a\x12\xe1\x23\x8f\x62\x17\xd0\xdd\x60\x74\x3f\xb8\x43\xfd\xbe\x6c\x44\x02\x01\xd1\x14\x3b\x80\x5c\x36\xc7\x44\xf2\x0b\x23\xb6\x5a\x3b\x3e\x0e\x3c\x88\xac\x71\x0f\xa1\x87\x08\xfe\x1f\x93\x08\x38\xa2\x64\xf2\x68\x79\x62\xf6\x4f\xeb\xb9\x7b\xc9\xec\xd6\x1a\xa6\xed\xd8\xe4\x0f\x70\x04\x72\x28\xe6\xfc\xd1\xfa\x24\x66\x3f\xb9\x7f\xc4\x5c\xcc\x21\x10\x16\x22\xee\xa3\x85\xb7\xf7\xa3\x94\xb3\xa4\x91\xbd\x85\x10\x89\x35\x0a\xf0\x1c\x1e\x2d\xca\x96\x49\xaf\xa3\x87\xe1\xe6\x81\xba\x5d\x1c\x86\x49\xbb\xb7\x6f\xde\xdc\xbd\xa9\x6b\xbb\xc0\x34\x06\x6b\x7c\x7f\x73\x7f\x53\xd7\x94\x0b\x08\x6d\x12\x38\x11\xa4\x83\xae\x1f\x47\x88\x3d\xd8\x21\xd8\xeb\xe2\x61\x98\x09\x45\x2d\x9f\xaf\xb0\x12\x3f\xc7\xd3\xa9\x9c\x4a\x2a\x1f\x21\xef\x27\xe9\xbd\x9d\x6a\xc1\xc6\x8e\x20\x0b\xa9\x3e\x9b\x3b\x11\x09\xc5\x46\x68\x9c\x78\x01\xa6\xf9\x10\x32\x55\xb9\x16\x92\xff\xba\x14\xa2\x47\x8b\x05\xb6\x9e\xd3\xb6\x39\x5f\x62\x29\x44\xd9\x6b\xc0\x72\xfd\x55\x8e\xf5\x5f\x04\x53\xe6\x65\xe3\x74\xd3\xeb\x8c\xb3\x46\x91\x0e\x0e\xec\x29\x73\x62\x6e\x8d\x3f\x62\xca\xa1\x4e\x90\x62\x1d\x82\xed\x93\x44\x86\x19\xf7\x12\x81\xe3\x13\xea\xa2\x14\xaf\x72\xf2\xfd\xf4\x56\x2a\x77\xc2\x56\xf9\x10\x54\xa3\xfe\x59\x3e\x2d\x0c\xb9\x9f\x34\x1f\x6d\xdb\x37\x1f\xb6\x8a\x66\x8e\x23\x8f\x04\x36\x85\xa9\x1c\x7c\x09\x8d\x15\x14\x11\xf1\xfc\x86\x24\x82\x85\xcd\x08\x26\x4c\x08\x36\x37\xa4\x61\x11\x91\x28\xc6\x42\x1a\xb6\x35\x96\x16\x2e\x88\x83\xa9\x09\x21\x0f\xb1\x23\xbd\x8f\xae\x1b\xb5\xea\x12\x48\x4a\xa8\xe2\x08\x70\x41\x23\x4a\x2d\xc6\x72\x12\xc1\xbe\x2e\x0b\xf4\xa3\x1d\x06\xed\xd4\xaa\x74\x47\x78\xcd\x62\x61\x73\xb1\xa6\xd2\x83\x40\xe0\x6a\x09\xd3\x39\xed\xfe\xa6\x9f\x49\x36\x8d\x49\x7a\x6d\xb3\x99\xb5\x4f\xa7\x1a\xc8\x04\xa8\x35\x96\xbe\xb7\xcf\x66\xba\x41\xa8\x08\x17\x84\x93\x49\x32\xf8\xaf\x51\xac\x9d\x76\x8d\xc8\x9a\x92\x46\xe0\x00\x59\x00\xb7\x5d\x98\xe2\x98\x8a\xe6\x1c\x62\x2e\x5d\x9e\x60\xce\xcc\x80\x74\xd7\x19\x52\xe2\xcc\xf6\x9d\xe1\x56\xd0\xf6\xf6\x71\xc9\xf9\xed\xb0\x2c\x38\xc2\xdd\x51\x62\x67\x26\x61\x5e\x3f\x7e\x58\x85\xb2\xff\xe6\xf3\x9e\x12\x4a\x9b\x53\x85\x8c\x93\xcc\x66\x4b\x0b\x5c\x71\x52\xca\xd1\x3f\x0c\x15\xc8\xed\x80\x66\x09\x1b\x27\x41\x6a\x13\x44\x67\x34\x57\x54\x17\x49\x8d\x51\x9d\x09\xef\xe2\x91\x5d\x8a\xc4\x8a\x93\x32\x45\xb6\x6a\xfa\xea\xa9\xeb\xa6\xdd\x68\xf5\xc8\xe6\xdc\x88\xa4\xd6\x94\x15\x93\x2d\x4d\xb4\x6c\xbe\x65\xd3\xfd\x8d\x09\x98\x30\xb9\xf6\xa4\xc6\x1b\xe4\x77\x75\xeb\xa8\x91\xdd\xb5\xb4\xb9\x7d\x32\xdf\x04\x6a\xa5\x01\x1a\x11\x99\xba\xb7\x4d\xc0\xb1\x17\x34\x76\x92\x4e\x8d\x84\x2a\xd1\xa2\xa2\x35\x8e\xd9\xaa\x67\xaf\x96\xc0\xc7\x48\xf6\x91\xc9\x60\x9a\x5c\xaa\xa4\xd0\x49\x12\x5d\xa5\xa1\xa2\xaf\x8f\xc7\x0d\xa8\xab\x62\x73\x03\x72\x7d\x9c\x6e\x40\x5c\x19\xb3\x57\xd1\x1b\x99\x8c\x56\x67\x5d\x88\xd3\xd5\xdc\x5e\x61\x2a\x97\xad\xca\x30\x44\x4d\xce\x7d\xec\xb2\xa5\x9d\x24\x81\xd6\x98\x04\xb5\xe4\x5a\x08\xa7\x4f\x4b\x30\xfe\x14\x11\x37\x43\xb1\x27\xaf\x74\x20\x56\x0a\xa5\x09\x90\x55\x0c\x9a\x82\x59\xc5\xa3\x19\xa0\x2b\x38\x98\x82\xba\x82\x85\x19\xb0\x2b\x18\x18\x83\x5b\xc5\x23\x92\x20\xa9\xce\x35\xeb\x38\x38\x8c\xc6\xf3\xa0\x29\x93\x4a\xbc\xa5\x2d\x4a\x98\xfb\x35\x8d\x6f\x53\xd0\xa5\xc6\x71\x57\x81\x3a\xd5\x40\x1b\x23\x4f\x39\xdb\x16\xe8\x53\xf1\xf1\x73\xc3\xae\xc8\x80\x6b\xe7\x93\xb3\xe0\x02\x47\xa2\x2d\x93\x2c\x69\x40\x22\xc2\x01\xa7\x58\x60\x29\xa0\x47\x6b\x0d\x72\x7a\x4f\x3e\x8b\x44\xda\xca\x8c\xb7\x2e\x12\xde\xed\x5d\x17\x15\x57\x8e\x51\x9a\xa9\x8d\x85\xc0\x8e\x6f\xe0\x09\x75\x5c\xa4\x9d\x35\x65\xa2\x89\x83\x77\x9b\xd4\x20\xb9\x0d\xd2\x7f\x09\x44\xb4\xce\x90\x0e\xc9\xa5\xcd\x69\xec\xbd\x30\xda\xbb\xb0\x69\xb4\x8a\xea\x98\x84\x14\x3b\xe0\x33\xea\x42\x94\x6e\xc6\xd6\x80\xf6\x35\xa2\x80\x17\x80\x60\x1e\x4a\x16\x82\x21\x1c\x0b\xe6\xe5\x9b\xf7\xc6\x63\xd8\x49\x1a\x37\x3b\xbe\xdd\xf6\x42\x76\xf8\x7f\x1b\x9b\xa9\x48\xf5\xaa\xb9\x9c\x91\xcd\xec\xaf\x0e\xdf\x5f\x57\x87\x63\xae\x0e\xbf\x5d\xe4\xba\x60\x68\x28\x27\xb3\x2e\x24\x33\xb8\xae\x0b\x06\xeb\x82\x39\x5c\xaf\x4e\xff\x8c\x0c\x62\xdf\xe9\xbf\xb9\x3a\xfd\x63\x3a\xfd\x9f\x5c\x37\x02\xce\x2f\xcf\xef\xdf\x9e\x17\xcc\xdf\x5e\x61\x7e\x4c\x98\x7f\x61\xa6\x5c\xcf\x09\xe3\x77\xe7\x85\xf1\x77\x57\x8c\x1f\x13\xe3\xbf\xf3\xe4\xe4\xc8\x25\xc6\xf0\xdf\x9f\x17\xce\xef\xaf\x38\x3f\xaa\x2f\x97\x72\x5f\xb2\xc8\x70\x78\xe7\x84\x73\xa3\x7d\xf6\xd3\xc1\xf9\x0f\x57\x9c\x1f\x13\xe7\xbf\xac\x1c\x08\x93\x57\xd6\x17\x18\x9d\xbf\x3d\x6d\xa4\x97\x76\x65\x70\x96\x26\x5d\x37\x66\x0c\x36\x66\x3e\x7f\x41\x2c\x42\x3e\xe3\xc2\x3c\x1a\xb9\x6e\xd1\x9c\x51\xee\x5a\xb2\x8e\x38\x8f\x3c\xaf\xe6\x61\x60\x1e\x9b\x30\xfd\x35\x22\x53\x84\x83\xf5\xd5\x40\xcc\xb8\x9c\x51\x42\xf0\x14\x92\xa0\x78\xec\x97\xcb\xfb\x5c\x35\x21\x8b\xc4\x5f\xdd\x4c\x9e\xbf\x70\xb2\xc6\x85\xaf\x9d\x4e\x2a\xca\x39\x08\x4c\x4f\x7c\x7f\xe6\xc9\x89\x18\xa5\xe0\xfe\x8f\x04\x2e\x5b\xe6\x50\xcd\x7f\x5b\xa6\xbf\x55\x9d\x02\x53\x4d\xfe\xe2\xa0\xda\xe8\xbc\x9f\x8e\x49\xc3\x83\x7b\x5b\x36\xb5\x2a\x4d\x5b\x29\x3f\x98\xfb\x2f\x81\xe5\xf3\xe7\x72\x0b\x79\x67\xc3\x36\x9d\xa8\x51\xaa\x52\x0e\x6d\x14\xab\x62\xd4\x52\xb9\x2a\x56\x82\x31\x2a\x48\xa8\x5f\x69\x7f\x25\x5c\x20\x36\x45\xcf\x33\x7f\x8d\x58\x00\x48\x32\x41\x94\x04\xf0\x01\x4d\x65\xa0\x0a\x2b\x3c\x0f\x29\xbc\xef\xf5\x46\xb7\xef\x06\xaf\xd2\xbf\x6f\xe4\x9f\xd1\xf0\xbe\x97\x3f\x1b\x38\x6c\xde\x7b\x35\x28\xdc\x75\x19\x77\x48\x56\x40\xb9\x8d\x27\x6c\x01\x76\x32\x0e\x6e\x9c\xf9\x57\x32\x94\x79\xa4\x44\xd9\x21\x18\xa6\x9e\x30\x3b\x8a\xd8\x91\x53\x7a\x98\xf2\x30\xac\xb0\x93\x68\x91\xdb\x52\xc3\x0d\x53\x7f\xb3\x45\xc3\xc0\xc9\x9e\xd7\xea\x73\xe2\x39\xf6\xfe\x6e\xd2\xe8\xe6\xba\x9d\x74\xcc\xed\xa4\x7f\x63\x21\xb5\x14\x78\xe8\xf3\x97\x0b\xdc\x50\x7a\x77\xda\x60\x2f\xa5\xcc\xf3\x5c\x1b\x36\x09\xcf\x79\x57\xc9\x78\x0d\x9e\x17\xd0\xf7\x1a\x71\x08\x71\x56\x71\x61\xb2\x46\x72\x39\x9d\x63\x3e\x40\x9f\xd3\x74\x58\x36\x40\x24\x40\xc2\x87\xe4\x41\x18\x0b\xb9\x58\xa7\xc4\xc0\x37\x8f\xa9\xe4\x29\x49\x7d\x88\x64\x12\x2d\x7c\xc2\x51\x98\x7c\x64\x8f\x96\x84\x52\x34\x01\x49\x06\x28\xcd\x8f\x61\xd0\xeb\xc9\xfe\x90\x9c\xac\xfc\x1d\x01\x0f\xc1\x21\x53\x02\xee\xa6\x87\xa9\x8c\x75\xd9\x32\x19\x96\x8c\x04\x64\x2f\xfc\x7d\x6f\xf4\xc3\xed\x60\xf4\xf6\x7e\x30\x92\x01\xc0\xdd\x6d\xe1\xf6\x66\x78\x6b\x98\x76\xbe\xe4\xee\x42\x73\x2b\xbf\xee\x2c\x9c\x91\x1f\x29\x7e\x6d\x77\x7b\x56\xeb\x65\xcb\x64\xa6\xe4\x38\xc3\xfc\xed\xdf\x85\x24\x31\xad\x3c\x83\x76\x72\x84\x12\xb1\x6e\x1c\x1d\x97\x99\x91\x20\x97\x55\x52\x09\x25\xb2\xc6\xaf\x3a\xa5\x27\x26\x7e\x6b\xf3\x52\xb7\xe9\xae\x68\xda\xe1\x91\xfd\x57\xda\x87\x51\x06\x61\xea\xc7\x54\x62\x32\xfa\x7a\xda\x84\x91\xd9\x57\xe4\x75\x5c\x8c\xea\x25\x94\x98\xd4\xbb\xc5\xac\x99\x81\x37\x68\xbd\xfd\xc1\x3c\x8f\x42\x71\xfb\x55\xa4\xbf\xe4\x08\xe0\xbe\x4c\x92\x2f\xcc\x89\x34\xaf\xba\x50\xc7\xb1\x36\xae\x7b\x92\x62\x1c\xfa\xc4\x05\x14\x36\x3a\x8e\x91\x77\xb7\x63\xb1\x99\x7e\xf6\x8b\x33\x69\x95\x66\x6f\xdb\x37\xb2\x60\x53\x44\xa5\x2d\x4b\xa8\xfa\x3c\xc7\x5e\xfe\x91\x37\x49\x2e\xeb\x76\x46\x75\x82\x6d\x0d\x1f\x15\xb3\xb6\x8b\xb1\x8e\x5f\x5e\x77\x23\x29\x37\x12\x41\xf2\x99\x7c\x5f\xb0\x3e\xc7\x0b\x68\xa4\x5c\x73\x67\x69\xe8\x09\xfe\xd2\xde\xd7\x38\xaa\x3d\xa4\xf7\x3d\xa7\x88\xfd\x9b\x1d\x9a\xaa\x16\x4a\x25\x71\x5e\xdc\x2b\x79\x03\xb0\x2d\xea\xa3\xeb\xa5\x76\xa3\xac\x2a\xee\xef\xec\x6e\x0e\xe1\x62\xf6\x79\x48\xd3\x72\x5b\x7e\x89\xae\xdf\xce\xca\x8a\x36\x56\x16\xf1\xc8\x59\xb6\x55\x5b\x15\x61\x35\xf8\x3b\x39\x97\xf6\x0e\xa5\x75\x08\x57\x61\x1b\x1a\xf9\xa8\x65\x53\x51\x27\x2b\xc7\x7e\xba\x79\xbf\xcf\xab\x7e\x6b\xf8\x54\xeb\xca\x74\x80\x67\xf7\x2a\x53\xb2\x3b\xdb\xa0\x7a\x92\x56\xbd\x9d\xaa\x9a\xed\x17\xbd\x51\x39\xa5\x93\x50\x91\x79\x5d\x10\x0d\xa5\x49\x3d\x10\x0d\x69\x7d\x1d\x10\x0d\xa1\x51\xfd\x8f\xd6\x5b\x09\xad\x5f\x72\x2b\xe1\x6f\x56\xd0\xa6\xc3\x3b\xf1\x26\xb5\x98\xea\x8f\x34\xe8\x56\xcf\x4e\x2b\x67\xc7\xdc\xae\x3d\x66\x2b\xa8\xcf\xb2\x38\xd3\x91\x6b\x1c\x69\x8e\x46\x84\x15\x75\x84\x4d\x46\x7d\xc8\x28\xab\x2d\x0b\xd5\xa9\x02\xa3\xf3\x47\x26\xa7\x09\x5a\x31\xda\x39\x45\xd0\x8a\xc3\xee\xe9\x81\x56\x2c\xb2\xfa\xce\xd6\xb8\xae\x6a\xf4\x8b\xc4\x91\x5a\x22\xc3\x7c\xc1\x20\x57\xd0\xbd\x54\x3f\x96\xbb\x6b\x15\xe2\x1a\x26\x08\xe6\xd1\xd7\x7f\x82\xfc\x95\xe0\x46\xcb\x28\xd3\x32\xfa\xfb\x77\x54\x7c\xc0\xc8\x8f\x60\x2a\x17\x4c\x21\x42\xfe\x7e\x38\xf4\x88\xf0\xe3\x49\x72\xac\x67\xc8\x66\x40\x41\x0c\xc3\x42\x79\xf7\xe1\x92\xcc\xc8\xf0\x29\xa5\xe7\xd6\x77\x9e\xf8\xe0\x03\x0d\x13\x3e\x43\x9c\xdc\xfd\xa3\xe9\xb0\x93\x0a\xa7\x12\xd3\xb3\x38\x34\x91\x76\x63\xf4\x74\x0f\x2c\x0f\x5c\x50\xf4\x30\x29\xc0\x19\xe6\x00\x4f\x35\x9e\xe5\x1b\x69\xea\x65\x33\x87\xc2\xdb\x9f\xe1\x41\xa0\xd2\x8a\xe1\x89\x0d\xeb\x45\x6a\xec\x36\x29\x11\x5b\x0b\x2c\x05\x32\xf6\xe6\xb9\x3b\xc7\xc2\xc3\xe7\x07\x0f\xc3\xed\xff\xac\x31\xee\xfd\x19\x00\x00\xff\xff\x50\x8d\x21\x2a\xb2\x63\x00\x00")
-
-func assetsProxyGladeBytes() ([]byte, error) {
-	return bindataRead(
-		_assetsProxyGlade,
-		"assets/proxy.glade",
-	)
-}
-
-func assetsProxyGlade() (*asset, error) {
-	bytes, err := assetsProxyGladeBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "assets/proxy.glade", size: 25522, mode: os.FileMode(436), modTime: time.Unix(1517005512, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _assetsProxyGlade2 = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xec\x5d\x5f\x8f\xdb\x36\x12\x7f\xf7\xa7\xe0\xe9\xa1\xb8\xcb\xc5\xf6\x7a\xb7\x49\xb6\xe9\xae\x8b\x16\xd7\x04\x01\x7a\xbd\x00\x9b\xde\x3d\x0a\xb4\x44\x4b\xac\x69\x51\x27\x52\xfe\xf3\xed\x3b\xfa\x63\xaf\x6c\x91\x12\x25\xd9\x59\xdb\x31\x02\x24\x92\xc5\x19\x92\x33\xbf\x19\xce\x50\xd4\xe4\xe1\xa7\xd5\x9c\xa1\x05\x89\x04\xe5\xc1\xa3\x35\x1a\xdc\x58\x88\x04\x0e\x77\x69\xe0\x3d\x5a\x7f\x7c\xf9\xd0\xbf\xb7\x7e\x1a\xf7\x1e\xfe\xd6\xef\xa3\x8f\x24\x20\x11\x96\xc4\x45\x4b\x2a\x7d\xe4\x31\xec\x12\x74\x37\x18\xdd\x0f\xee\x50\xbf\x0f\x8d\x68\x20\x49\x34\xc5\x0e\x41\x2e\x9f\x63\x0a\xfc\xc2\x88\xaf\xd6\x8e\x8f\x03\x8f\x44\xd6\xb8\x87\xd0\x43\x44\xfe\x1f\xd3\x88\x08\xc4\xe8\xe4\xd1\xf2\xe4\xec\x9f\xd6\x73\xf7\xc0\xec\xd6\x1a\xa6\xed\xf8\xe4\x4f\xe2\x48\xe4\x30\x2c\xc4\xa3\xf5\x51\xce\x7e\x76\xff\x8c\x85\x9c\x93\x40\x5a\x88\xba\x8f\x16\xde\xde\x8f\x52\xce\x40\x03\xbd\x85\x24\x92\x6b\x14\xe0\x39\x79\xb4\x18\x5f\x26\xbd\x8e\x1e\x86\x9b\x07\xea\x76\x71\x18\x26\xed\xde\xbe\x79\x73\xf7\xa6\xae\xed\x02\xb3\x98\x58\xe3\xfb\x9b\xfb\x9b\xba\xa6\x42\x92\xd0\xa6\x81\x13\x91\x74\xd0\xf5\xe3\x08\xb1\x47\x76\x08\xf6\xba\x78\x18\x66\x42\x51\xcb\xe7\x0b\x59\xc9\x5f\xe2\xe9\x14\xa6\x92\xca\x47\xc2\xfd\x24\xbd\xb7\x53\x2d\xd8\xd8\x91\x74\x01\xea\xb3\x85\x13\xd1\x50\x6e\x84\x26\xa8\x17\x60\x96\x0f\x21\x53\x95\x6b\x21\xf8\xd7\x65\x24\x7a\xb4\x78\x60\xeb\x39\x6d\x9b\x8b\x25\x06\x21\x42\xaf\x01\xcf\xf5\x57\x39\xd6\x7f\x51\xcc\xb8\x97\x8d\xd3\x4d\xaf\x33\xce\x1a\x45\x3a\x38\xb0\xa7\xdc\x89\x85\x35\xfe\x80\x99\x20\x75\x82\x94\xeb\x90\xd8\x3e\x4d\x64\x98\x71\x2f\x11\x38\x3e\x65\x2e\x4a\xf1\x0a\x93\xef\xa7\xb7\xa0\xdc\x09\x5f\xe5\x43\x50\x8d\xfa\x17\x78\x5a\x18\x72\x3f\x69\x3e\xda\xb6\x6f\x3e\x6c\x15\xcd\x1c\x47\x1e\x0d\x6c\x46\xa6\x30\xf8\x12\x1a\x2b\x28\x22\xea\xf9\x0d\x49\x24\x0f\x9b\x11\x4c\xb8\x94\x7c\x6e\x48\xc3\x23\x0a\x28\xc6\x12\x0c\xdb\x1a\x83\x85\x4b\xea\x60\x66\x42\x28\x42\xec\x80\xf7\xd1\x75\xa3\x56\x5d\x02\x49\x80\x2a\x8e\x08\x2e\x68\x44\xa9\xc5\x18\x26\x11\xec\xeb\xb2\x40\x3f\xda\x61\xd0\x4e\xad\x4a\x77\x84\xd7\x3c\x96\xb6\x90\x6b\x06\x1e\x84\x04\xae\x96\x30\x9d\xd3\xee\x6f\xfa\x99\x64\xd3\x98\xa4\xd7\x36\x9f\x59\xfb\x74\xaa\x81\x4c\x08\xb3\xc6\xe0\x7b\xfb\x7c\xa6\x1b\x84\x8a\x70\x41\x05\x9d\x24\x83\xff\x12\xc5\xda\x69\xd7\x88\xac\x29\x69\x44\x1c\x42\x17\x44\xd8\x2e\x99\xe2\x98\xc9\xe6\x1c\x62\x01\x2e\x4f\x72\x67\x66\x40\xba\xeb\x0c\x19\x75\x66\xfb\xce\x70\x2b\x68\x7b\xfb\xb8\xe4\xfc\x76\x58\x16\x1c\xe1\xee\x28\xb1\x33\x03\x98\xd7\x8f\x9f\xac\x42\xe8\xbf\xf9\xbc\xa7\x94\xb1\xe6\x54\x21\x17\x34\xb3\xd9\xd2\x02\x57\x9c\x94\x72\xf4\x0f\x43\x05\x72\x3b\xa0\x19\x60\xe3\x24\x48\x6d\x82\xe8\x8c\xe6\x8a\xea\x22\xa9\x31\xaa\x33\xe1\x5d\x3c\xb2\x4b\x91\x58\x71\x52\xa6\xc8\x56\x4d\x5f\x3d\x75\xdd\xb4\x1b\xad\x1e\xd9\x9c\x1b\x91\xd4\x9a\xb2\x62\xb2\xa5\x89\x96\xcd\xb7\x6c\xba\xbf\x73\x49\x26\x1c\xd6\x9e\xd4\x78\x83\xfc\xae\x6e\x1d\x35\xb2\xbb\x96\x36\xb7\x4f\xe6\x9b\x40\xad\x34\x40\x23\x22\x53\xf7\xb6\x09\x38\xf6\x82\xc6\x4e\xd2\xa9\x91\x50\x25\x5a\x54\xb4\xc6\x31\x5b\xf5\xec\xd5\x12\xf8\x10\x41\x1f\x99\x0c\xa6\xc9\xa5\x4a\x0a\x9d\x24\xd1\x55\x1a\x2a\xfa\xfa\x78\xdc\x80\xba\x2a\x36\x37\x20\xd7\xc7\xe9\x06\xc4\x95\x31\x7b\x15\xbd\x91\xc9\x68\x75\xd6\x85\x38\x5d\xcd\xed\x15\x66\xb0\x6c\x55\x86\x21\x6a\x72\xe1\x63\x97\x2f\xed\x24\x09\xb4\xc6\x34\xa8\x25\xd7\x42\x38\x7d\x5a\x82\xf1\xc7\x88\xba\x19\x8a\x3d\xb8\xd2\x81\x58\x29\x94\x26\x40\x56\x31\x68\x0a\x66\x15\x8f\x66\x80\xae\xe0\x60\x0a\xea\x0a\x16\x66\xc0\xae\x60\x60\x0c\x6e\x15\x8f\x08\x40\x52\x9d\x6b\xd6\x71\x70\x38\x8b\xe7\x41\x53\x26\x95\x78\x4b\x5b\x94\x30\xf7\x5b\x1a\xdf\xa6\xa0\x4b\x8d\xe3\xae\x02\x75\xaa\x81\x36\x46\x9e\x72\xb6\x2d\xd0\xa7\xe2\xe3\xe7\x86\x5d\x91\x01\xd7\xce\x27\x67\x21\x24\x8e\x64\x5b\x26\x59\xd2\x80\x64\x84\x03\xc1\xb0\xc4\x20\xa0\x47\x6b\x4d\x60\x7a\x4f\x3e\x8f\x64\xda\xca\x8c\xb7\x2e\x12\xde\xed\x5d\x17\x15\x57\x8e\x11\xcc\xd4\xc6\x52\x62\xc7\x37\xf0\x84\x3a\x2e\x60\x67\x4d\x99\x68\xe2\xe0\xdd\x26\x35\x48\x6e\x83\xf4\x5f\x03\x19\xad\x33\xa4\x93\xe4\xd2\x16\x2c\xf6\x5e\x18\xed\x5d\xd8\x34\x5a\x45\x75\x4c\x42\x86\x1d\xe2\x73\xe6\x92\x28\xdd\x8c\xad\x01\xed\x6b\xc4\x08\x5e\x10\x44\xe6\x21\xb0\x90\x1c\xe1\x58\x72\x2f\xdf\xbc\x37\x1e\xc3\x4e\xd2\xb8\xd9\xf1\xed\xb6\x17\xb2\xc3\xff\xeb\xd8\x4c\x45\xaa\x57\xcd\xe5\x8c\x6c\x66\x7f\x75\xf8\xfe\xba\x3a\x1c\x73\x75\xf8\xfd\x22\xd7\x05\x43\x43\x39\x99\x75\x21\x99\xc1\x75\x5d\x30\x58\x17\xcc\xe1\x7a\x75\xfa\x67\x64\x10\xfb\x4e\xff\xcd\xd5\xe9\x1f\xd3\xe9\xff\xec\xba\x11\x11\xe2\xf2\xfc\xfe\xed\x79\xc1\xfc\xed\x15\xe6\xc7\x84\xf9\x67\x6e\xca\xf5\x9c\x30\x7e\x77\x5e\x18\x7f\x77\xc5\xf8\x31\x31\xfe\x87\x48\x4e\x8e\x5c\x62\x0c\xff\xfd\x79\xe1\xfc\xfe\x8a\xf3\xa3\xfa\x72\x90\xfb\x92\x47\x86\xc3\x3b\x27\x9c\x1b\xed\xb3\x9f\x0e\xce\x7f\xb8\xe2\xfc\x98\x38\xff\x75\xe5\x90\x30\x79\x65\x7d\x81\xd1\xf9\xdb\xd3\x46\x7a\x69\x57\x06\x67\x69\xd2\x75\x63\xc6\x60\x63\xe6\xd3\x67\xc4\x23\xe4\x73\x21\xcd\xa3\x91\xeb\x16\xcd\x19\xe5\xae\x25\xeb\x88\xf3\xc8\xf3\x6a\x1e\x06\xe6\xb1\x09\xd3\x5f\x23\x3a\x45\x38\x58\x5f\x0d\xc4\x8c\xcb\x19\x25\x04\x4f\x21\x0d\x8a\xc7\x7e\x05\xdc\xe7\xaa\x09\x79\x24\xbf\x75\x33\x79\xfe\xc2\xc9\x1a\x17\xbe\x76\x3a\xa9\x28\xe7\x20\x30\x3d\xf1\xfd\x99\x27\x27\xe2\x8c\x11\xf7\x7f\x34\x70\xf9\x32\x87\x6a\xfe\xdb\x32\xfd\xad\xea\x14\x98\x6a\xf2\x17\x07\xd5\x46\xe7\xfd\x74\x4c\x1a\x1e\xdc\xdb\xb2\xa9\x55\x69\xda\x4a\xf9\xc1\xdc\x7f\x29\x59\x3e\x7f\x2e\xb7\x80\x3b\x9b\x6c\xd3\x89\x1a\xa5\x2a\xe5\xd0\x46\xb1\x2a\x46\x2d\x95\xab\x62\x25\x39\x67\x92\x86\xfa\x95\xf6\x37\x2a\x24\xe2\x53\xf4\x3c\xf3\xd7\x88\x07\x04\x01\x13\xc4\x68\x40\x7e\x44\x53\x08\x54\xc9\x0a\xcf\x43\x46\xde\xf7\x7a\xa3\xdb\x77\x83\x57\xe9\xdf\x37\xf0\x67\x34\xbc\xef\xe5\xcf\x06\x0e\x9f\xf7\x5e\x0d\x0a\x77\x5d\xc6\x1d\xd2\x15\x61\xc2\xc6\x13\xbe\x20\x76\x32\x0e\x61\x9c\xf9\x57\x32\x84\x3c\x12\x50\x76\x08\x86\xa9\x27\xcc\x8e\x22\x76\xe4\x94\x1e\xa6\x3c\x0c\x2b\xec\x24\x5a\x14\x36\x68\xb8\x61\xea\x6f\xb6\x68\x18\x38\xd9\xf3\x5a\x7d\x4e\x3c\xc7\xde\xdf\x4d\x1a\xdd\x5c\xb7\x93\x8e\xb9\x9d\xf4\x6f\x2c\x41\x4b\x81\x87\x3e\x7d\xbe\xc0\x0d\xa5\x77\xa7\x0d\xf6\x52\xca\x3c\xcf\xb5\x61\xd3\xf0\x9c\x77\x95\x8c\xd7\xe0\x79\x01\x7d\xaf\x91\x20\x21\xce\x2a\x2e\x4c\xd6\x08\x96\xd3\x39\x16\x03\xf4\x29\x4d\x87\xa1\x01\xa2\x01\x92\x3e\x49\x1e\x84\xb1\x84\xc5\x3a\x25\x26\x62\xf3\x98\x01\x4f\x20\xf5\x49\x04\x49\xb4\xf4\xa9\x40\x61\xf2\x91\x3d\x5a\x52\xc6\xd0\x84\x00\x19\x41\x69\x7e\x4c\x06\xbd\x1e\xf4\x87\x60\xb2\xf0\x3b\x22\x22\x24\x0e\x9d\x52\xe2\x6e\x7a\x98\x42\xac\xcb\x97\xc9\xb0\x20\x12\x80\x5e\xc4\xfb\xde\xe8\x87\xdb\xc1\xe8\xed\xfd\x60\x04\x01\xc0\xdd\x6d\xe1\xf6\x66\x78\x6b\x98\x76\xbe\xe4\xee\x42\x73\x2b\xbf\xee\x2c\x9c\x91\x1f\x29\x7e\x6d\x77\x7b\x56\xeb\x65\xcb\x64\xa6\xe4\x38\xc3\xfc\xed\xdf\x85\x24\x31\xad\x3c\x83\x76\x72\x94\x51\xb9\x6e\x1c\x1d\x97\x99\xd1\x20\x97\x55\x52\x09\x25\xb2\xc6\xaf\x3a\xa5\x27\x26\x7e\x6b\xf3\x52\xb7\xe9\xae\x68\xda\xe1\x91\xfd\x57\xda\x87\x51\x06\x61\xea\xc7\x54\x62\x32\xfa\x7a\xda\x84\x91\xd9\x57\xe4\x75\x5c\x8c\xea\x25\x94\x98\xd4\xbb\xc5\xac\x99\x81\x37\x68\xbd\xfd\xc1\x3d\x8f\x91\xe2\xf6\xab\x4c\x7f\xc9\x11\x20\x7c\x48\x92\x2f\xcc\x89\x34\xaf\xba\x50\xc7\xb1\x36\xae\x7b\x02\x31\x0e\x7d\xea\x12\x14\x36\x3a\x8e\x91\x77\xb7\x63\xb1\x99\x7e\xf6\x8b\x33\x69\x95\x66\x6f\xdb\x37\xb2\x60\x53\x44\xa5\x2d\x4b\xa8\xfa\x34\xc7\x5e\xfe\x91\x37\x4d\x2e\xeb\x76\x46\x75\x82\x6d\x0d\x1f\x15\xb3\xb6\x8b\xb1\x8e\x5f\x5e\x77\x23\x29\x37\x12\x91\xe4\x33\xf9\xbe\xe4\x7d\x81\x17\xa4\x91\x72\xcd\x9d\xa5\xa1\x27\xf8\xa6\xbd\xaf\x71\x54\x7b\x48\xef\x7b\x4e\x11\xfb\x57\x3b\x34\x55\x2d\x94\x4a\xe2\xbc\xb8\x57\xf2\x06\x60\x5b\xd4\x47\xd7\x4b\xed\x46\x59\x55\xdc\xdf\xd9\xdd\x1c\xc2\xc5\xec\xf3\x00\xd3\x72\x5b\x7e\x89\xae\xdf\xce\xca\x8a\x36\x56\x16\xf1\xc8\x59\xb6\x55\x5b\x15\x61\x35\xf8\x3b\x39\x97\xf6\x0e\xa5\x75\x08\x57\x61\x1b\x1a\xf9\xa8\x65\x53\x51\x27\x2b\xc7\x7e\xba\x79\xbf\xcf\xab\x7e\x6b\xf8\x54\xeb\xca\x74\x80\x67\xf7\x2a\x53\xd0\x9d\x6d\x50\x3d\x49\xab\xde\x4e\x55\xcd\xf6\x8b\xde\xa8\x9c\xd2\x49\xa8\xc8\xbc\x2e\x88\x86\xd2\xa4\x1e\x88\x86\xb4\xbe\x0e\x88\x86\xd0\xa8\xfe\x47\xeb\xad\x84\xd6\x2f\xb9\x95\xf0\x37\x2b\x68\xd3\xe1\x9d\x78\x93\x5a\x4c\xf5\x47\x1a\x74\xab\x67\xa7\x95\xb3\x63\x6e\xd7\x1e\xb3\x15\xd4\x67\x59\x9c\xe9\xc8\x35\x8e\x34\x47\x23\xc2\x8a\x3a\xc2\x26\xa3\x3e\x64\x94\xd5\x96\x85\xea\x54\x81\xd1\xf9\x23\x93\xd3\x04\xad\x18\xed\x9c\x22\x68\xc5\x61\xf7\xf4\x40\x2b\x16\x59\x7d\x67\x6b\x5c\x57\x35\xfa\x45\xe2\x48\x2d\x91\x61\xbe\x60\x90\x2b\xe8\x5e\xaa\x1f\xcb\xdd\xb5\x0a\x71\x0d\x13\x04\xf3\xe8\xeb\x3f\x41\xfe\x4a\x70\xa3\x65\x94\x69\x19\xfd\xfd\x3b\x26\x7f\xc4\xc8\x8f\xc8\x14\x16\x4c\x29\x43\xf1\x7e\x38\xf4\xa8\xf4\xe3\x49\x72\xac\x67\xc8\x67\x84\x11\x39\x0c\x0b\xe5\xdd\x87\x4b\x3a\xa3\xc3\xa7\x94\x5e\x58\xdf\x79\x40\xbf\x8e\x5d\x9c\x30\x1a\xe2\xe4\xf6\x1f\x4d\xc7\x9d\x94\x38\x05\x50\xcf\xe2\xd0\x44\xdc\x8d\xe1\xd3\x3d\xb2\x3c\x70\x45\xd1\xc3\xe4\x00\x67\x98\x04\x3c\xd5\xb8\x96\xaf\xa4\xa9\x97\x4d\x1d\x0a\xaf\x7f\x86\x07\x81\x4a\x2b\x86\x27\x36\xac\x17\x29\xb2\xdb\xa4\x46\x6c\x2d\xb0\x14\xc8\xd8\x9b\xe7\xee\x1c\x0b\x0f\x9f\x1f\x3c\x0c\xb7\xff\xb5\xc6\xb8\xf7\x57\x00\x00\x00\xff\xff\x15\xc7\xf2\xdc\xb3\x63\x00\x00")
-
-func assetsProxyGlade2Bytes() ([]byte, error) {
-	return bindataRead(
-		_assetsProxyGlade2,
-		"assets/proxy.glade~",
-	)
-}
-
-func assetsProxyGlade2() (*asset, error) {
-	bytes, err := assetsProxyGlade2Bytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "assets/proxy.glade~", size: 25523, mode: os.FileMode(436), modTime: time.Unix(1517005512, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-// Asset loads and returns the asset for the given name.
-// It returns an error if the asset could not be found or
-// could not be loaded.
-func Asset(name string) ([]byte, error) {
-	cannonicalName := strings.Replace(name, "\\", "/", -1)
-	if f, ok := _bindata[cannonicalName]; ok {
-		a, err := f()
-		if err != nil {
-			return nil, fmt.Errorf("Asset %s can't read by error: %v", name, err)
-		}
-		return a.bytes, nil
-	}
-	return nil, fmt.Errorf("Asset %s not found", name)
-}
-
-// MustAsset is like Asset but panics when Asset would return an error.
-// It simplifies safe initialization of global variables.
-func MustAsset(name string) []byte {
-	a, err := Asset(name)
-	if err != nil {
-		panic("asset: Asset(" + name + "): " + err.Error())
-	}
-
-	return a
-}
-
-// AssetInfo loads and returns the asset info for the given name.
-// It returns an error if the asset could not be found or
-// could not be loaded.
-func AssetInfo(name string) (os.FileInfo, error) {
-	cannonicalName := strings.Replace(name, "\\", "/", -1)
-	if f, ok := _bindata[cannonicalName]; ok {
-		a, err := f()
-		if err != nil {
-			return nil, fmt.Errorf("AssetInfo %s can't read by error: %v", name, err)
-		}
-		return a.info, nil
-	}
-	return nil, fmt.Errorf("AssetInfo %s not found", name)
-}
-
-// AssetNames returns the names of the assets.
-func AssetNames() []string {
-	names := make([]string, 0, len(_bindata))
-	for name := range _bindata {
-		names = append(names, name)
-	}
-	return names
-}
-
-// _bindata is a table, holding each asset generator, mapped to its name.
-var _bindata = map[string]func() (*asset, error){
-	"assets/config.glade": assetsConfigGlade,
-	"assets/config.glade~": assetsConfigGlade2,
-	"assets/proxy.glade": assetsProxyGlade,
-	"assets/proxy.glade~": assetsProxyGlade2,
-}
-
-// AssetDir returns the file names below a certain
-// directory embedded in the file by go-bindata.
-// For example if you run go-bindata on data/... and data contains the
-// following hierarchy:
-//     data/
-//       foo.txt
-//       img/
-//         a.png
-//         b.png
-// then AssetDir("data") would return []string{"foo.txt", "img"}
-// AssetDir("data/img") would return []string{"a.png", "b.png"}
-// AssetDir("foo.txt") and AssetDir("notexist") would return an error
-// AssetDir("") will return []string{"data"}.
-func AssetDir(name string) ([]string, error) {
-	node := _bintree
-	if len(name) != 0 {
-		cannonicalName := strings.Replace(name, "\\", "/", -1)
-		pathList := strings.Split(cannonicalName, "/")
-		for _, p := range pathList {
-			node = node.Children[p]
-			if node == nil {
-				return nil, fmt.Errorf("Asset %s not found", name)
-			}
-		}
-	}
-	if node.Func != nil {
-		return nil, fmt.Errorf("Asset %s not found", name)
-	}
-	rv := make([]string, 0, len(node.Children))
-	for childName := range node.Children {
-		rv = append(rv, childName)
-	}
-	return rv, nil
-}
-
-type bintree struct {
-	Func     func() (*asset, error)
-	Children map[string]*bintree
-}
-var _bintree = &bintree{nil, map[string]*bintree{
-	"assets": &bintree{nil, map[string]*bintree{
-		"config.glade": &bintree{assetsConfigGlade, map[string]*bintree{}},
-		"config.glade~": &bintree{assetsConfigGlade2, map[string]*bintree{}},
-		"proxy.glade": &bintree{assetsProxyGlade, map[string]*bintree{}},
-		"proxy.glade~": &bintree{assetsProxyGlade2, map[string]*bintree{}},
-	}},
-}}
-
-// RestoreAsset restores an asset under the given directory
-func RestoreAsset(dir, name string) error {
-	data, err := Asset(name)
-	if err != nil {
-		return err
-	}
-	info, err := AssetInfo(name)
-	if err != nil {
-		return err
-	}
-	err = os.MkdirAll(_filePath(dir, filepath.Dir(name)), os.FileMode(0755))
-	if err != nil {
-		return err
-	}
-	err = ioutil.WriteFile(_filePath(dir, name), data, info.Mode())
-	if err != nil {
-		return err
-	}
-	err = os.Chtimes(_filePath(dir, name), info.ModTime(), info.ModTime())
-	if err != nil {
-		return err
-	}
-	return nil
-}
-
-// RestoreAssets restores an asset under the given directory recursively
-func RestoreAssets(dir, name string) error {
-	children, err := AssetDir(name)
-	// File
-	if err != nil {
-		return RestoreAsset(dir, name)
-	}
-	// Dir
-	for _, child := range children {
-		err = RestoreAssets(dir, filepath.Join(name, child))
-		if err != nil {
-			return err
-		}
-	}
-	return nil
-}
-
-func _filePath(dir, name string) string {
-	cannonicalName := strings.Replace(name, "\\", "/", -1)
-	return filepath.Join(append([]string{dir}, strings.Split(cannonicalName, "/")...)...)
-}
-
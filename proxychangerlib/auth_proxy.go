@@ -0,0 +1,161 @@
+package proxychangerlib
+
+import (
+	"bufio"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// First port used by the local endpoints of the proxies in credential-safe mode
+const DEFAULT_AUTH_PROXY_PORT = 53128
+
+const AUTH_PROXY_DIAL_TIMEOUT = 10 * time.Second
+
+// Local HTTP proxy that forwards the requests to an upstream proxy adding the credentials, so the
+// applications can use the proxy without knowing the password. It only listens in the loopback interface,
+// only accepts the connections of processes of the same user, and lives while this process runs.
+type AuthProxy struct {
+	ListenAddress string
+	// host:port of the upstream proxy
+	Upstream string
+	Username string
+	Password string
+
+	listener net.Listener
+}
+
+func StartAuthProxy(listenAddress string, upstream string, username string, password string) (*AuthProxy, error) {
+	s := AuthProxy{
+		ListenAddress: listenAddress,
+		Upstream:      upstream,
+		Username:      username,
+		Password:      password,
+	}
+	listener, err := net.Listen("tcp", listenAddress)
+	if err != nil {
+		return nil, errors.Wrapf(err, MyGettextv("Error listening in %v", listenAddress))
+	}
+	s.listener = listener
+	Log.Infof("Local endpoint %v started for proxy %v", listenAddress, upstream)
+	go s.Serve()
+	return &s, nil
+}
+
+func (s *AuthProxy) Serve() {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			Log.Debugf("Local endpoint %v stopped: %v", s.ListenAddress, err)
+			return
+		}
+		go s.Handle(conn)
+	}
+}
+
+// Forwards the requests of the client to the upstream proxy with the Proxy-Authorization header; after a
+// CONNECT request, the connection becomes a tunnel and the data is copied as is
+func (s *AuthProxy) Handle(client net.Conn) {
+
+	defer client.Close()
+
+	// Other local users could use the proxy with the credentials of this user
+	sameUser, err := IsConnectionFromSameUser(client)
+	if err != nil || !sameUser {
+		Log.Warningf("Rejecting connection from %v to local endpoint %v: not from this user (%v)", client.RemoteAddr(), s.ListenAddress, err)
+		client.Write([]byte("HTTP/1.1 403 Forbidden\r\n\r\n"))
+		return
+	}
+
+	upstream, err := net.DialTimeout("tcp", s.Upstream, AUTH_PROXY_DIAL_TIMEOUT)
+	if err != nil {
+		Log.Errorf("Error connecting to proxy %v: %v", s.Upstream, err)
+		client.Write([]byte("HTTP/1.1 502 Bad Gateway\r\n\r\n"))
+		return
+	}
+	defer upstream.Close()
+
+	go func() {
+		io.Copy(client, upstream)
+		client.Close()
+	}()
+
+	authorization := "Basic " + base64.StdEncoding.EncodeToString([]byte(s.Username+":"+s.Password))
+	reader := bufio.NewReader(client)
+	for {
+		req, err := http.ReadRequest(reader)
+		if err != nil {
+			return
+		}
+		req.Header.Set("Proxy-Authorization", authorization)
+		err = req.WriteProxy(upstream)
+		if err != nil {
+			Log.Errorf("Error sending request to proxy %v: %v", s.Upstream, err)
+			return
+		}
+		if req.Method == http.MethodConnect {
+			io.Copy(upstream, reader)
+			return
+		}
+	}
+
+}
+
+func (s *AuthProxy) Stop() error {
+	Log.Infof("Stopping local endpoint %v", s.ListenAddress)
+	return s.listener.Close()
+}
+
+// Returns if the other end of the loopback TCP connection belongs to this user, looking for the socket of the
+// client in /proc/net/tcp
+func IsConnectionFromSameUser(conn net.Conn) (bool, error) {
+
+	local, ok := conn.LocalAddr().(*net.TCPAddr)
+	if !ok {
+		return false, errors.New(MyGettextv("Not a TCP connection"))
+	}
+	remote, ok := conn.RemoteAddr().(*net.TCPAddr)
+	if !ok {
+		return false, errors.New(MyGettextv("Not a TCP connection"))
+	}
+	if remote.IP.To4() == nil || local.IP.To4() == nil {
+		return false, errors.New(MyGettextv("Only IPv4 connections are supported"))
+	}
+
+	data, err := ioutil.ReadFile("/proc/net/tcp")
+	if err != nil {
+		return false, errors.Wrap(err, MyGettextv("Error reading the TCP sockets"))
+	}
+
+	// The socket of the client has our remote address as local address, and the other way round
+	clientAddress, serverAddress := procNetTcpAddress(remote), procNetTcpAddress(local)
+	for _, line := range strings.Split(string(data), "\n")[1:] {
+		fields := strings.Fields(line)
+		if len(fields) < 8 || fields[1] != clientAddress || fields[2] != serverAddress {
+			continue
+		}
+		uid, err := strconv.Atoi(fields[7])
+		if err != nil {
+			return false, errors.Wrapf(err, MyGettextv("Invalid user id %v of the TCP socket", fields[7]))
+		}
+		return uid == os.Getuid(), nil
+	}
+	return false, errors.New(MyGettextv("Socket of the client %v not found", remote))
+
+}
+
+// Formats the address like /proc/net/tcp: the IP in hexadecimal, in the byte order of the host (little endian
+// in the supported architectures), and the port in hexadecimal
+func procNetTcpAddress(a *net.TCPAddr) string {
+	ip := a.IP.To4()
+	return fmt.Sprintf("%02X%02X%02X%02X:%04X", ip[3], ip[2], ip[1], ip[0], a.Port)
+}
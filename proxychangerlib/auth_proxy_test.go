@@ -0,0 +1,33 @@
+package proxychangerlib
+
+import (
+	"net"
+	"testing"
+)
+
+func TestIsConnectionFromSameUser(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer listener.Close()
+
+	client, err := net.Dial("tcp", listener.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+	server, err := listener.Accept()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer server.Close()
+
+	sameUser, err := IsConnectionFromSameUser(server)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !sameUser {
+		t.Error("connection of this process not from the same user")
+	}
+}
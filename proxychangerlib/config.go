@@ -2,11 +2,17 @@ package proxychangerlib
 
 import (
 	"encoding/json"
+	"fmt"
 	"net"
+	"os"
+	"path"
 	"regexp"
 	"strconv"
 	"strings"
+	"sync"
+	"time"
 
+	"github.com/fsnotify/fsnotify"
 	"github.com/godbus/dbus"
 	"github.com/juju/loggo"
 
@@ -14,7 +20,6 @@ import (
 	"github.com/gosimple/slug"
 	"github.com/okelet/goutils"
 	"github.com/pkg/errors"
-	"github.com/zalando/go-keyring"
 )
 
 const KEEP_CURRENT_PROXY = "keep"
@@ -22,6 +27,8 @@ const DEACTIVATE_PROXY = "deactivate"
 
 type Configuration struct {
 	Filename string
+	// JSON Schema of the file ($schema key), kept when saving
+	SchemaUrl string
 
 	IndicatorAlreadyRun                 bool
 	ShowCurrentProxyNameNextToIndicator bool
@@ -44,9 +51,31 @@ type Configuration struct {
 	// Script to run when some proxy is activated
 	ProxyActivateScript string
 
+	// Where the proxies passwords are stored (see SECRET_STORES) and its options
+	SecretStoreType    string
+	SecretStoreOptions map[string]string
+	SecretStore        SecretStore
+
+	// First port of the local endpoints used by the proxies in credential-safe mode
+	AuthProxyPort int
+	// Running local endpoints, by upstream proxy address
+	AuthProxies map[string]*AuthProxy
+	// If this process keeps running (indicator or daemon) and can serve the local endpoints; a command that
+	// exits after changing the proxy would leave the applications pointing to endpoints that don't exist
+	ServeAuthProxies bool
+
 	// List of ids of disabled applications
 	DisabledApplicationsIds []string
 
+	// Policies of the system configuration files, in load order, and applications enabled or disabled by them
+	// (see LoadSystemConfiguration)
+	SystemPolicies                []*goutils.MapHelper
+	ForcedEnabledApplicationsIds  []string
+	ForcedDisabledApplicationsIds []string
+
+	// Applications defined in the configuration file and in CUSTOM_APPS_DIR
+	CustomApplications []*CustomProxySetter
+
 	// List of proxyes
 	Proxies []*Proxy
 	// Current active proxy
@@ -55,6 +84,17 @@ type Configuration struct {
 	// List of objects that listens for config events
 	Listeners []ConfigListener
 
+	// See BeginUpdate and EndUpdate
+	updateMutex   sync.Mutex
+	eventsMutex   sync.Mutex
+	updating      bool
+	pendingEvents []func(l ConfigListener)
+
+	// See StartWatching and CheckFileChanged
+	watcher       *fsnotify.Watcher
+	lastDiskHash  string
+	lastSavedHash string
+
 	// Status of the last proxy change result
 	LastExecutionResults *GlobalProxyChangeResult
 }
@@ -64,6 +104,14 @@ func NewConfig(configPath string, setActiveProxy bool) (*Configuration, error) {
 	config := &Configuration{}
 	config.Listeners = []ConfigListener{}
 	config.Proxies = []*Proxy{}
+	config.CustomApplications = []*CustomProxySetter{}
+	config.SecretStoreType = DEFAULT_SECRET_STORE
+	config.SecretStoreOptions = map[string]string{}
+	config.SecretStore = NewKeyringSecretStore()
+	config.AuthProxyPort = DEFAULT_AUTH_PROXY_PORT
+	config.AuthProxies = map[string]*AuthProxy{}
+
+	RegisterPluginApplications()
 
 	if configPath == "" {
 		configPath = DEFAULT_CONFIG_PATH
@@ -76,6 +124,11 @@ func NewConfig(configPath string, setActiveProxy bool) (*Configuration, error) {
 
 	config.Filename = configPath
 
+	err = config.UpdateSavedState()
+	if err != nil {
+		return nil, err
+	}
+
 	return config, nil
 
 }
@@ -114,6 +167,32 @@ func (c *Configuration) Load(configPath string, setActiveProxy bool, loadPasswor
 		return errors.Wrapf(err, MyGettextv("Error loading configuration"))
 	}
 
+	version, err := MigrateConfiguration(helper)
+	if err != nil {
+		return err
+	}
+	// Imported files are not backed up, as they are not overwritten
+	if version < CONFIG_VERSION && (c.Filename == "" || c.Filename == configPath) {
+		exists, err := goutils.FileExists(configPath)
+		if err != nil {
+			return errors.Wrapf(err, "Error checking if file %v exists", configPath)
+		}
+		if exists {
+			backupFilename, err := BackupConfigurationFile(configPath, version)
+			if err != nil {
+				return err
+			}
+			Log.Infof("Configuration migrated from version %v to %v; previous file saved in %v", version, CONFIG_VERSION, backupFilename)
+		}
+	}
+
+	return c.LoadMap(helper, setActiveProxy, loadPasswordsFromMap, saveAfterLoad)
+
+}
+
+// Loads the configuration from the map, already migrated to the current version; see Load
+func (c *Configuration) LoadMap(helper *goutils.MapHelper, setActiveProxy bool, loadPasswordsFromMap bool, saveAfterLoad bool) error {
+
 	// Deactivate current proxy, if any
 	if c.ActiveProxy != nil {
 		_, err := c.SetActiveProxy(nil, "Deactivating current proxy while loading configuration", false)
@@ -122,56 +201,45 @@ func (c *Configuration) Load(configPath string, setActiveProxy bool, loadPasswor
 		}
 	}
 
-	// Remove old proxies, sending notification to listeners
+	// Remove old proxies, sending notification to listeners; their passwords are kept in the secret store, as
+	// the same proxies are usually loaded again
 	proxiesCopy := c.Proxies[:]
 	for ind, _ := range proxiesCopy {
-		err := c.DeleteProxy(proxiesCopy[ind], false)
-		if err != nil {
-			return errors.Wrapf(err, MyGettextv("Error deleting proxy %v"), proxiesCopy[ind].Name)
-		}
+		c.RemoveProxy(proxiesCopy[ind])
 	}
 
-	version := helper.GetInt("version", 0)
-
-	c.IndicatorAlreadyRun = helper.GetBoolean("indicator_already_run", false)
-
-	if version == 0 {
-		c.SetShowCurrentProxyNameNextToIndicator(helper.GetBoolean("show_current_proxy_next_icon", true))
-	} else {
-		c.SetShowCurrentProxyNameNextToIndicator(helper.GetBoolean("show_current_proxy_name_next_to_indicator", true))
+	secretStoreHelper := helper.GetHelper("secret_store")
+	secretStoreType := secretStoreHelper.GetString("type", DEFAULT_SECRET_STORE)
+	secretStoreOptions := map[string]string{}
+	for _, k := range secretStoreHelper.Keys() {
+		if k != "type" {
+			secretStoreOptions[k] = secretStoreHelper.GetString(k, "")
+		}
 	}
-	logLevelStr := helper.GetString("log_level", "warning")
-	level, ok := loggo.ParseLevel(logLevelStr)
-	if !ok {
-		Log.Errorf("Invalid LOG level %v.", logLevelStr)
-	} else {
-		c.LogLevel = level
+	secretStore, err := NewSecretStore(secretStoreType, secretStoreOptions)
+	if err != nil {
+		return errors.Wrap(err, MyGettextv("Error creating secret store"))
 	}
+	c.SecretStoreType = secretStoreType
+	c.SecretStoreOptions = secretStoreOptions
+	c.SecretStore = secretStore
 
-	c.EnableUpdateCheck = helper.GetBoolean("enable_update_check", true)
-	c.TimeBetweenUpdateChecks = helper.GetInt("time_between_update_checks", DEFAULT_TIME_BETWEEN_UPDATE_CHECKS)
-
-	c.EnableAutoChangeByIp = helper.GetBoolean("enable_auto_change_by_ip", false)
-	c.WhatToDoWhenNoIpMatches = helper.GetString("what_to_do_when_no_ip_matches", DEACTIVATE_PROXY)
-	c.TimeBetweenIpChecks = helper.GetInt("time_between_ips_checks", DEFAULT_TIME_BETWEEN_IP_CHECKS)
-
-	c.ProxyChangeScript = helper.GetString("proxy_change_script", "")
-	c.ProxyDeactivateScript = helper.GetString("proxy_deactivate_script", "")
-	c.ProxyActivateScript = helper.GetString("proxy_activate_script", "")
+	c.SchemaUrl = helper.GetString("$schema", "")
+	c.IndicatorAlreadyRun = helper.GetBoolean("indicator_already_run", false)
 
-	c.ExcludedInterfacesRegexps = helper.GetListOfStrings("excluded_interfaces_regexps", DEFAULT_EXCLUDED_INTERFACES_REGEXPS)
-	c.ExcludedInterfacesRegexpsParsed = []*regexp.Regexp{}
-	for _, s := range c.ExcludedInterfacesRegexps {
-		regexp, err := regexp.Compile(s)
-		if err != nil {
-			return errors.Wrapf(err, "Error compiling regexp %v", s)
-		}
-		c.ExcludedInterfacesRegexpsParsed = append(c.ExcludedInterfacesRegexpsParsed, regexp)
+	err = c.LoadSettings(helper)
+	if err != nil {
+		return err
 	}
 
-	c.DisabledApplicationsIds = helper.GetListOfStrings("disabled_applications", []string{})
+	// System proxies are loaded first, so they keep their names and slugs
+	c.LoadSystemConfiguration()
 
 	for _, v := range helper.GetListOfHelpers("proxies") {
+		if systemProxy := c.GetProxyWithUuid(v.GetString("uuid", "")); systemProxy != nil && systemProxy.Locked {
+			Log.Warningf("Ignoring proxy %v; it is defined in the system configuration", v.GetString("name", ""))
+			continue
+		}
 		p, err := NewProxyFromMap(c, v, loadPasswordsFromMap)
 		if err != nil {
 			return errors.Wrapf(err, "Error loading proxy")
@@ -193,9 +261,9 @@ func (c *Configuration) Load(configPath string, setActiveProxy bool, loadPasswor
 		}
 	}
 
-	for _, l := range c.Listeners {
+	c.NotifyListeners(func(l ConfigListener) {
 		l.OnConfigLoaded()
-	}
+	})
 
 	if saveAfterLoad {
 		return c.Save("Saving after load")
@@ -205,14 +273,56 @@ func (c *Configuration) Load(configPath string, setActiveProxy bool, loadPasswor
 
 }
 
-func (c *Configuration) AddListener(l ConfigListener) {
-	c.Listeners = append(c.Listeners, l)
+// Loads the settings of the map (everything but the proxies and the secret store)
+func (c *Configuration) LoadSettings(helper *goutils.MapHelper) error {
+
+	c.SetShowCurrentProxyNameNextToIndicator(helper.GetBoolean("show_current_proxy_name_next_to_indicator", true))
+	logLevelStr := helper.GetString("log_level", "warning")
+	level, ok := loggo.ParseLevel(logLevelStr)
+	if !ok {
+		Log.Errorf("Invalid LOG level %v.", logLevelStr)
+	} else {
+		c.LogLevel = level
+	}
+
+	c.EnableUpdateCheck = helper.GetBoolean("enable_update_check", true)
+	c.TimeBetweenUpdateChecks = helper.GetInt("time_between_update_checks", DEFAULT_TIME_BETWEEN_UPDATE_CHECKS)
+
+	c.EnableAutoChangeByIp = helper.GetBoolean("enable_auto_change_by_ip", false)
+	c.WhatToDoWhenNoIpMatches = helper.GetString("what_to_do_when_no_ip_matches", DEACTIVATE_PROXY)
+	c.TimeBetweenIpChecks = helper.GetInt("time_between_ips_checks", DEFAULT_TIME_BETWEEN_IP_CHECKS)
+
+	c.AuthProxyPort = helper.GetInt("auth_proxy_port", DEFAULT_AUTH_PROXY_PORT)
+
+	c.ProxyChangeScript = helper.GetString("proxy_change_script", "")
+	c.ProxyDeactivateScript = helper.GetString("proxy_deactivate_script", "")
+	c.ProxyActivateScript = helper.GetString("proxy_activate_script", "")
+
+	c.ExcludedInterfacesRegexps = helper.GetListOfStrings("excluded_interfaces_regexps", DEFAULT_EXCLUDED_INTERFACES_REGEXPS)
+	c.ExcludedInterfacesRegexpsParsed = []*regexp.Regexp{}
+	for _, s := range c.ExcludedInterfacesRegexps {
+		regexp, err := regexp.Compile(s)
+		if err != nil {
+			return errors.Wrapf(err, "Error compiling regexp %v", s)
+		}
+		c.ExcludedInterfacesRegexpsParsed = append(c.ExcludedInterfacesRegexpsParsed, regexp)
+	}
+
+	c.DisabledApplicationsIds = helper.GetListOfStrings("disabled_applications", []string{})
+
+	c.LoadCustomApplications(helper.GetListOfHelpers("custom_applications"))
+
+	return nil
+
 }
 
 func (c *Configuration) ToMap(includePasswords bool) (*goutils.MapHelper, error) {
 
 	h := goutils.NewEmptyMapHelper()
-	h.SetInt("version", 1)
+	if c.SchemaUrl != "" {
+		h.SetString("$schema", c.SchemaUrl)
+	}
+	h.SetInt("version", CONFIG_VERSION)
 	if c.IndicatorAlreadyRun {
 		h.SetBoolean("indicator_already_run", c.IndicatorAlreadyRun)
 	}
@@ -223,16 +333,21 @@ func (c *Configuration) ToMap(includePasswords bool) (*goutils.MapHelper, error)
 		h.SetString("log_level", c.LogLevel.String())
 	}
 
-	if c.EnableAutoChangeByIp {
+	// The settings forced by the system configuration are not saved
+	if c.EnableAutoChangeByIp && !c.IsPolicyLocked("enable_auto_change_by_ip") {
 		h.SetBoolean("enable_auto_change_by_ip", c.EnableAutoChangeByIp)
 	}
-	if c.WhatToDoWhenNoIpMatches != "" && c.WhatToDoWhenNoIpMatches != DEACTIVATE_PROXY {
+	if c.WhatToDoWhenNoIpMatches != "" && c.WhatToDoWhenNoIpMatches != DEACTIVATE_PROXY && !c.IsPolicyLocked("what_to_do_when_no_ip_matches") {
 		h.SetString("what_to_do_when_no_ip_matches", c.WhatToDoWhenNoIpMatches)
 	}
-	if c.TimeBetweenIpChecks != DEFAULT_TIME_BETWEEN_IP_CHECKS {
+	if c.TimeBetweenIpChecks != DEFAULT_TIME_BETWEEN_IP_CHECKS && !c.IsPolicyLocked("time_between_ips_checks") {
 		h.SetInt("time_between_ips_checks", c.TimeBetweenIpChecks)
 	}
 
+	if c.AuthProxyPort != DEFAULT_AUTH_PROXY_PORT {
+		h.SetInt("auth_proxy_port", c.AuthProxyPort)
+	}
+
 	if c.ProxyChangeScript != "" {
 		h.SetString("proxy_change_script", c.ProxyChangeScript)
 	}
@@ -247,27 +362,50 @@ func (c *Configuration) ToMap(includePasswords bool) (*goutils.MapHelper, error)
 		h.SetListOfStrings("excluded_interfaces_regexps", c.ExcludedInterfacesRegexps)
 	}
 
-	if !c.EnableUpdateCheck {
+	if !c.EnableUpdateCheck && !c.IsPolicyLocked("enable_update_check") {
 		h.SetBoolean("enable_update_check", c.EnableUpdateCheck)
 	}
-	if c.TimeBetweenUpdateChecks != DEFAULT_TIME_BETWEEN_UPDATE_CHECKS {
+	if c.TimeBetweenUpdateChecks != DEFAULT_TIME_BETWEEN_UPDATE_CHECKS && !c.IsPolicyLocked("time_between_update_checks") {
 		h.SetInt("time_between_update_checks", c.TimeBetweenUpdateChecks)
 	}
 
+	if c.SecretStoreType != DEFAULT_SECRET_STORE {
+		h.GetHelper("secret_store").SetString("type", c.SecretStoreType)
+		for k, v := range c.SecretStoreOptions {
+			h.GetHelper("secret_store").SetString(k, v)
+		}
+	}
+
 	if len(c.DisabledApplicationsIds) > 0 {
 		h.SetListOfStrings("disabled_applications", c.DisabledApplicationsIds)
 	}
 
+	customApplications := []*goutils.MapHelper{}
+	for _, a := range c.CustomApplications {
+		if a.Source == "" {
+			customApplications = append(customApplications, a.Definition)
+		}
+	}
+	if len(customApplications) > 0 {
+		h.SetListOfHelpers("custom_applications", customApplications)
+	}
+
 	if len(c.Proxies) > 0 {
 		l := []*goutils.MapHelper{}
 		for _, v := range c.Proxies {
+			if v.Locked {
+				// Loaded from the system configuration
+				continue
+			}
 			data, err := v.ToMap(c, includePasswords)
 			if err != nil {
 				return nil, errors.Wrapf(err, "Error exporting proxy %v", v.Name)
 			}
 			l = append(l, data)
 		}
-		h.SetListOfHelpers("proxies", l)
+		if len(l) > 0 {
+			h.SetListOfHelpers("proxies", l)
+		}
 	}
 	if c.ActiveProxy != nil {
 		h.SetString("active_proxy", c.ActiveProxy.UUID)
@@ -277,7 +415,25 @@ func (c *Configuration) ToMap(includePasswords bool) (*goutils.MapHelper, error)
 
 }
 
+// Saves the configuration to its file; if the file has been changed by other program since it was loaded or
+// saved, the configuration is not saved, the listeners are notified and ConfigurationConflictError is returned
 func (c *Configuration) Save(reason string) error {
+	changed, err := c.IsFileChanged()
+	if err != nil {
+		return err
+	}
+	if changed {
+		Log.Warningf("Not saving configuration (reason: %v); the file %v has been changed by other program", reason, c.Filename)
+		c.NotifyListeners(func(l ConfigListener) {
+			l.OnConfigConflict()
+		})
+		return ConfigurationConflictError
+	}
+	return c.ForceSave(reason)
+}
+
+// Saves the configuration to its file, overwriting the changes made by other programs
+func (c *Configuration) ForceSave(reason string) error {
 	if reason == "" {
 		reason = "No reason"
 	}
@@ -286,16 +442,13 @@ func (c *Configuration) Save(reason string) error {
 	if err != nil {
 		return errors.Wrap(err, "Error exporting configuration")
 	}
-	return data.SaveToJsonFile(c.Filename, true)
-}
-
-func (c *Configuration) Export(filename string, includePasswords bool) error {
-	Log.Debugf("Exporting configuration to file %v...", filename)
-	data, err := c.ToMap(includePasswords)
+	err = SafeSaveFile(c.Filename, 0644, false, func(tmpFilename string) error {
+		return data.SaveToJsonFile(tmpFilename, true)
+	})
 	if err != nil {
-		return errors.Wrap(err, "Error exporting configuration")
+		return err
 	}
-	return data.SaveToJsonFile(filename, true)
+	return c.UpdateSavedState()
 }
 
 func (c *Configuration) NoOp() {
@@ -383,19 +536,16 @@ func (c *Configuration) SetActiveProxy(p *Proxy, reason string, save bool) (*Glo
 
 	results := []*AppProxyChangeResult{}
 	for _, a := range c.GetEnabledApplications() {
-		Log.Debugf("Applying proxy to %v", a.GetSimpleName())
-		var result *AppProxyChangeResult
-		if p != nil {
-			result = a.Apply(p)
-		} else {
-			result = a.Apply(nil)
-		}
-		if result.SkippedMessage == "" && result.ErrorMessage != "" {
-			Log.Errorf("Error applying proxy in application %v: %v.\n", a.GetSimpleName(), result.ErrorMessage)
-		}
-		results = append(results, result)
+		results = append(results, c.ApplyProxyToApplication(a, p))
 	}
 	c.ActiveProxy = p
+	if p == nil || !p.NeedsCredentialSafeMode() {
+		c.StopAuthProxies()
+	}
+	err = c.WriteActiveProxyState()
+	if err != nil {
+		Log.Errorf("Error writing file %v: %v", ACTIVE_PROXY_STATE_FILE, err)
+	}
 
 	n := &GlobalProxyChangeResult{
 		Proxy:              p,
@@ -476,9 +626,9 @@ func (c *Configuration) SetActiveProxy(p *Proxy, reason string, save bool) (*Glo
 	}
 
 	c.LastExecutionResults = n
-	for _, l := range c.Listeners {
+	c.NotifyListeners(func(l ConfigListener) {
 		l.OnProxyActivated(n)
-	}
+	})
 
 	var saveError error
 	if save {
@@ -568,6 +718,10 @@ func (c *Configuration) UpdateProxyFromData(save bool, p *Proxy, setSlug bool, n
 
 	var err error
 
+	if p.Locked {
+		return errors.New(MyGettextv("The proxy %v is managed by the system configuration and can't be changed", p.Name)), "_locked"
+	}
+
 	if setName {
 		if newName == "" {
 			return errors.New(MyGettextv("Name can not be empty")), "name"
@@ -670,12 +824,12 @@ func (c *Configuration) UpdateProxyFromData(save bool, p *Proxy, setSlug bool, n
 		if newPassword != "" {
 			err = c.SetPassword(p.UUID, newPassword)
 			if err != nil {
-				return errors.Wrap(err, MyGettextv("Error saving password in keyring")), "password"
+				return errors.Wrap(err, MyGettextv("Error saving password in secret store")), "password"
 			}
 		} else {
 			err = c.DeletePassword(p.UUID)
 			if err != nil {
-				return errors.Wrap(err, MyGettextv("Error saving password in keyring")), "password"
+				return errors.Wrap(err, MyGettextv("Error saving password in secret store")), "password"
 			}
 		}
 	}
@@ -684,6 +838,40 @@ func (c *Configuration) UpdateProxyFromData(save bool, p *Proxy, setSlug bool, n
 
 }
 
+// Sets the applications included and excluded by the proxy p, and its per application overrides
+func (c *Configuration) UpdateProxyApplicationSettings(save bool, p *Proxy, includedIds []string, excludedIds []string, overrides map[string]*ProxyApplicationOverride) (error, string) {
+
+	if p.Locked {
+		return errors.New(MyGettextv("The proxy %v is managed by the system configuration and can't be changed", p.Name)), "_locked"
+	}
+
+	for _, id := range includedIds {
+		if goutils.ListContainsString(excludedIds, id) {
+			return errors.New(MyGettextv("Application %v can not be included and excluded at the same time", id)), "applications"
+		}
+	}
+
+	cleanOverrides := map[string]*ProxyApplicationOverride{}
+	for id, o := range overrides {
+		if o.Port < 0 {
+			return errors.New(MyGettextv("Port for application %v must be greater than 0", id)), "applications"
+		}
+		if o.Port >= 65535 {
+			return errors.New(MyGettextv("Port for application %v must be lower than 65535", id)), "applications"
+		}
+		if !o.IsEmpty() {
+			cleanOverrides[id] = o
+		}
+	}
+
+	p.IncludedApplicationsIds = includedIds
+	p.ExcludedApplicationsIds = excludedIds
+	p.ApplicationOverrides = cleanOverrides
+
+	return c.UpdateProxy(save, p)
+
+}
+
 func (c *Configuration) UpdateProxy(save bool, p *Proxy) (error, string) {
 
 	var foundProxy *Proxy
@@ -696,18 +884,18 @@ func (c *Configuration) UpdateProxy(save bool, p *Proxy) (error, string) {
 
 	if foundProxy == nil {
 		c.Proxies = append(c.Proxies, p)
-		for _, l := range c.Listeners {
+		c.NotifyListeners(func(l ConfigListener) {
 			l.OnProxyAdded(p)
-		}
+		})
 		if save {
 			return c.Save(MyGettextv("Proxy %v added", p.Name)), "_saving"
 		} else {
 			return nil, ""
 		}
 	} else {
-		for _, l := range c.Listeners {
+		c.NotifyListeners(func(l ConfigListener) {
 			l.OnProxyUpdated(p)
-		}
+		})
 		if save {
 			return c.Save(MyGettextv("Proxy %v updated", p.Name)), "_saving"
 		} else {
@@ -727,13 +915,28 @@ func (c *Configuration) DeleteProxyFromUuid(uuid string, save bool) error {
 
 func (c *Configuration) DeleteProxy(p *Proxy, save bool) error {
 
+	if p.Locked {
+		return errors.New(MyGettextv("The proxy %v is managed by the system configuration and can't be deleted", p.Name))
+	}
 	if c.ActiveProxy == p {
 		return errors.New(MyGettextv("The proxy %v is currently in use", p.Name))
 	}
 	err := c.DeletePassword(p.UUID)
 	if err != nil {
-		return errors.Wrap(err, MyGettextv("Error deleting password in keyring"))
+		return errors.Wrap(err, MyGettextv("Error deleting password in secret store"))
+	}
+	c.RemoveProxy(p)
+
+	if save {
+		return c.Save(MyGettextv("Proxy %v deleted", p.Name))
+	} else {
+		return nil
 	}
+
+}
+
+// Removes the proxy from the list, without deleting its password
+func (c *Configuration) RemoveProxy(p *Proxy) {
 	newProxies := []*Proxy{}
 	for ind, _ := range c.Proxies {
 		if p != c.Proxies[ind] {
@@ -741,16 +944,9 @@ func (c *Configuration) DeleteProxy(p *Proxy, save bool) error {
 		}
 	}
 	c.Proxies = newProxies
-	for _, l := range c.Listeners {
+	c.NotifyListeners(func(l ConfigListener) {
 		l.OnProxyRemoved(p)
-	}
-
-	if save {
-		return c.Save(MyGettextv("Proxy %v deleted", p.Name))
-	} else {
-		return nil
-	}
-
+	})
 }
 
 func (c *Configuration) GetEnabledApplications() []ProxifiedApplication {
@@ -764,6 +960,12 @@ func (c *Configuration) GetEnabledApplications() []ProxifiedApplication {
 }
 
 func (c *Configuration) IsApplicationEnabled(appName string) bool {
+	if goutils.ListContainsString(c.ForcedDisabledApplicationsIds, appName) {
+		return false
+	}
+	if goutils.ListContainsString(c.ForcedEnabledApplicationsIds, appName) {
+		return true
+	}
 	return !goutils.ListContainsString(c.DisabledApplicationsIds, appName)
 }
 
@@ -775,94 +977,438 @@ func (c *Configuration) DisableApplication(appName string) {
 	c.DisabledApplicationsIds = goutils.AddStringToList(c.DisabledApplicationsIds, appName)
 }
 
-func (c *Configuration) GetPassword(uuid string) (string, error) {
-	password, err := keyring.Get(APP_ID, uuid)
-	if err != nil && err != keyring.ErrNotFound {
-		return "", err
+// Enables or disables the application with the id and saves the configuration. If applyNow is set, the active
+// proxy is applied to the application when enabling it, and its proxy settings are removed when disabling it.
+func (c *Configuration) SetApplicationEnabled(id string, enabled bool, applyNow bool) (*AppProxyChangeResult, error) {
+
+	a := GetProxifiedApplication(id)
+	if a == nil {
+		return nil, errors.New(MyGettextv("Application with id %v not found", id))
+	}
+	if c.IsApplicationLocked(id) {
+		return nil, errors.New(MyGettextv("The application %v is enabled or disabled by the system configuration", id))
+	}
+
+	var err error
+	if enabled {
+		c.EnableApplication(id)
+		err = c.Save(fmt.Sprintf("App %v is now enabled.", id))
 	} else {
-		return password, nil
+		c.DisableApplication(id)
+		err = c.Save(fmt.Sprintf("App %v is now disabled.", id))
+	}
+	if err != nil {
+		return nil, err
 	}
-}
 
-func (c *Configuration) SetPassword(uuid string, password string) error {
-	return keyring.Set(APP_ID, uuid, password)
-}
+	if !applyNow {
+		return nil, nil
+	}
 
-func (c *Configuration) DeletePassword(uuid string) error {
-	err := keyring.Delete(APP_ID, uuid)
-	if err != nil && err != keyring.ErrNotFound {
-		return err
+	var results []*AppProxyChangeResult
+	if enabled {
+		results, err = c.ApplyToApplications([]string{id}, false, MyGettextv("Application %v enabled", a.GetSimpleName()))
 	} else {
-		return nil
+		results, err = c.ApplyToApplications([]string{id}, true, MyGettextv("Application %v disabled", a.GetSimpleName()))
 	}
-}
-
-func (c *Configuration) SetShowCurrentProxyNameNextToIndicator(value bool) {
-	c.ShowCurrentProxyNameNextToIndicator = value
-	for _, l := range c.Listeners {
-		l.OnShowProxyNameNextToIndicatorChanged(value)
+	if err != nil {
+		return nil, err
 	}
+	return results[0], nil
+
 }
 
-func (c *Configuration) GetIndicatorAutostart() (bool, error) {
+// Applies the active proxy (or removes the proxy settings, if clear is set or there is no active proxy) only
+// in the applications with the ids, merging the results into LastExecutionResults.
+func (c *Configuration) ApplyToApplications(ids []string, clear bool, reason string) ([]*AppProxyChangeResult, error) {
 
-	exists, err := goutils.FileExists(AUTOSTART_FILE)
-	if err != nil {
-		return false, err
+	apps := []ProxifiedApplication{}
+	for _, id := range ids {
+		a := GetProxifiedApplication(id)
+		if a == nil {
+			return nil, errors.New(MyGettextv("Application with id %v not found", id))
+		}
+		apps = append(apps, a)
 	}
 
-	if !exists {
-		return false, errors.New(MyGettextv("File %v doesn't exist", AUTOSTART_FILE))
+	var p *Proxy
+	if !clear {
+		p = c.ActiveProxy
 	}
 
-	cfg, err := ini.LoadSources(ini.LoadOptions{IgnoreInlineComment: true}, AUTOSTART_FILE)
-	if err != nil {
-		return false, err
+	results := []*AppProxyChangeResult{}
+	for _, a := range apps {
+		results = append(results, c.ApplyProxyToApplication(a, p))
 	}
 
-	section := cfg.Section("Desktop Entry")
-	key, err := section.GetKey("X-GNOME-Autostart-enabled")
-	if err != nil {
-		// If section doesn't exists, the default value is true
-		return true, nil
+	if c.LastExecutionResults == nil {
+		c.LastExecutionResults = &GlobalProxyChangeResult{
+			Proxy:   c.ActiveProxy,
+			Reason:  reason,
+			Results: []*AppProxyChangeResult{},
+		}
 	}
+	c.LastExecutionResults.MergeResults(results)
 
-	val, err := key.Bool()
-	if err != nil {
-		return false, err
-	}
+	c.NotifyListeners(func(l ConfigListener) {
+		l.OnApplicationsApplied(results)
+	})
 
-	return val, nil
+	return results, nil
 
 }
 
-func (c *Configuration) SetIndicatorAutostart(value bool) error {
+// Applies the proxy p (or removes the proxy settings, if nil) to the application, honoring the applications
+// included and excluded by the proxy and its overrides for the application
+func (c *Configuration) ApplyProxyToApplication(a ProxifiedApplication, p *Proxy) *AppProxyChangeResult {
+	var result *AppProxyChangeResult
+	if p != nil {
+		if !p.AppliesToApplication(a.GetId()) {
+			Log.Debugf("Proxy %v is not applied to %v", p.Name, a.GetSimpleName())
+			return &AppProxyChangeResult{a, MyGettextv("Application excluded by proxy %v", p.Name), "", ""}
+		}
+		Log.Debugf("Applying proxy to %v", a.GetSimpleName())
+		applicationProxy := p.ForApplication(a.GetId())
+		if applicationProxy.NeedsCredentialSafeMode() {
+			result = c.ApplyCredentialSafeProxyToApplication(a, applicationProxy)
+		} else {
+			result = a.Apply(applicationProxy)
+		}
+	} else {
+		Log.Debugf("Removing proxy from %v", a.GetSimpleName())
+		result = a.Apply(nil)
+	}
+	if result.SkippedMessage == "" && result.ErrorMessage != "" {
+		Log.Errorf("Error applying proxy in application %v: %v.\n", a.GetSimpleName(), result.ErrorMessage)
+	}
+	return result
+}
 
-	exists, err := goutils.FileExists(AUTOSTART_FILE)
-	if err != nil {
-		return err
+// Applies the proxy without writing its password in the application settings: the native secret mechanism
+// of the application is used if available, and the local endpoints if not; as last resort, the proxy is
+// applied with the password and a warning is returned
+func (c *Configuration) ApplyCredentialSafeProxyToApplication(a ProxifiedApplication, p *Proxy) *AppProxyChangeResult {
+
+	if csa, ok := a.(CredentialSafeApplication); ok {
+		result := csa.ApplyCredentialSafe(p)
+		if result != nil {
+			return result
+		}
+		Log.Debugf("Native secret mechanism of %v not available", a.GetSimpleName())
 	}
 
-	if !exists {
-		return errors.New(MyGettextv("File %v doesn't exist", AUTOSTART_FILE))
+	endpoints, err := c.StartAuthProxies(p)
+	if err == nil {
+		Log.Debugf("Applying local endpoints of proxy %v to %v", p.Name, a.GetSimpleName())
+		localProxy, warning := p.ForLocalEndpoints(endpoints)
+		result := a.Apply(localProxy)
+		if warning != "" && result.SkippedMessage == "" && result.ErrorMessage == "" {
+			if result.WarningMessage != "" {
+				warning = result.WarningMessage + "; " + warning
+			}
+			result.WarningMessage = warning
+		}
+		return result
 	}
 
-	cfg, err := ini.LoadSources(ini.LoadOptions{IgnoreInlineComment: true}, AUTOSTART_FILE)
-	if err != nil {
-		return err
+	Log.Errorf("Error starting local endpoints of proxy %v: %v", p.Name, err)
+	result := a.Apply(p)
+	if result.SkippedMessage == "" && result.ErrorMessage == "" {
+		warning := MyGettextv("The proxy password has been written in plain text: %v", err)
+		if result.WarningMessage != "" {
+			warning = result.WarningMessage + "; " + warning
+		}
+		result.WarningMessage = warning
 	}
+	return result
+}
 
-	cfg.Section("Desktop Entry").Key("X-GNOME-Autostart-enabled").SetValue(map[bool]string{true: "true", false: "false"}[value])
-	err = cfg.SaveTo(AUTOSTART_FILE)
-	if err != nil {
-		return err
+// Starts (if not already running) the local endpoints that add the credentials of the proxy, and returns
+// them by scheme
+func (c *Configuration) StartAuthProxies(p *Proxy) (map[string]*ProxyEndpoint, error) {
+
+	if !c.ServeAuthProxies {
+		return nil, errors.New(MyGettextv("The local endpoints only run in the indicator or the daemon, and none of them is running"))
 	}
 
-	return nil
+	password, err := p.GetPassword()
+	if err != nil {
+		return nil, errors.Wrap(err, MyGettextv("Failed to get proxy password"))
+	}
+
+	endpoints := map[string]*ProxyEndpoint{}
+	for _, scheme := range []string{PROXY_SCHEME_HTTP, PROXY_SCHEME_HTTPS, PROXY_SCHEME_FTP} {
+		e := p.GetSchemeEndpoint(scheme)
+		upstream := net.JoinHostPort(e.Address, strconv.Itoa(e.Port))
+		s, ok := c.AuthProxies[upstream]
+		if ok && (s.Username != p.Username || s.Password != password) {
+			s.Stop()
+			delete(c.AuthProxies, upstream)
+			ok = false
+		}
+		if !ok {
+			port, err := c.GetFreeAuthProxyPort()
+			if err != nil {
+				return nil, err
+			}
+			s, err = StartAuthProxy(net.JoinHostPort("127.0.0.1", strconv.Itoa(port)), upstream, p.Username, password)
+			if err != nil {
+				return nil, err
+			}
+			c.AuthProxies[upstream] = s
+		}
+		_, port, _ := net.SplitHostPort(s.ListenAddress)
+		portNumber, _ := strconv.Atoi(port)
+		endpoints[scheme] = &ProxyEndpoint{Protocol: "http", Address: "127.0.0.1", Port: portNumber}
+	}
+	return endpoints, nil
 
 }
 
-func (c *Configuration) GetProxyPassword(p *goutils.Proxy) (string, error) {
+// Returns the local endpoints of the proxy if they are already running, by scheme
+func (c *Configuration) GetRunningAuthProxies(p *Proxy) (map[string]*ProxyEndpoint, bool) {
+	endpoints := map[string]*ProxyEndpoint{}
+	for _, scheme := range []string{PROXY_SCHEME_HTTP, PROXY_SCHEME_HTTPS, PROXY_SCHEME_FTP} {
+		e := p.GetSchemeEndpoint(scheme)
+		s, ok := c.AuthProxies[net.JoinHostPort(e.Address, strconv.Itoa(e.Port))]
+		if !ok || s.Username != p.Username {
+			return nil, false
+		}
+		_, port, _ := net.SplitHostPort(s.ListenAddress)
+		portNumber, _ := strconv.Atoi(port)
+		endpoints[scheme] = &ProxyEndpoint{Protocol: "http", Address: "127.0.0.1", Port: portNumber}
+	}
+	return endpoints, true
+}
+
+// Returns the environment variables for the proxy; for proxies in credential-safe mode, the local endpoints
+// are used if they are running (they only live in the indicator), and the variables include the password
+// and a warning is returned if not; a warning is also returned if the SOCKS endpoint is not included
+func (c *Configuration) GetProxyEnvironment(p *Proxy) ([]*EnvironmentVariable, string, error) {
+	var warning string
+	if p.NeedsCredentialSafeMode() {
+		endpoints, running := c.GetRunningAuthProxies(p)
+		if running {
+			p, warning = p.ForLocalEndpoints(endpoints)
+		} else {
+			warning = MyGettextv("The local endpoints of proxy %v are not running, so the variables include the password in plain text", p.Name)
+		}
+	}
+	variables, err := ProxyEnvironmentVariables(p)
+	if err != nil {
+		return nil, "", errors.Wrap(err, MyGettextv("Failed to get proxy URL"))
+	}
+	return variables, warning, nil
+}
+
+// Writes the slug of the active proxy and the time of the change, so the shell hooks can detect the changes
+// without running this application
+func (c *Configuration) WriteActiveProxyState() error {
+	var activeSlug string
+	if c.ActiveProxy != nil {
+		activeSlug = c.ActiveProxy.Slug
+	}
+	err := os.MkdirAll(path.Dir(ACTIVE_PROXY_STATE_FILE), 0755)
+	if err != nil {
+		return err
+	}
+	return SafeWriteFile(ACTIVE_PROXY_STATE_FILE, []byte(fmt.Sprintf("%v %v\n", activeSlug, time.Now().UnixNano())), 0644)
+}
+
+// Returns the first port, starting from AuthProxyPort, not used by other local endpoint
+func (c *Configuration) GetFreeAuthProxyPort() (int, error) {
+	usedAddresses := []string{}
+	for _, s := range c.AuthProxies {
+		usedAddresses = append(usedAddresses, s.ListenAddress)
+	}
+	for port := c.AuthProxyPort; port < c.AuthProxyPort+100; port++ {
+		if !goutils.ListContainsString(usedAddresses, net.JoinHostPort("127.0.0.1", strconv.Itoa(port))) {
+			return port, nil
+		}
+	}
+	return 0, errors.New(MyGettextv("No free port found for the local endpoint"))
+}
+
+func (c *Configuration) StopAuthProxies() {
+	for upstream, s := range c.AuthProxies {
+		err := s.Stop()
+		if err != nil {
+			Log.Errorf("Error stopping local endpoint %v: %v", s.ListenAddress, err)
+		}
+		delete(c.AuthProxies, upstream)
+	}
+}
+
+var pluginApplicationsRegistered bool
+
+// Registers the plugins found in the directory PLUGINS_DIR; only the first call has effect.
+func RegisterPluginApplications() {
+	if pluginApplicationsRegistered {
+		return
+	}
+	pluginApplicationsRegistered = true
+	for _, a := range LoadPluginProxySettersFromDir(PLUGINS_DIR) {
+		if GetProxifiedApplication(a.GetId()) != nil {
+			Log.Errorf("Ignoring plugin %v because there is already an application with id %v", a.Executable, a.GetId())
+			continue
+		}
+		RegisterProxifiedApplication(a)
+	}
+}
+
+// Registers the custom applications defined in the configuration and in the directory CUSTOM_APPS_DIR,
+// replacing the ones previously registered. Applications with invalid definitions or with the id of an
+// already registered application are logged and ignored.
+func (c *Configuration) LoadCustomApplications(definitions []*goutils.MapHelper) {
+
+	for _, a := range c.CustomApplications {
+		UnregisterProxifiedApplication(a.GetId())
+	}
+	c.CustomApplications = []*CustomProxySetter{}
+
+	apps := []*CustomProxySetter{}
+	for i, h := range definitions {
+		a, err := NewCustomProxySetterFromMap(h, "")
+		if err != nil {
+			Log.Errorf("Error loading custom application %v from configuration: %v", i+1, err)
+			continue
+		}
+		apps = append(apps, a)
+	}
+	apps = append(apps, LoadCustomProxySettersFromDir(CUSTOM_APPS_DIR)...)
+
+	for _, a := range apps {
+		if GetProxifiedApplication(a.GetId()) != nil {
+			Log.Errorf("Ignoring custom application %v because there is already an application with that id", a.GetId())
+			continue
+		}
+		RegisterProxifiedApplication(a)
+		c.CustomApplications = append(c.CustomApplications, a)
+	}
+
+}
+
+func (c *Configuration) GetPassword(uuid string) (string, error) {
+	return c.SecretStore.Get(uuid)
+}
+
+func (c *Configuration) SetPassword(uuid string, password string) error {
+	return c.SecretStore.Set(uuid, password)
+}
+
+func (c *Configuration) DeletePassword(uuid string) error {
+	return c.SecretStore.Delete(uuid)
+}
+
+// Moves the passwords of all the proxies to a new secret store, and saves the configuration to use it; the
+// passwords are deleted from the old store only after the configuration is saved, so they are never lost
+func (c *Configuration) MigrateSecretStore(storeType string, options map[string]string) (int, error) {
+
+	newStore, err := NewSecretStore(storeType, options)
+	if err != nil {
+		return 0, err
+	}
+
+	uuids := []string{}
+	for _, p := range c.Proxies {
+		uuids = append(uuids, p.UUID)
+	}
+	migrated, err := CopySecrets(c.SecretStore, newStore, uuids)
+	if err != nil {
+		return 0, err
+	}
+
+	if options == nil {
+		options = map[string]string{}
+	}
+	oldStoreType, oldStoreOptions, oldStore := c.SecretStoreType, c.SecretStoreOptions, c.SecretStore
+	c.SecretStoreType = newStore.GetType()
+	c.SecretStoreOptions = options
+	c.SecretStore = newStore
+
+	err = c.Save(MyGettextv("Passwords migrated to secret store %v", storeType))
+	if err != nil {
+		// The configuration still uses the old store, that keeps the passwords
+		c.SecretStoreType, c.SecretStoreOptions, c.SecretStore = oldStoreType, oldStoreOptions, oldStore
+		DeleteSecrets(newStore, uuids)
+		return 0, errors.Wrap(err, MyGettextv("Error saving the configuration; the passwords are kept in the old store"))
+	}
+
+	DeleteSecrets(oldStore, uuids)
+	return migrated, nil
+
+}
+
+func (c *Configuration) SetShowCurrentProxyNameNextToIndicator(value bool) {
+	c.ShowCurrentProxyNameNextToIndicator = value
+	c.NotifyListeners(func(l ConfigListener) {
+		l.OnShowProxyNameNextToIndicatorChanged(value)
+	})
+}
+
+func (c *Configuration) GetIndicatorAutostart() (bool, error) {
+
+	exists, err := goutils.FileExists(AUTOSTART_FILE)
+	if err != nil {
+		return false, err
+	}
+
+	if !exists {
+		return false, errors.New(MyGettextv("File %v doesn't exist", AUTOSTART_FILE))
+	}
+
+	cfg, err := ini.LoadSources(ini.LoadOptions{IgnoreInlineComment: true}, AUTOSTART_FILE)
+	if err != nil {
+		return false, err
+	}
+
+	section := cfg.Section("Desktop Entry")
+	key, err := section.GetKey("X-GNOME-Autostart-enabled")
+	if err != nil {
+		// If section doesn't exists, the default value is true
+		return true, nil
+	}
+
+	val, err := key.Bool()
+	if err != nil {
+		return false, err
+	}
+
+	return val, nil
+
+}
+
+func (c *Configuration) SetIndicatorAutostart(value bool) error {
+
+	exists, err := goutils.FileExists(AUTOSTART_FILE)
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		return errors.New(MyGettextv("File %v doesn't exist", AUTOSTART_FILE))
+	}
+
+	cfg, err := ini.LoadSources(ini.LoadOptions{IgnoreInlineComment: true}, AUTOSTART_FILE)
+	if err != nil {
+		return err
+	}
+
+	cfg.Section("Desktop Entry").Key("X-GNOME-Autostart-enabled").SetValue(map[bool]string{true: "true", false: "false"}[value])
+	err = SafeSaveFile(AUTOSTART_FILE, 0644, false, cfg.SaveTo)
+	if err != nil {
+		return err
+	}
+
+	return nil
+
+}
+
+func (c *Configuration) GetProxyPassword(p *goutils.Proxy) (string, error) {
+	// Copies of the proxy using the local endpoints have no credentials
+	if p.Username == "" {
+		return "", nil
+	}
 	password, err := c.GetPassword(p.UUID)
 	if err != nil {
 		return "", err
@@ -910,31 +1456,43 @@ func (c *Configuration) SetProxyForIps(ips []string) {
 }
 
 func (c *Configuration) SetEnableAutoChangeByIp(value bool) {
+	if c.IsPolicyLocked("enable_auto_change_by_ip") {
+		Log.Warningf("Not changing enable_auto_change_by_ip; it is forced by the system configuration")
+		return
+	}
 	c.EnableAutoChangeByIp = value
-	for _, l := range c.Listeners {
+	c.NotifyListeners(func(l ConfigListener) {
 		l.OnEnableAutoChangeByIpChanged(value)
-	}
+	})
 }
 
 func (c *Configuration) SetEnableUpdateCheck(value bool) {
+	if c.IsPolicyLocked("enable_update_check") {
+		Log.Warningf("Not changing enable_update_check; it is forced by the system configuration")
+		return
+	}
 	c.EnableUpdateCheck = value
-	for _, l := range c.Listeners {
+	c.NotifyListeners(func(l ConfigListener) {
 		l.OnEnableUpdateCheckChanged(value)
-	}
+	})
 }
 
 func (c *Configuration) SetWhatToDoWhenNoIpMatches(value string) {
+	if c.IsPolicyLocked("what_to_do_when_no_ip_matches") {
+		Log.Warningf("Not changing what_to_do_when_no_ip_matches; it is forced by the system configuration")
+		return
+	}
 	c.WhatToDoWhenNoIpMatches = value
-	for _, l := range c.Listeners {
+	c.NotifyListeners(func(l ConfigListener) {
 		l.OnWhatToDoWhenNoIpMatchesChanged(value)
-	}
+	})
 }
 
 func (c *Configuration) SetLogLevel(value loggo.Level) {
 	c.LogLevel = value
-	for _, l := range c.Listeners {
+	c.NotifyListeners(func(l ConfigListener) {
 		l.OnLogLevelChanged(value)
-	}
+	})
 }
 
 // ------------------------------------------------------------------------------------------
@@ -943,6 +1501,9 @@ func (c *Configuration) SetLogLevel(value loggo.Level) {
 
 func (c *Configuration) ListProxies(includePasswords bool) (string, *dbus.Error) {
 
+	c.BeginUpdate()
+	defer c.EndUpdate()
+
 	var err error
 	Log.Debugf("Received dbus request to ListProxies...")
 	response := ListProxiesResponse{}
@@ -969,6 +1530,10 @@ func (c *Configuration) ListProxies(includePasswords bool) (string, *dbus.Error)
 			Exceptions:  v.Exceptions,
 			MatchingIps: v.MatchingIps,
 			Active:      c.ActiveProxy == v,
+			Locked:      v.Locked,
+
+			IncludedApplications: v.IncludedApplicationsIds,
+			ExcludedApplications: v.ExcludedApplicationsIds,
 		}
 		response.Proxies = append(response.Proxies, p)
 	}
@@ -984,6 +1549,9 @@ func (c *Configuration) ListProxies(includePasswords bool) (string, *dbus.Error)
 
 func (c *Configuration) ApplyActiveProxy() (string, *dbus.Error) {
 
+	c.BeginUpdate()
+	defer c.EndUpdate()
+
 	Log.Debugf("Received dbus request to ApplyActiveProxy...")
 	response := ApplyActiveProxyResponse{}
 
@@ -1008,6 +1576,9 @@ func (c *Configuration) ApplyActiveProxy() (string, *dbus.Error) {
 
 func (c *Configuration) GetActiveProxySlug() (string, *dbus.Error) {
 
+	c.BeginUpdate()
+	defer c.EndUpdate()
+
 	Log.Debugf("Received dbus request to GetActiveProxySlug...")
 	response := GetActiveProxySlugResponse{}
 
@@ -1024,8 +1595,73 @@ func (c *Configuration) GetActiveProxySlug() (string, *dbus.Error) {
 
 }
 
+func (c *Configuration) GetActiveProxyEnvironment() (string, *dbus.Error) {
+
+	c.BeginUpdate()
+	defer c.EndUpdate()
+
+	Log.Debugf("Received dbus request to GetActiveProxyEnvironment...")
+	response := ProxyEnvironmentResponse{}
+
+	if c.ActiveProxy != nil {
+		response.Slug = c.ActiveProxy.Slug
+		variables, warning, err := c.GetProxyEnvironment(c.ActiveProxy)
+		if err != nil {
+			response.Error = err.Error()
+		} else {
+			response.Variables = variables
+			response.Warning = warning
+		}
+	}
+
+	b, err := json.Marshal(response)
+	if err != nil {
+		return "", dbus.NewError("Error marshaling", nil)
+	}
+
+	return string(b), nil
+
+}
+
+// Returns the environment of the proxy with the slug, without activating it; "none" returns no variables
+func (c *Configuration) GetProxyEnvironmentBySlug(slug string) (string, *dbus.Error) {
+
+	c.BeginUpdate()
+	defer c.EndUpdate()
+
+	Log.Debugf("Received dbus request to GetProxyEnvironmentBySlug...")
+	response := ProxyEnvironmentResponse{}
+
+	proxy := c.GetProxyWithSlug(slug)
+	if slug != "none" {
+		if proxy == nil {
+			response.Error = MyGettextv("Proxy with slug %v not found", slug)
+		} else {
+			response.Slug = proxy.Slug
+			variables, warning, err := c.GetProxyEnvironment(proxy)
+			if err != nil {
+				response.Error = err.Error()
+			} else {
+				response.Variables = variables
+				response.Warning = warning
+			}
+		}
+	}
+
+	b, err := json.Marshal(response)
+	if err != nil {
+		return "", dbus.NewError("Error marshaling", nil)
+	}
+
+	return string(b), nil
+
+}
+
 func (c *Configuration) SetActiveProxyBySlug(slug string) (string, *dbus.Error) {
 
+	c.BeginUpdate()
+	defer c.EndUpdate()
+
 	Log.Debugf("Received dbus request to SetActiveProxyBySlug...")
 	response := SetActiveProxyBySlugResponse{}
 
@@ -1054,3 +1690,260 @@ func (c *Configuration) SetActiveProxyBySlug(slug string) (string, *dbus.Error)
 	return string(b), nil
 
 }
+
+func (c *Configuration) ListApplications() (string, *dbus.Error) {
+
+	c.BeginUpdate()
+	defer c.EndUpdate()
+
+	Log.Debugf("Received dbus request to ListApplications...")
+	response := ListApplicationsResponse{}
+
+	response.Applications = []ApplicationStruct{}
+	for _, a := range ProxifiedApplications {
+		installed, version, configPaths := a.Detect()
+		app := ApplicationStruct{
+			Id:          a.GetId(),
+			Name:        a.GetSimpleName(),
+			Description: a.GetDescription(),
+			Homepage:    a.GetHomepage(),
+			Enabled:     c.IsApplicationEnabled(a.GetId()),
+			Locked:      c.IsApplicationLocked(a.GetId()),
+			Installed:   installed,
+			Version:     version,
+			ConfigPaths: configPaths,
+		}
+		if sa, ok := a.(ProxyStatusApplication); ok && app.Enabled && installed {
+			// Checked against the same proxy that is applied to the application
+			if p := c.ActiveProxy; p == nil || p.AppliesToApplication(a.GetId()) {
+				if p != nil {
+					p = p.ForApplication(a.GetId())
+				}
+				app.Status = NewApplicationResultStruct(sa.Status(p))
+			}
+		}
+		response.Applications = append(response.Applications, app)
+	}
+
+	b, err := json.Marshal(response)
+	if err != nil {
+		return "", dbus.NewError("Error marshaling", nil)
+	}
+
+	return string(b), nil
+
+}
+
+func (c *Configuration) EnableApplicationById(id string, apply bool) (string, *dbus.Error) {
+
+	c.BeginUpdate()
+	defer c.EndUpdate()
+
+	Log.Debugf("Received dbus request to EnableApplicationById...")
+	response := SetApplicationEnabledResponse{}
+
+	result, err := c.SetApplicationEnabled(id, true, apply)
+	if err != nil {
+		response.Error = err.Error()
+	} else if result != nil {
+		response.Result = NewApplicationResultStruct(result)
+	}
+
+	b, err := json.Marshal(response)
+	if err != nil {
+		return "", dbus.NewError("Error marshaling", nil)
+	}
+
+	return string(b), nil
+
+}
+
+func (c *Configuration) DisableApplicationById(id string, clean bool) (string, *dbus.Error) {
+
+	c.BeginUpdate()
+	defer c.EndUpdate()
+
+	Log.Debugf("Received dbus request to DisableApplicationById...")
+	response := SetApplicationEnabledResponse{}
+
+	result, err := c.SetApplicationEnabled(id, false, clean)
+	if err != nil {
+		response.Error = err.Error()
+	} else if result != nil {
+		response.Result = NewApplicationResultStruct(result)
+	}
+
+	b, err := json.Marshal(response)
+	if err != nil {
+		return "", dbus.NewError("Error marshaling", nil)
+	}
+
+	return string(b), nil
+
+}
+
+func (c *Configuration) ApplyActiveProxyToApplications(ids []string) (string, *dbus.Error) {
+
+	c.BeginUpdate()
+	defer c.EndUpdate()
+
+	Log.Debugf("Received dbus request to ApplyActiveProxyToApplications...")
+	response := ApplyToApplicationsResponse{}
+
+	results, err := c.ApplyToApplications(ids, false, MyGettextv("Proxy applied from D-Bus"))
+	if err != nil {
+		response.Error = err.Error()
+	} else {
+		response.Results = NewApplicationResultStructs(results)
+	}
+
+	b, err := json.Marshal(response)
+	if err != nil {
+		return "", dbus.NewError("Error marshaling", nil)
+	}
+
+	return string(b), nil
+
+}
+
+func (c *Configuration) ClearApplications(ids []string) (string, *dbus.Error) {
+
+	c.BeginUpdate()
+	defer c.EndUpdate()
+
+	Log.Debugf("Received dbus request to ClearApplications...")
+	response := ApplyToApplicationsResponse{}
+
+	results, err := c.ApplyToApplications(ids, true, MyGettextv("Proxy cleared from D-Bus"))
+	if err != nil {
+		response.Error = err.Error()
+	} else {
+		response.Results = NewApplicationResultStructs(results)
+	}
+
+	b, err := json.Marshal(response)
+	if err != nil {
+		return "", dbus.NewError("Error marshaling", nil)
+	}
+
+	return string(b), nil
+
+}
+
+func (c *Configuration) MigrateSecrets(storeType string, options map[string]string) (string, *dbus.Error) {
+
+	c.BeginUpdate()
+	defer c.EndUpdate()
+
+	Log.Debugf("Received dbus request to MigrateSecrets...")
+	response := MigrateSecretsResponse{}
+
+	migrated, err := c.MigrateSecretStore(storeType, options)
+	response.Migrated = migrated
+	if err != nil {
+		response.Error = err.Error()
+	}
+
+	b, err := json.Marshal(response)
+	if err != nil {
+		return "", dbus.NewError("Error marshaling", nil)
+	}
+
+	return string(b), nil
+
+}
+
+func (c *Configuration) ImportConfiguration(filename string, passphrase string, mode string, withSettings bool, dryRun bool, resolutions map[string]string) (string, *dbus.Error) {
+
+	c.BeginUpdate()
+	defer c.EndUpdate()
+
+	Log.Debugf("Received dbus request to ImportConfiguration...")
+	response := ImportConfigurationResponse{}
+
+	entries, err := c.ImportFile(filename, passphrase, mode, withSettings, dryRun, resolutions)
+	response.Entries = NewImportEntryStructs(entries)
+	if err != nil {
+		response.Error = err.Error()
+	}
+
+	b, err := json.Marshal(response)
+	if err != nil {
+		return "", dbus.NewError("Error marshaling", nil)
+	}
+
+	return string(b), nil
+
+}
+
+func (c *Configuration) ExportConfiguration(includePasswords bool, passphrase string, slugs []string, settingsOnly bool) (string, *dbus.Error) {
+
+	c.BeginUpdate()
+	defer c.EndUpdate()
+
+	Log.Debugf("Received dbus request to ExportConfiguration...")
+	response := ExportConfigurationResponse{}
+
+	data, err := c.ExportJson(&ExportOptions{
+		IncludePasswords: includePasswords,
+		Passphrase:       passphrase,
+		ProxiesSlugs:     slugs,
+		SettingsOnly:     settingsOnly,
+	})
+	if err != nil {
+		response.Error = err.Error()
+	} else {
+		response.Data = string(data)
+	}
+
+	b, err := json.Marshal(response)
+	if err != nil {
+		return "", dbus.NewError("Error marshaling", nil)
+	}
+
+	return string(b), nil
+
+}
+
+func (c *Configuration) DiscoverProxies(add bool, keys []string) (string, *dbus.Error) {
+
+	c.BeginUpdate()
+	defer c.EndUpdate()
+
+	Log.Debugf("Received dbus request to DiscoverProxies...")
+	response := DiscoverProxiesResponse{}
+
+	proxies := c.GetDiscoveredProxies()
+	if add {
+		toAdd := proxies
+		var err error
+		if len(keys) > 0 {
+			toAdd, err = FilterDiscoveredProxies(proxies, keys)
+		}
+		if err == nil {
+			var added []*Proxy
+			added, err = c.AddDiscoveredProxies(toAdd)
+			for _, p := range added {
+				response.Added = append(response.Added, p.Name)
+			}
+		}
+		if err != nil {
+			response.Error = err.Error()
+		}
+		// Show which proxies are configured now
+		for _, d := range proxies {
+			if p := c.GetProxyMatchingDiscovered(d); p != nil {
+				d.Existing = p.Name
+			}
+		}
+	}
+	response.Proxies = NewDiscoveredProxyStructs(proxies)
+
+	b, err := json.Marshal(response)
+	if err != nil {
+		return "", dbus.NewError("Error marshaling", nil)
+	}
+
+	return string(b), nil
+
+}
@@ -91,3 +91,212 @@ func (c *ConfigDbus) SetActiveProxyBySlug(slug string) (string, *dbus.Error) {
 	return ret, nil
 
 }
+
+func (c *ConfigDbus) ListApplications() (string, *dbus.Error) {
+
+	obj := c.DBusConnection.Object(DBUS_INTERFACE, DBUS_PATH)
+
+	call := obj.Call(fmt.Sprintf("%v.%v", DBUS_INTERFACE, "ListApplications"), 0)
+	if call.Err != nil {
+		return "", dbus.NewError(call.Err.Error(), nil)
+	}
+
+	var ret string
+	err := call.Store(&ret)
+	if err != nil {
+		return "", dbus.NewError(call.Err.Error(), nil)
+	}
+
+	return ret, nil
+
+}
+
+func (c *ConfigDbus) EnableApplicationById(id string, apply bool) (string, *dbus.Error) {
+
+	obj := c.DBusConnection.Object(DBUS_INTERFACE, DBUS_PATH)
+
+	call := obj.Call(fmt.Sprintf("%v.%v", DBUS_INTERFACE, "EnableApplicationById"), 0, id, apply)
+	if call.Err != nil {
+		return "", dbus.NewError(call.Err.Error(), nil)
+	}
+
+	var ret string
+	err := call.Store(&ret)
+	if err != nil {
+		return "", dbus.NewError(call.Err.Error(), nil)
+	}
+
+	return ret, nil
+
+}
+
+func (c *ConfigDbus) DisableApplicationById(id string, clean bool) (string, *dbus.Error) {
+
+	obj := c.DBusConnection.Object(DBUS_INTERFACE, DBUS_PATH)
+
+	call := obj.Call(fmt.Sprintf("%v.%v", DBUS_INTERFACE, "DisableApplicationById"), 0, id, clean)
+	if call.Err != nil {
+		return "", dbus.NewError(call.Err.Error(), nil)
+	}
+
+	var ret string
+	err := call.Store(&ret)
+	if err != nil {
+		return "", dbus.NewError(call.Err.Error(), nil)
+	}
+
+	return ret, nil
+
+}
+
+func (c *ConfigDbus) ApplyActiveProxyToApplications(ids []string) (string, *dbus.Error) {
+
+	obj := c.DBusConnection.Object(DBUS_INTERFACE, DBUS_PATH)
+
+	call := obj.Call(fmt.Sprintf("%v.%v", DBUS_INTERFACE, "ApplyActiveProxyToApplications"), 0, ids)
+	if call.Err != nil {
+		return "", dbus.NewError(call.Err.Error(), nil)
+	}
+
+	var ret string
+	err := call.Store(&ret)
+	if err != nil {
+		return "", dbus.NewError(call.Err.Error(), nil)
+	}
+
+	return ret, nil
+
+}
+
+func (c *ConfigDbus) ClearApplications(ids []string) (string, *dbus.Error) {
+
+	obj := c.DBusConnection.Object(DBUS_INTERFACE, DBUS_PATH)
+
+	call := obj.Call(fmt.Sprintf("%v.%v", DBUS_INTERFACE, "ClearApplications"), 0, ids)
+	if call.Err != nil {
+		return "", dbus.NewError(call.Err.Error(), nil)
+	}
+
+	var ret string
+	err := call.Store(&ret)
+	if err != nil {
+		return "", dbus.NewError(call.Err.Error(), nil)
+	}
+
+	return ret, nil
+
+}
+
+func (c *ConfigDbus) MigrateSecrets(storeType string, options map[string]string) (string, *dbus.Error) {
+
+	obj := c.DBusConnection.Object(DBUS_INTERFACE, DBUS_PATH)
+
+	call := obj.Call(fmt.Sprintf("%v.%v", DBUS_INTERFACE, "MigrateSecrets"), 0, storeType, options)
+	if call.Err != nil {
+		return "", dbus.NewError(call.Err.Error(), nil)
+	}
+
+	var ret string
+	err := call.Store(&ret)
+	if err != nil {
+		return "", dbus.NewError(call.Err.Error(), nil)
+	}
+
+	return ret, nil
+
+}
+
+func (c *ConfigDbus) GetActiveProxyEnvironment() (string, *dbus.Error) {
+
+	obj := c.DBusConnection.Object(DBUS_INTERFACE, DBUS_PATH)
+
+	call := obj.Call(fmt.Sprintf("%v.%v", DBUS_INTERFACE, "GetActiveProxyEnvironment"), 0)
+	if call.Err != nil {
+		return "", dbus.NewError(call.Err.Error(), nil)
+	}
+
+	var ret string
+	err := call.Store(&ret)
+	if err != nil {
+		return "", dbus.NewError(call.Err.Error(), nil)
+	}
+
+	return ret, nil
+
+}
+
+func (c *ConfigDbus) GetProxyEnvironmentBySlug(slug string) (string, *dbus.Error) {
+
+	obj := c.DBusConnection.Object(DBUS_INTERFACE, DBUS_PATH)
+
+	call := obj.Call(fmt.Sprintf("%v.%v", DBUS_INTERFACE, "GetProxyEnvironmentBySlug"), 0, slug)
+	if call.Err != nil {
+		return "", dbus.NewError(call.Err.Error(), nil)
+	}
+
+	var ret string
+	err := call.Store(&ret)
+	if err != nil {
+		return "", dbus.NewError(call.Err.Error(), nil)
+	}
+
+	return ret, nil
+
+}
+
+func (c *ConfigDbus) ImportConfiguration(filename string, passphrase string, mode string, withSettings bool, dryRun bool, resolutions map[string]string) (string, *dbus.Error) {
+
+	obj := c.DBusConnection.Object(DBUS_INTERFACE, DBUS_PATH)
+
+	call := obj.Call(fmt.Sprintf("%v.%v", DBUS_INTERFACE, "ImportConfiguration"), 0, filename, passphrase, mode, withSettings, dryRun, resolutions)
+	if call.Err != nil {
+		return "", dbus.NewError(call.Err.Error(), nil)
+	}
+
+	var ret string
+	err := call.Store(&ret)
+	if err != nil {
+		return "", dbus.NewError(call.Err.Error(), nil)
+	}
+
+	return ret, nil
+
+}
+
+func (c *ConfigDbus) ExportConfiguration(includePasswords bool, passphrase string, slugs []string, settingsOnly bool) (string, *dbus.Error) {
+
+	obj := c.DBusConnection.Object(DBUS_INTERFACE, DBUS_PATH)
+
+	call := obj.Call(fmt.Sprintf("%v.%v", DBUS_INTERFACE, "ExportConfiguration"), 0, includePasswords, passphrase, slugs, settingsOnly)
+	if call.Err != nil {
+		return "", dbus.NewError(call.Err.Error(), nil)
+	}
+
+	var ret string
+	err := call.Store(&ret)
+	if err != nil {
+		return "", dbus.NewError(call.Err.Error(), nil)
+	}
+
+	return ret, nil
+
+}
+
+func (c *ConfigDbus) DiscoverProxies(add bool, keys []string) (string, *dbus.Error) {
+
+	obj := c.DBusConnection.Object(DBUS_INTERFACE, DBUS_PATH)
+
+	call := obj.Call(fmt.Sprintf("%v.%v", DBUS_INTERFACE, "DiscoverProxies"), 0, add, keys)
+	if call.Err != nil {
+		return "", dbus.NewError(call.Err.Error(), nil)
+	}
+
+	var ret string
+	err := call.Store(&ret)
+	if err != nil {
+		return "", dbus.NewError(call.Err.Error(), nil)
+	}
+
+	return ret, nil
+
+}
@@ -0,0 +1,223 @@
+package proxychangerlib
+
+import (
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/okelet/goutils"
+	"github.com/pkg/errors"
+)
+
+// Ports used when the proxy URL of an application doesn't have one
+var DISCOVER_DEFAULT_PORTS = map[string]int{
+	"http":    80,
+	"https":   443,
+	"socks":   1080,
+	"socks4":  1080,
+	"socks4a": 1080,
+	"socks5":  1080,
+	"socks5h": 1080,
+}
+
+// Proxy found in the settings of the applications, that can be added to the configuration
+type DiscoveredProxy struct {
+	// Ids of the applications that use this proxy
+	Sources    []string
+	Protocol   string
+	Address    string
+	Port       int
+	Username   string
+	Password   string
+	Exceptions []string
+	// Name of the configured proxy with the same protocol, address, port and username, if any
+	Existing string
+}
+
+// Creates a discovered proxy from the URL found in the settings of the application; the URL can be just
+// host:port, like in the environment variables
+func ParseDiscoveredProxy(source string, rawUrl string, exceptions []string) (*DiscoveredProxy, error) {
+
+	rawUrl = strings.TrimSpace(rawUrl)
+	if !strings.Contains(rawUrl, "://") {
+		rawUrl = "http://" + rawUrl
+	}
+	// The URL is not included in the errors, as it can have the password
+	u, err := url.Parse(rawUrl)
+	if err != nil || u.Hostname() == "" {
+		return nil, errors.New(MyGettextv("Invalid proxy URL in application %v", source))
+	}
+
+	d := DiscoveredProxy{
+		Sources:    []string{source},
+		Protocol:   strings.ToLower(u.Scheme),
+		Address:    u.Hostname(),
+		Exceptions: []string{},
+	}
+	if u.Port() != "" {
+		d.Port, err = strconv.Atoi(u.Port())
+		if err != nil || d.Port <= 0 || d.Port >= 65535 {
+			return nil, errors.New(MyGettextv("Invalid proxy port %v in application %v", u.Port(), source))
+		}
+	} else if port, ok := DISCOVER_DEFAULT_PORTS[d.Protocol]; ok {
+		d.Port = port
+	} else {
+		return nil, errors.New(MyGettextv("Proxy without port in application %v", source))
+	}
+	if u.User != nil {
+		d.Username = u.User.Username()
+		d.Password, _ = u.User.Password()
+	}
+	for _, e := range exceptions {
+		if e = strings.TrimSpace(e); e != "" {
+			d.Exceptions = goutils.AddStringToList(d.Exceptions, e)
+		}
+	}
+	return &d, nil
+
+}
+
+// Identifies the proxy; the proxies with the same protocol, address, port and username are the same proxy
+func (d *DiscoveredProxy) Key() string {
+	u := url.URL{Scheme: d.Protocol, Host: net.JoinHostPort(d.Address, strconv.Itoa(d.Port))}
+	if d.Username != "" {
+		u.User = url.User(d.Username)
+	}
+	return u.String()
+}
+
+// Returns the name of the proxy to create, from the first application where it was found
+func (d *DiscoveredProxy) GetDefaultName() string {
+	if len(d.Sources) > 0 {
+		if a := GetProxifiedApplication(d.Sources[0]); a != nil {
+			return MyGettextv("%v imported", a.GetSimpleName())
+		}
+	}
+	return MyGettextv("Discovered proxy")
+}
+
+// Adds the proxy to the list, or merges its sources, exceptions and password with the same proxy already in it
+func MergeDiscoveredProxy(proxies []*DiscoveredProxy, d *DiscoveredProxy) []*DiscoveredProxy {
+	for _, existing := range proxies {
+		if existing.Key() == d.Key() {
+			for _, s := range d.Sources {
+				existing.Sources = goutils.AddStringToList(existing.Sources, s)
+			}
+			for _, e := range d.Exceptions {
+				existing.Exceptions = goutils.AddStringToList(existing.Exceptions, e)
+			}
+			if existing.Password == "" {
+				existing.Password = d.Password
+			}
+			return proxies
+		}
+	}
+	return append(proxies, d)
+}
+
+// Reads the proxy settings of all the applications that support it; the errors of an application are logged, and
+// the rest of applications are read anyway
+func DiscoverProxies() []*DiscoveredProxy {
+	proxies := []*DiscoveredProxy{}
+	for _, a := range ProxifiedApplications {
+		reader, ok := a.(ProxyReaderApplication)
+		if !ok {
+			continue
+		}
+		found, err := reader.ReadProxies()
+		if err != nil {
+			Log.Errorf("Error reading the proxy settings of application %v: %v", a.GetId(), err)
+			continue
+		}
+		for _, d := range found {
+			proxies = MergeDiscoveredProxy(proxies, d)
+		}
+	}
+	return proxies
+}
+
+// Discovers the proxies of the applications, setting the configured proxy that matches each one, if any
+func (c *Configuration) GetDiscoveredProxies() []*DiscoveredProxy {
+	proxies := DiscoverProxies()
+	for _, d := range proxies {
+		if p := c.GetProxyMatchingDiscovered(d); p != nil {
+			d.Existing = p.Name
+		}
+	}
+	return proxies
+}
+
+func (c *Configuration) GetProxyMatchingDiscovered(d *DiscoveredProxy) *Proxy {
+	for _, p := range c.Proxies {
+		if p.Protocol == d.Protocol && p.Address == d.Address && p.Port == d.Port && p.Username == d.Username {
+			return p
+		}
+	}
+	return nil
+}
+
+// Creates a proxy from each discovered proxy, moving its password to the secret store, and saves the
+// configuration; the proxies already configured are skipped
+func (c *Configuration) AddDiscoveredProxies(proxies []*DiscoveredProxy) ([]*Proxy, error) {
+
+	added := []*Proxy{}
+	for _, d := range proxies {
+
+		if p := c.GetProxyMatchingDiscovered(d); p != nil {
+			Log.Infof("Not adding discovered proxy %v; it is already configured as %v", d.Key(), p.Name)
+			continue
+		}
+
+		h := goutils.NewEmptyMapHelper()
+		h.SetString("protocol", d.Protocol)
+		h.SetString("address", d.Address)
+		h.SetInt("port", d.Port)
+		h.SetString("username", d.Username)
+		h.SetListOfStrings("exceptions", d.Exceptions)
+		p := NewImportedProxy(c, goutils.NewProxyFromMap(h, c, false), c.CreateUniqueName(d.GetDefaultName(), nil), "")
+
+		if d.Password != "" {
+			err := c.SetPassword(p.UUID, d.Password)
+			if err != nil {
+				return added, errors.Wrap(err, MyGettextv("Error saving password in secret store"))
+			}
+		}
+
+		Log.Infof("Adding discovered proxy %v as %v", d.Key(), p.Name)
+		err, _ := c.AddProxy(false, p)
+		if err != nil {
+			return added, errors.Wrap(err, MyGettextv("Error adding proxy %v", p.Name))
+		}
+		added = append(added, p)
+
+	}
+
+	if len(added) > 0 {
+		err := c.Save(MyGettextv("Discovered proxies added"))
+		if err != nil {
+			return added, err
+		}
+	}
+	return added, nil
+
+}
+
+// Returns the discovered proxies with the keys; an error is returned if a key is not found
+func FilterDiscoveredProxies(proxies []*DiscoveredProxy, keys []string) ([]*DiscoveredProxy, error) {
+	filtered := []*DiscoveredProxy{}
+	for _, key := range keys {
+		found := false
+		for _, d := range proxies {
+			if d.Key() == key {
+				filtered = append(filtered, d)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, errors.New(MyGettextv("Discovered proxy %v not found", key))
+		}
+	}
+	return filtered, nil
+}
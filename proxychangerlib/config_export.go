@@ -0,0 +1,279 @@
+package proxychangerlib
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"os"
+
+	"github.com/okelet/goutils"
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/scrypt"
+)
+
+// Environment variable with the passphrase of the exported passwords, to export and import without prompting
+const EXPORT_PASSPHRASE_ENV = "PROXYCHANGER_PASSPHRASE"
+
+// The key that encrypts the exported passwords is derived from the passphrase with scrypt, and the passwords are
+// encrypted with AES-256-GCM, using the UUID of the proxy as additional data
+const EXPORT_KDF_SCRYPT = "scrypt"
+const EXPORT_CIPHER_AES_GCM = "aes-256-gcm"
+const EXPORT_SCRYPT_N = 32768
+const EXPORT_SCRYPT_R = 8
+const EXPORT_SCRYPT_P = 1
+const EXPORT_SALT_SIZE = 16
+const EXPORT_KEY_SIZE = 32
+
+// What is exported
+type ExportOptions struct {
+	IncludePasswords bool
+	// If set, the passwords are encrypted with it; otherwise, they are exported in plain text
+	Passphrase string
+	// Slugs of the proxies to export; all the proxies if empty
+	ProxiesSlugs []string
+	// Export the settings, without proxies
+	SettingsOnly bool
+}
+
+// Returns the configuration to export; the proxies of the system configuration are never exported
+func (c *Configuration) ExportMap(options *ExportOptions) (*goutils.MapHelper, error) {
+
+	if options.SettingsOnly && len(options.ProxiesSlugs) > 0 {
+		return nil, errors.New(MyGettextv("The proxies can't be selected when exporting only the settings"))
+	}
+	for _, slug := range options.ProxiesSlugs {
+		p := c.GetProxyWithSlug(slug)
+		if p == nil {
+			return nil, errors.New(MyGettextv("Proxy with slug %v not found", slug))
+		}
+		if p.Locked {
+			return nil, errors.New(MyGettextv("The proxy %v is managed by the system configuration", p.Name))
+		}
+	}
+
+	h, err := c.ToMap(options.IncludePasswords && !options.SettingsOnly)
+	if err != nil {
+		return nil, errors.Wrap(err, "Error exporting configuration")
+	}
+	// Only useful in the computer where the application has been run
+	h.Delete("indicator_already_run")
+
+	if options.SettingsOnly {
+		h.Delete("proxies")
+		h.Delete("active_proxy")
+		return h, nil
+	}
+
+	if len(options.ProxiesSlugs) > 0 {
+		proxies := []*goutils.MapHelper{}
+		for _, p := range h.GetListOfHelpers("proxies") {
+			if goutils.ListContainsString(options.ProxiesSlugs, p.GetString("slug", "")) {
+				proxies = append(proxies, p)
+			}
+		}
+		h.SetListOfHelpers("proxies", proxies)
+		if c.ActiveProxy != nil && !goutils.ListContainsString(options.ProxiesSlugs, c.ActiveProxy.Slug) {
+			h.Delete("active_proxy")
+		}
+	}
+
+	if options.IncludePasswords && options.Passphrase != "" {
+		err = EncryptPasswords(h, options.Passphrase)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return h, nil
+
+}
+
+// Exports the configuration to the file; it is only readable by the user if it has passwords
+func (c *Configuration) Export(filename string, options *ExportOptions) error {
+	Log.Debugf("Exporting configuration to file %v...", filename)
+	data, err := c.ExportMap(options)
+	if err != nil {
+		return err
+	}
+	perm := os.FileMode(0644)
+	if options.IncludePasswords {
+		perm = CREDENTIALS_FILE_MODE
+	}
+	return SafeSaveFile(filename, perm, options.IncludePasswords, func(tmpFilename string) error {
+		return data.SaveToJsonFile(tmpFilename, true)
+	})
+}
+
+// Returns the configuration to export as JSON
+func (c *Configuration) ExportJson(options *ExportOptions) ([]byte, error) {
+	data, err := c.ExportMap(options)
+	if err != nil {
+		return nil, err
+	}
+	return MapHelperToJson(data)
+}
+
+// Serializes the map in memory, as it can have passwords
+func MapHelperToJson(h *goutils.MapHelper) ([]byte, error) {
+	data, err := json.MarshalIndent(h.Data, "", "    ")
+	if err != nil {
+		return nil, errors.Wrap(err, "Error serializing configuration")
+	}
+	return append(data, '\n'), nil
+}
+
+// Reads a file to import, decrypting its passwords and migrating it to the current version
+func ReadImportFile(filename string, passphrase string) (*goutils.MapHelper, error) {
+	helper, err := goutils.NewMapHelperFromJsonFile(filename, true)
+	if err != nil {
+		return nil, errors.Wrap(err, MyGettextv("Error loading configuration"))
+	}
+	err = DecryptPasswords(helper, passphrase)
+	if err != nil {
+		return nil, err
+	}
+	_, err = MigrateConfiguration(helper)
+	if err != nil {
+		return nil, err
+	}
+	return helper, nil
+}
+
+// Returns true if the file has passwords encrypted with a passphrase
+func IsEncryptedConfigurationFile(filename string) (bool, error) {
+	helper, err := goutils.NewMapHelperFromJsonFile(filename, true)
+	if err != nil {
+		return false, errors.Wrap(err, MyGettextv("Error loading configuration"))
+	}
+	return helper.Exists("encryption"), nil
+}
+
+// Derives the key of the passwords from the passphrase and the settings of the encryption object
+func deriveExportKey(encryption *goutils.MapHelper, passphrase string) ([]byte, error) {
+	if kdf := encryption.GetString("kdf", ""); kdf != EXPORT_KDF_SCRYPT {
+		return nil, errors.New(MyGettextv("Unsupported key derivation function %v", kdf))
+	}
+	if c := encryption.GetString("cipher", ""); c != EXPORT_CIPHER_AES_GCM {
+		return nil, errors.New(MyGettextv("Unsupported cipher %v", c))
+	}
+	salt, err := base64.StdEncoding.DecodeString(encryption.GetString("salt", ""))
+	if err != nil || len(salt) == 0 {
+		return nil, errors.New(MyGettextv("Invalid salt in the encryption settings"))
+	}
+	key, err := scrypt.Key(
+		[]byte(passphrase),
+		salt,
+		encryption.GetInt("n", EXPORT_SCRYPT_N),
+		encryption.GetInt("r", EXPORT_SCRYPT_R),
+		encryption.GetInt("p", EXPORT_SCRYPT_P),
+		EXPORT_KEY_SIZE,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "Error deriving key")
+	}
+	return key, nil
+}
+
+func newExportCipher(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, errors.Wrap(err, "Error creating cipher")
+	}
+	return cipher.NewGCM(block)
+}
+
+// Replaces the password of every proxy with encrypted_password, and adds the encryption object with the
+// settings needed to decrypt them
+func EncryptPasswords(h *goutils.MapHelper, passphrase string) error {
+
+	salt := make([]byte, EXPORT_SALT_SIZE)
+	_, err := io.ReadFull(rand.Reader, salt)
+	if err != nil {
+		return errors.Wrap(err, "Error generating salt")
+	}
+
+	encryption := h.GetHelper("encryption")
+	encryption.SetString("kdf", EXPORT_KDF_SCRYPT)
+	encryption.SetString("cipher", EXPORT_CIPHER_AES_GCM)
+	encryption.SetString("salt", base64.StdEncoding.EncodeToString(salt))
+	encryption.SetInt("n", EXPORT_SCRYPT_N)
+	encryption.SetInt("r", EXPORT_SCRYPT_R)
+	encryption.SetInt("p", EXPORT_SCRYPT_P)
+
+	key, err := deriveExportKey(encryption, passphrase)
+	if err != nil {
+		return err
+	}
+	aead, err := newExportCipher(key)
+	if err != nil {
+		return err
+	}
+
+	proxies := []*goutils.MapHelper{}
+	for _, p := range h.GetListOfHelpers("proxies") {
+		if password := p.GetString("password", ""); password != "" {
+			nonce := make([]byte, aead.NonceSize())
+			_, err = io.ReadFull(rand.Reader, nonce)
+			if err != nil {
+				return errors.Wrap(err, "Error generating nonce")
+			}
+			sealed := aead.Seal(nonce, nonce, []byte(password), []byte(p.GetString("uuid", "")))
+			p.SetString("encrypted_password", base64.StdEncoding.EncodeToString(sealed))
+			p.Delete("password")
+		}
+		proxies = append(proxies, p)
+	}
+	if len(proxies) > 0 {
+		h.SetListOfHelpers("proxies", proxies)
+	}
+	return nil
+
+}
+
+// Replaces the encrypted_password of every proxy with the decrypted password, and removes the encryption object;
+// nothing is done if the passwords are not encrypted
+func DecryptPasswords(h *goutils.MapHelper, passphrase string) error {
+
+	if !h.Exists("encryption") {
+		return nil
+	}
+	if passphrase == "" {
+		return PassphraseRequiredError
+	}
+
+	key, err := deriveExportKey(h.GetHelper("encryption"), passphrase)
+	if err != nil {
+		return err
+	}
+	aead, err := newExportCipher(key)
+	if err != nil {
+		return err
+	}
+
+	proxies := []*goutils.MapHelper{}
+	for _, p := range h.GetListOfHelpers("proxies") {
+		if encrypted := p.GetString("encrypted_password", ""); encrypted != "" {
+			sealed, err := base64.StdEncoding.DecodeString(encrypted)
+			if err != nil || len(sealed) < aead.NonceSize() {
+				return errors.New(MyGettextv("Invalid encrypted password for proxy %v", p.GetString("name", "")))
+			}
+			password, err := aead.Open(nil, sealed[:aead.NonceSize()], sealed[aead.NonceSize():], []byte(p.GetString("uuid", "")))
+			if err != nil {
+				// The passphrase is the same for all the passwords, so a wrong one fails with the first proxy
+				return InvalidPassphraseError
+			}
+			p.SetString("password", string(password))
+			p.Delete("encrypted_password")
+		}
+		proxies = append(proxies, p)
+	}
+	if len(proxies) > 0 {
+		h.SetListOfHelpers("proxies", proxies)
+	}
+	h.Delete("encryption")
+	return nil
+
+}
@@ -0,0 +1,352 @@
+package proxychangerlib
+
+import (
+	"github.com/okelet/goutils"
+	"github.com/pkg/errors"
+)
+
+// Import modes: merge adds and updates the proxies of the file, keeping the rest of the configuration; replace
+// loads the whole file, removing the proxies that are not in it
+const IMPORT_MODE_MERGE = "merge"
+const IMPORT_MODE_REPLACE = "replace"
+
+// What importing does with each proxy
+const IMPORT_ACTION_ADD = "add"
+const IMPORT_ACTION_UPDATE = "update"
+const IMPORT_ACTION_UNCHANGED = "unchanged"
+const IMPORT_ACTION_REMOVE = "remove"
+const IMPORT_ACTION_CONFLICT = "conflict"
+const IMPORT_ACTION_SKIP = "skip"
+
+// Resolutions of the conflicts (a proxy of the file has the slug of other existing proxy): keep the existing
+// proxy and ignore the one of the file, replace the existing proxy with the one of the file, or add the one of
+// the file as a new proxy (with other slug)
+const IMPORT_RESOLUTION_KEEP = "keep"
+const IMPORT_RESOLUTION_REPLACE = "replace"
+const IMPORT_RESOLUTION_COPY = "copy"
+
+var IMPORT_RESOLUTIONS = []string{IMPORT_RESOLUTION_KEEP, IMPORT_RESOLUTION_REPLACE, IMPORT_RESOLUTION_COPY}
+
+// Change of the configuration made by an import
+type ImportEntry struct {
+	// Slug of the proxy in the file (or its UUID, if it has no slug); identifies the conflicts to resolve
+	Key    string
+	Action string
+	// Proxy of the file; nil for removed proxies
+	Imported *Proxy
+	// Password of the proxy in the file, if any
+	Password string
+	// Proxy of the configuration matched by UUID or slug, if any
+	Existing *Proxy
+	Message  string
+	// Only for conflicts; one of IMPORT_RESOLUTIONS
+	Resolution string
+	// Data of the proxy in the file
+	data *goutils.MapHelper
+}
+
+// Returns the name of the proxy, to show it to the user
+func (e *ImportEntry) GetName() string {
+	if e.Imported != nil {
+		return e.Imported.Name
+	}
+	return e.Existing.Name
+}
+
+// Returns the changes that importing the file would make, without changing the configuration; the passphrase
+// is only needed if the passwords of the file are encrypted
+func (c *Configuration) PreviewImport(filename string, passphrase string, mode string) ([]*ImportEntry, error) {
+	helper, err := ReadImportFile(filename, passphrase)
+	if err != nil {
+		return nil, err
+	}
+	return c.PreviewImportMap(helper, mode)
+}
+
+// Returns the changes that importing the configuration of the map would make; see PreviewImport
+func (c *Configuration) PreviewImportMap(helper *goutils.MapHelper, mode string) ([]*ImportEntry, error) {
+
+	if mode != IMPORT_MODE_MERGE && mode != IMPORT_MODE_REPLACE {
+		return nil, errors.New(MyGettextv("Invalid import mode %v", mode))
+	}
+
+	var err error
+	entries := []*ImportEntry{}
+	matched := []*Proxy{}
+	for _, v := range helper.GetListOfHelpers("proxies") {
+
+		uuid := v.GetString("uuid", "")
+		slug := v.GetString("slug", "")
+		e := &ImportEntry{Key: slug, Password: v.GetString("password", ""), data: v}
+		if e.Key == "" {
+			e.Key = uuid
+		}
+
+		// Never load the password into the secret store while previewing
+		e.Imported, err = NewProxyFromMap(c, v, false)
+		if err != nil {
+			return nil, errors.Wrap(err, MyGettextv("Error loading proxy"))
+		}
+		// The names and slugs of the file are shown, even if they are already in use
+		if slug != "" {
+			e.Imported.Slug = slug
+		}
+		if name := v.GetString("name", ""); name != "" {
+			e.Imported.Name = name
+		}
+
+		if uuid != "" {
+			e.Existing = c.GetProxyWithUuid(uuid)
+		}
+		if e.Existing == nil && slug != "" && mode == IMPORT_MODE_MERGE {
+			if p := c.GetProxyWithSlug(slug); p != nil {
+				e.Existing = p
+				e.Action = IMPORT_ACTION_CONFLICT
+				e.Resolution = IMPORT_RESOLUTION_KEEP
+				e.Message = MyGettextv("There is already a proxy with the slug %v", slug)
+			}
+		}
+
+		if e.Existing == nil {
+			e.Action = IMPORT_ACTION_ADD
+		} else if e.Existing.Locked {
+			e.Action = IMPORT_ACTION_SKIP
+			e.Resolution = ""
+			e.Message = MyGettextv("The proxy %v is managed by the system configuration", e.Existing.Name)
+		} else if e.Action == "" {
+			changed, err := c.IsImportedProxyChanged(e)
+			if err != nil {
+				return nil, err
+			}
+			if changed {
+				e.Action = IMPORT_ACTION_UPDATE
+			} else {
+				e.Action = IMPORT_ACTION_UNCHANGED
+			}
+		}
+		if e.Existing != nil {
+			matched = append(matched, e.Existing)
+		}
+		entries = append(entries, e)
+
+	}
+
+	if mode == IMPORT_MODE_REPLACE {
+		for _, p := range c.Proxies {
+			if p.Locked {
+				continue
+			}
+			found := false
+			for _, m := range matched {
+				if m == p {
+					found = true
+					break
+				}
+			}
+			if !found {
+				entries = append(entries, &ImportEntry{Key: p.Slug, Action: IMPORT_ACTION_REMOVE, Existing: p})
+			}
+		}
+	}
+
+	return entries, nil
+
+}
+
+// Returns true if the proxy of the file has other settings or password than the existing one
+func (c *Configuration) IsImportedProxyChanged(e *ImportEntry) (bool, error) {
+
+	existingData, err := e.Existing.ToMap(c, false)
+	if err != nil {
+		return false, errors.Wrapf(err, "Error exporting proxy %v", e.Existing.Name)
+	}
+	existingHash, err := GetMapHelperHash(existingData)
+	if err != nil {
+		return false, err
+	}
+
+	importedData, err := e.Imported.ToMap(c, false)
+	if err != nil {
+		return false, errors.Wrapf(err, "Error exporting proxy %v", e.Imported.Name)
+	}
+	importedData.SetString("uuid", e.Existing.UUID)
+	importedHash, err := GetMapHelperHash(importedData)
+	if err != nil {
+		return false, err
+	}
+
+	if existingHash != importedHash {
+		return true, nil
+	}
+	if e.Password != "" {
+		password, err := c.GetPassword(e.Existing.UUID)
+		if err != nil {
+			return false, err
+		}
+		return password != e.Password, nil
+	}
+	return false, nil
+
+}
+
+// Adds and updates the proxies of the entries (see PreviewImport with IMPORT_MODE_MERGE), resolving the
+// conflicts as set in each entry, and saves the configuration. The rest of the configuration is not changed.
+func (c *Configuration) MergeImport(entries []*ImportEntry) error {
+
+	reapplyActive := false
+	for _, e := range entries {
+
+		switch e.Action {
+		case IMPORT_ACTION_ADD:
+			err := c.addImportedProxy(e, false)
+			if err != nil {
+				return err
+			}
+		case IMPORT_ACTION_UPDATE:
+			err := c.updateImportedProxy(e)
+			if err != nil {
+				return err
+			}
+			reapplyActive = reapplyActive || c.ActiveProxy == e.Existing
+		case IMPORT_ACTION_CONFLICT:
+			switch e.Resolution {
+			case IMPORT_RESOLUTION_REPLACE:
+				err := c.updateImportedProxy(e)
+				if err != nil {
+					return err
+				}
+				reapplyActive = reapplyActive || c.ActiveProxy == e.Existing
+			case IMPORT_RESOLUTION_COPY:
+				err := c.addImportedProxy(e, true)
+				if err != nil {
+					return err
+				}
+			case IMPORT_RESOLUTION_KEEP, "":
+				Log.Infof("Keeping proxy %v; the proxy of the imported file is ignored", e.Existing.Name)
+			default:
+				return errors.New(MyGettextv("Invalid resolution %v for proxy %v; valid values are %v", e.Resolution, e.Key, IMPORT_RESOLUTIONS))
+			}
+		}
+
+	}
+
+	if reapplyActive {
+		_, err := c.SetActiveProxy(c.ActiveProxy, MyGettextv("Configuration imported"), false)
+		if err != nil {
+			return err
+		}
+	}
+
+	return c.Save(MyGettextv("Configuration imported"))
+
+}
+
+// Adds the proxy of the file; if asNew is set, the proxy gets a new UUID and slug, so it doesn't replace the
+// existing one
+func (c *Configuration) addImportedProxy(e *ImportEntry, asNew bool) error {
+	data := e.data
+	if asNew {
+		data.Delete("uuid")
+		data.Delete("slug")
+	}
+	p, err := NewProxyFromMap(c, data, false)
+	if err != nil {
+		return errors.Wrap(err, MyGettextv("Error loading proxy"))
+	}
+	if e.Password != "" {
+		err = c.SetPassword(p.UUID, e.Password)
+		if err != nil {
+			return errors.Wrap(err, MyGettextv("Error saving password in secret store"))
+		}
+	}
+	Log.Infof("Adding imported proxy %v", p.Name)
+	err, _ = c.AddProxy(false, p)
+	return err
+}
+
+// Replaces the settings of the existing proxy with the ones of the file, keeping its UUID
+func (c *Configuration) updateImportedProxy(e *ImportEntry) error {
+	if e.Existing.Locked {
+		Log.Warningf("Not replacing proxy %v; it is managed by the system configuration", e.Existing.Name)
+		return nil
+	}
+	p := e.Imported
+	if p.Slug == "" || c.IsSlugAlreadyInUse(p.Slug, e.Existing) {
+		p.Slug = c.CreateUniqueSlug(p.Name, e.Existing)
+	}
+	if p.Name == "" || c.IsNameAlreadyInUse(p.Name, e.Existing) {
+		p.Name = c.CreateUniqueName(p.Name, e.Existing)
+	}
+	e.Existing.CopyFrom(p)
+	if e.Password != "" {
+		err := c.SetPassword(e.Existing.UUID, e.Password)
+		if err != nil {
+			return errors.Wrap(err, MyGettextv("Error saving password in secret store"))
+		}
+	}
+	Log.Infof("Updating proxy %v from imported file", e.Existing.Name)
+	err, _ := c.UpdateProxy(false, e.Existing)
+	return err
+}
+
+// Imports the file; in dry run mode, only the changes are returned. The resolutions of the conflicts are set by
+// the key of each entry; the conflicts without resolution keep the existing proxy. In merge mode, the settings of
+// the file are only imported if withSettings is set (the replace mode always imports them).
+func (c *Configuration) ImportFile(filename string, passphrase string, mode string, withSettings bool, dryRun bool, resolutions map[string]string) ([]*ImportEntry, error) {
+
+	helper, err := ReadImportFile(filename, passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := c.PreviewImportMap(helper, mode)
+	if err != nil {
+		return nil, err
+	}
+
+	for key, resolution := range resolutions {
+		if !goutils.ListContainsString(IMPORT_RESOLUTIONS, resolution) {
+			return entries, errors.New(MyGettextv("Invalid resolution %v for proxy %v; valid values are %v", resolution, key, IMPORT_RESOLUTIONS))
+		}
+		found := false
+		for _, e := range entries {
+			if e.Key == key && e.Action == IMPORT_ACTION_CONFLICT {
+				e.Resolution = resolution
+				found = true
+			}
+		}
+		if !found {
+			return entries, errors.New(MyGettextv("There is no conflict for proxy %v", key))
+		}
+	}
+
+	if dryRun {
+		return entries, nil
+	}
+
+	if mode == IMPORT_MODE_REPLACE {
+		err = c.LoadMap(helper, true, true, true)
+		if err != nil {
+			return entries, err
+		}
+		for _, e := range entries {
+			if e.Action == IMPORT_ACTION_REMOVE {
+				err = c.DeletePassword(e.Existing.UUID)
+				if err != nil {
+					Log.Errorf("Error deleting password of proxy %v: %v", e.Existing.Name, err)
+				}
+			}
+		}
+	} else {
+		if withSettings {
+			err = c.LoadSettings(helper)
+			if err != nil {
+				return entries, err
+			}
+			c.ApplySystemPolicy()
+		}
+		err = c.MergeImport(entries)
+	}
+	return entries, err
+
+}
@@ -34,9 +34,30 @@ func (n *GlobalProxyChangeResult) GetNumberOfErrors() int {
 	return counter
 }
 
+// Replaces the results of the same applications with the new ones, appending the results of applications
+// not present in the previous execution
+func (n *GlobalProxyChangeResult) MergeResults(results []*AppProxyChangeResult) {
+	for _, r := range results {
+		found := false
+		for i, previous := range n.Results {
+			if previous.Application.GetId() == r.Application.GetId() {
+				n.Results[i] = r
+				found = true
+				break
+			}
+		}
+		if !found {
+			n.Results = append(n.Results, r)
+		}
+	}
+}
+
 type ConfigListener interface {
 	OnConfigLoaded()
+	// The configuration file has been changed by other program while there were changes not saved
+	OnConfigConflict()
 	OnProxyActivated(notification *GlobalProxyChangeResult)
+	OnApplicationsApplied(results []*AppProxyChangeResult)
 	OnProxyAdded(p *Proxy)
 	OnProxyUpdated(p *Proxy)
 	OnProxyRemoved(p *Proxy)
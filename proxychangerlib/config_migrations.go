@@ -0,0 +1,88 @@
+package proxychangerlib
+
+import (
+	"fmt"
+	"io/ioutil"
+
+	"github.com/okelet/goutils"
+	"github.com/pkg/errors"
+)
+
+// Version of the configuration file written by this version of the application
+const CONFIG_VERSION = 1
+
+// Changes the configuration from the previous version to Version
+type ConfigMigration struct {
+	Version     int
+	Description string
+	// Keys that are removed or renamed by the migration; they are valid in older versions
+	LegacyKeys []string
+	Migrate    func(h *goutils.MapHelper) error
+}
+
+// Migrations, ordered by version; add a new one (and increase CONFIG_VERSION) every time the format of the
+// configuration changes, instead of checking the version while loading
+var CONFIG_MIGRATIONS = []*ConfigMigration{
+	{
+		Version:     1,
+		Description: "Rename show_current_proxy_next_icon to show_current_proxy_name_next_to_indicator",
+		LegacyKeys:  []string{"show_current_proxy_next_icon"},
+		Migrate: func(h *goutils.MapHelper) error {
+			if h.Exists("show_current_proxy_next_icon") {
+				h.SetBoolean("show_current_proxy_name_next_to_indicator", h.GetBoolean("show_current_proxy_next_icon", true))
+				h.Delete("show_current_proxy_next_icon")
+			}
+			return nil
+		},
+	},
+}
+
+// Applies the migrations newer than the version of the configuration, and sets the version to CONFIG_VERSION.
+// Returns the original version of the configuration.
+func MigrateConfiguration(h *goutils.MapHelper) (int, error) {
+
+	version := h.GetInt("version", 0)
+	if version > CONFIG_VERSION {
+		return version, errors.New(MyGettextv("The configuration version %v is newer than the supported one (%v); please, update the application", version, CONFIG_VERSION))
+	}
+
+	for _, m := range CONFIG_MIGRATIONS {
+		if m.Version <= version {
+			continue
+		}
+		Log.Infof("Migrating configuration to version %v: %v", m.Version, m.Description)
+		err := m.Migrate(h)
+		if err != nil {
+			return version, errors.Wrapf(err, MyGettextv("Error migrating configuration to version %v", m.Version))
+		}
+	}
+
+	h.SetInt("version", CONFIG_VERSION)
+	return version, nil
+
+}
+
+// Returns true if the key was removed or renamed by a migration newer than the version
+func IsLegacyConfigKey(key string, version int) bool {
+	for _, m := range CONFIG_MIGRATIONS {
+		if m.Version > version && goutils.ListContainsString(m.LegacyKeys, key) {
+			return true
+		}
+	}
+	return false
+}
+
+// Copies the configuration file before migrating it, so it can be restored with an older version of the
+// application; the copy is named after the original version (for example, proxychanger.json.v0.bak)
+func BackupConfigurationFile(filename string, version int) (string, error) {
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return "", errors.Wrapf(err, MyGettextv("Error reading configuration file %v", filename))
+	}
+	backupFilename := fmt.Sprintf("%v.v%v.bak", filename, version)
+	err = SafeWriteCredentialsFile(backupFilename, data)
+	if err != nil {
+		return "", errors.Wrapf(err, MyGettextv("Error writing backup file %v", backupFilename))
+	}
+	return backupFilename, nil
+}
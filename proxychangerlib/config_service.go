@@ -14,6 +14,14 @@ type GetActiveProxySlugResponse struct {
 	Slug  string
 }
 
+type ProxyEnvironmentResponse struct {
+	Error     string
+	Slug      string
+	Variables []*EnvironmentVariable
+	// Not empty if the variables include the password of a proxy in credential-safe mode
+	Warning string
+}
+
 type SetActiveProxyBySlugResponse struct {
 	Error string
 }
@@ -22,6 +30,138 @@ type ApplyActiveProxyResponse struct {
 	Error string
 }
 
+type ListApplicationsResponse struct {
+	Error        string
+	Applications []ApplicationStruct
+}
+
+type ApplicationStruct struct {
+	Id          string
+	Name        string
+	Description string
+	Homepage    string
+	Enabled     bool
+	// Enabled or disabled by the system configuration
+	Locked      bool
+	Installed   bool
+	Version     string
+	ConfigPaths []string
+	// If the settings match the active proxy; nil if the application can't report it, or it is disabled
+	Status *ApplicationResultStruct
+}
+
+type SetApplicationEnabledResponse struct {
+	Error string
+	// Nil if the application was not applied or cleaned
+	Result *ApplicationResultStruct
+}
+
+type ApplicationResultStruct struct {
+	ApplicationId  string
+	SkippedMessage string
+	WarningMessage string
+	ErrorMessage   string
+}
+
+func NewApplicationResultStruct(r *AppProxyChangeResult) *ApplicationResultStruct {
+	return &ApplicationResultStruct{
+		ApplicationId:  r.Application.GetId(),
+		SkippedMessage: r.SkippedMessage,
+		WarningMessage: r.WarningMessage,
+		ErrorMessage:   r.ErrorMessage,
+	}
+}
+
+func NewApplicationResultStructs(results []*AppProxyChangeResult) []*ApplicationResultStruct {
+	l := []*ApplicationResultStruct{}
+	for _, r := range results {
+		l = append(l, NewApplicationResultStruct(r))
+	}
+	return l
+}
+
+type ApplyToApplicationsResponse struct {
+	Error   string
+	Results []*ApplicationResultStruct
+}
+
+type MigrateSecretsResponse struct {
+	Error    string
+	Migrated int
+}
+
+type ImportConfigurationResponse struct {
+	Error   string
+	Entries []*ImportEntryStruct
+}
+
+type ExportConfigurationResponse struct {
+	Error string
+	// Exported configuration, in JSON
+	Data string
+}
+
+type DiscoverProxiesResponse struct {
+	Error   string
+	Proxies []*DiscoveredProxyStruct
+	// Names of the proxies created
+	Added []string
+}
+
+// The password of the discovered proxy is not sent, only if it has one
+type DiscoveredProxyStruct struct {
+	Key         string
+	Sources     []string
+	Protocol    string
+	Address     string
+	Port        int
+	Username    string
+	HasPassword bool
+	Exceptions  []string
+	// Name of the configured proxy with the same settings, if any
+	Existing string
+}
+
+func NewDiscoveredProxyStructs(proxies []*DiscoveredProxy) []*DiscoveredProxyStruct {
+	l := []*DiscoveredProxyStruct{}
+	for _, d := range proxies {
+		l = append(l, &DiscoveredProxyStruct{
+			Key:         d.Key(),
+			Sources:     d.Sources,
+			Protocol:    d.Protocol,
+			Address:     d.Address,
+			Port:        d.Port,
+			Username:    d.Username,
+			HasPassword: d.Password != "",
+			Exceptions:  d.Exceptions,
+			Existing:    d.Existing,
+		})
+	}
+	return l
+}
+
+type ImportEntryStruct struct {
+	Key        string
+	Action     string
+	Name       string
+	Message    string
+	Resolution string
+}
+
+func NewImportEntryStructs(entries []*ImportEntry) []*ImportEntryStruct {
+	l := []*ImportEntryStruct{}
+	for _, e := range entries {
+		l = append(l, &ImportEntryStruct{
+			Key:        e.Key,
+			Action:     e.Action,
+			Name:       e.GetName(),
+			Message:    e.Message,
+			Resolution: e.Resolution,
+		})
+	}
+	return l
+}
+
 type ProxyStruct struct {
 	UUID        string
 	Name        string
@@ -35,11 +175,27 @@ type ProxyStruct struct {
 	Exceptions  []string
 	MatchingIps []string
 	Active      bool
+	// Loaded from the system configuration
+	Locked bool
+	// Applications included and excluded by the proxy
+	IncludedApplications []string
+	ExcludedApplications []string
 }
 
 type ConfigService interface {
 	ListProxies(includePasswords bool) (string, *dbus.Error)
 	ApplyActiveProxy() (string, *dbus.Error)
 	GetActiveProxySlug() (string, *dbus.Error)
+	GetActiveProxyEnvironment() (string, *dbus.Error)
+	GetProxyEnvironmentBySlug(slug string) (string, *dbus.Error)
 	SetActiveProxyBySlug(slug string) (string, *dbus.Error)
+	ListApplications() (string, *dbus.Error)
+	EnableApplicationById(id string, apply bool) (string, *dbus.Error)
+	DisableApplicationById(id string, clean bool) (string, *dbus.Error)
+	ApplyActiveProxyToApplications(ids []string) (string, *dbus.Error)
+	ClearApplications(ids []string) (string, *dbus.Error)
+	MigrateSecrets(storeType string, options map[string]string) (string, *dbus.Error)
+	ImportConfiguration(filename string, passphrase string, mode string, withSettings bool, dryRun bool, resolutions map[string]string) (string, *dbus.Error)
+	ExportConfiguration(includePasswords bool, passphrase string, slugs []string, settingsOnly bool) (string, *dbus.Error)
+	DiscoverProxies(add bool, keys []string) (string, *dbus.Error)
 }
@@ -0,0 +1,169 @@
+package proxychangerlib
+
+import (
+	"io/ioutil"
+	"os"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/okelet/goutils"
+	"github.com/pkg/errors"
+)
+
+// Configuration shipped by the system administrator; it is loaded under the user configuration, and can't be
+// changed by the user
+const SYSTEM_CONFIG_PATH = "/etc/proxychanger/proxies.json"
+
+// Drop-in files (*.json) with the same format as SYSTEM_CONFIG_PATH, loaded after it in alphabetical order
+const SYSTEM_CONFIG_DIR = "/etc/proxychanger.d"
+
+// Keys of the user configuration that can be forced in the "policy" object of the system files
+var SYSTEM_POLICY_KEYS = []string{
+	"enable_auto_change_by_ip",
+	"what_to_do_when_no_ip_matches",
+	"time_between_ips_checks",
+	"enable_update_check",
+	"time_between_update_checks",
+}
+
+// Returns the system configuration files that exist, in the order they must be loaded
+func GetSystemConfigFiles() ([]string, error) {
+	files := []string{}
+	exists, err := goutils.FileExists(SYSTEM_CONFIG_PATH)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, errors.Wrap(err, MyGettextv("Error checking if file %v exists", SYSTEM_CONFIG_PATH))
+	}
+	if exists {
+		files = append(files, SYSTEM_CONFIG_PATH)
+	}
+	entries, err := ioutil.ReadDir(SYSTEM_CONFIG_DIR)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			return nil, errors.Wrap(err, MyGettextv("Error reading directory %v", SYSTEM_CONFIG_DIR))
+		}
+		return files, nil
+	}
+	names := []string{}
+	for _, e := range entries {
+		if !e.IsDir() && strings.HasSuffix(e.Name(), ".json") {
+			names = append(names, e.Name())
+		}
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		files = append(files, path.Join(SYSTEM_CONFIG_DIR, name))
+	}
+	return files, nil
+}
+
+// Loads the proxies and the policy of the system configuration files; the proxies are marked as locked, and
+// replace the user proxies with the same UUID. Invalid files are logged and ignored, so a broken system file
+// doesn't prevent the user from using the application.
+func (c *Configuration) LoadSystemConfiguration() {
+
+	c.SystemPolicies = []*goutils.MapHelper{}
+	c.ForcedEnabledApplicationsIds = []string{}
+	c.ForcedDisabledApplicationsIds = []string{}
+
+	files, err := GetSystemConfigFiles()
+	if err != nil {
+		Log.Errorf("Error getting system configuration files: %v", err)
+		return
+	}
+
+	for _, file := range files {
+
+		helper, err := goutils.NewMapHelperFromJsonFile(file, true)
+		if err != nil {
+			Log.Errorf("Error loading system configuration file %v: %v", file, err)
+			continue
+		}
+		Log.Debugf("Loading system configuration file %v", file)
+
+		for _, v := range helper.GetListOfHelpers("proxies") {
+			if v.GetString("uuid", "") == "" {
+				Log.Errorf("Ignoring proxy %v without UUID in system configuration file %v", v.GetString("name", ""), file)
+				continue
+			}
+			previous := c.GetProxyWithUuid(v.GetString("uuid", ""))
+			if previous != nil {
+				c.RemoveProxy(previous)
+			}
+			p, err := NewProxyFromMap(c, v, false)
+			if err != nil {
+				Log.Errorf("Error loading proxy from system configuration file %v: %v", file, err)
+				continue
+			}
+			p.Locked = true
+			Log.Debugf("Loaded system proxy %v", p.Name)
+			c.AddProxy(false, p)
+		}
+
+		if helper.Exists("policy") {
+			policy := helper.GetHelper("policy")
+			for _, k := range policy.Keys() {
+				if k != "enabled_applications" && k != "disabled_applications" && !goutils.ListContainsString(SYSTEM_POLICY_KEYS, k) {
+					Log.Warningf("Ignoring unknown policy key %v in system configuration file %v", k, file)
+				}
+			}
+			for _, id := range policy.GetListOfStrings("enabled_applications", []string{}) {
+				c.ForcedDisabledApplicationsIds = goutils.RemoveStringFromList(c.ForcedDisabledApplicationsIds, id)
+				c.ForcedEnabledApplicationsIds = goutils.AddStringToList(c.ForcedEnabledApplicationsIds, id)
+			}
+			for _, id := range policy.GetListOfStrings("disabled_applications", []string{}) {
+				c.ForcedEnabledApplicationsIds = goutils.RemoveStringFromList(c.ForcedEnabledApplicationsIds, id)
+				c.ForcedDisabledApplicationsIds = goutils.AddStringToList(c.ForcedDisabledApplicationsIds, id)
+			}
+			c.SystemPolicies = append(c.SystemPolicies, policy)
+		}
+
+	}
+
+	c.ApplySystemPolicy()
+
+}
+
+// Returns the policy of the last system file that forces the key, or nil if the key is not forced
+func (c *Configuration) GetSystemPolicy(key string) *goutils.MapHelper {
+	for i := len(c.SystemPolicies) - 1; i >= 0; i-- {
+		if c.SystemPolicies[i].Exists(key) {
+			return c.SystemPolicies[i]
+		}
+	}
+	return nil
+}
+
+// Returns true if the setting with the key (see SYSTEM_POLICY_KEYS) is forced by the system configuration
+func (c *Configuration) IsPolicyLocked(key string) bool {
+	return c.GetSystemPolicy(key) != nil
+}
+
+// Replaces the user settings with the values forced by the system configuration
+func (c *Configuration) ApplySystemPolicy() {
+	if h := c.GetSystemPolicy("enable_auto_change_by_ip"); h != nil {
+		c.EnableAutoChangeByIp = h.GetBoolean("enable_auto_change_by_ip", c.EnableAutoChangeByIp)
+	}
+	if h := c.GetSystemPolicy("what_to_do_when_no_ip_matches"); h != nil {
+		value := h.GetString("what_to_do_when_no_ip_matches", c.WhatToDoWhenNoIpMatches)
+		if value == KEEP_CURRENT_PROXY || value == DEACTIVATE_PROXY {
+			c.WhatToDoWhenNoIpMatches = value
+		} else {
+			Log.Errorf("Invalid value %v for policy what_to_do_when_no_ip_matches", value)
+		}
+	}
+	if h := c.GetSystemPolicy("time_between_ips_checks"); h != nil {
+		c.TimeBetweenIpChecks = h.GetInt("time_between_ips_checks", c.TimeBetweenIpChecks)
+	}
+	if h := c.GetSystemPolicy("enable_update_check"); h != nil {
+		c.EnableUpdateCheck = h.GetBoolean("enable_update_check", c.EnableUpdateCheck)
+	}
+	if h := c.GetSystemPolicy("time_between_update_checks"); h != nil {
+		c.TimeBetweenUpdateChecks = h.GetInt("time_between_update_checks", c.TimeBetweenUpdateChecks)
+	}
+}
+
+// Returns true if the application is enabled or disabled by the system configuration
+func (c *Configuration) IsApplicationLocked(id string) bool {
+	return goutils.ListContainsString(c.ForcedEnabledApplicationsIds, id) || goutils.ListContainsString(c.ForcedDisabledApplicationsIds, id)
+}
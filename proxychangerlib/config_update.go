@@ -0,0 +1,65 @@
+package proxychangerlib
+
+// The configuration is changed from several goroutines: the GTK main loop, the IP check thread and the D-Bus
+// handlers. Every change (and every read that needs a consistent view) must run between BeginUpdate and
+// EndUpdate, or inside Update; the methods of Configuration never take the lock themselves, so they can call
+// each other. The listeners notifications of a change are queued and dispatched after releasing the lock, so
+// the listeners can read or change the configuration again.
+
+// Takes the lock of the configuration
+func (c *Configuration) BeginUpdate() {
+	c.updateMutex.Lock()
+	c.eventsMutex.Lock()
+	c.updating = true
+	c.eventsMutex.Unlock()
+}
+
+// Releases the lock of the configuration, and dispatches the listeners notifications queued since BeginUpdate
+func (c *Configuration) EndUpdate() {
+	c.eventsMutex.Lock()
+	events := c.pendingEvents
+	c.pendingEvents = nil
+	c.updating = false
+	c.eventsMutex.Unlock()
+	c.updateMutex.Unlock()
+	c.dispatchEvents(events)
+}
+
+// Runs the function holding the lock of the configuration
+func (c *Configuration) Update(f func()) {
+	c.BeginUpdate()
+	defer c.EndUpdate()
+	f()
+}
+
+func (c *Configuration) AddListener(l ConfigListener) {
+	c.eventsMutex.Lock()
+	defer c.eventsMutex.Unlock()
+	c.Listeners = append(c.Listeners, l)
+}
+
+// Notifies the listeners; the notification is queued if the configuration is being updated
+func (c *Configuration) NotifyListeners(event func(l ConfigListener)) {
+	c.eventsMutex.Lock()
+	if c.updating {
+		c.pendingEvents = append(c.pendingEvents, event)
+		c.eventsMutex.Unlock()
+		return
+	}
+	c.eventsMutex.Unlock()
+	c.dispatchEvents([]func(l ConfigListener){event})
+}
+
+func (c *Configuration) dispatchEvents(events []func(l ConfigListener)) {
+	if len(events) == 0 {
+		return
+	}
+	c.eventsMutex.Lock()
+	listeners := append([]ConfigListener{}, c.Listeners...)
+	c.eventsMutex.Unlock()
+	for _, event := range events {
+		for _, l := range listeners {
+			event(l)
+		}
+	}
+}
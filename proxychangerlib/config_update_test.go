@@ -0,0 +1,197 @@
+package proxychangerlib
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/juju/loggo"
+)
+
+// Secret store that keeps the passwords in memory
+type memorySecretStore struct {
+	mutex     sync.Mutex
+	passwords map[string]string
+}
+
+func newMemorySecretStore() *memorySecretStore {
+	return &memorySecretStore{passwords: map[string]string{}}
+}
+
+func (s *memorySecretStore) GetType() string {
+	return SECRET_STORE_ENV
+}
+
+func (s *memorySecretStore) Get(uuid string) (string, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.passwords[uuid], nil
+}
+
+func (s *memorySecretStore) Set(uuid string, password string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.passwords[uuid] = password
+	return nil
+}
+
+func (s *memorySecretStore) Delete(uuid string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	delete(s.passwords, uuid)
+	return nil
+}
+
+// Returns a configuration saved in a temporary directory, with all the applications disabled, so activating
+// a proxy doesn't change the settings of the user
+func newTestConfiguration(t *testing.T) *Configuration {
+	dir := tempDir(t)
+	previousStateFile := ACTIVE_PROXY_STATE_FILE
+	ACTIVE_PROXY_STATE_FILE = filepath.Join(dir, "active_proxy")
+	t.Cleanup(func() {
+		ACTIVE_PROXY_STATE_FILE = previousStateFile
+	})
+	c := &Configuration{
+		Filename:           filepath.Join(dir, "proxies.json"),
+		Listeners:          []ConfigListener{},
+		Proxies:            []*Proxy{},
+		CustomApplications: []*CustomProxySetter{},
+		SecretStoreType:    SECRET_STORE_ENV,
+		SecretStoreOptions: map[string]string{},
+		SecretStore:        newMemorySecretStore(),
+		AuthProxyPort:      DEFAULT_AUTH_PROXY_PORT,
+		AuthProxies:        map[string]*AuthProxy{},
+	}
+	for _, a := range ProxifiedApplications {
+		c.DisabledApplicationsIds = append(c.DisabledApplicationsIds, a.GetId())
+	}
+	return c
+}
+
+// Must be called holding the lock of the configuration
+func newTestProxy(c *Configuration, name string) *Proxy {
+	p := NewEmptyProxy(c)
+	p.Name = name
+	p.Slug = c.CreateUniqueSlug(name, nil)
+	p.Protocol = "http"
+	p.Address = "proxy.example.com"
+	p.Port = 3128
+	return p
+}
+
+// Listener that reads the configuration again when notified, as the indicator does
+type readingListener struct {
+	Config *Configuration
+	Added  int32
+}
+
+func (l *readingListener) OnConfigLoaded()                                       {}
+func (l *readingListener) OnConfigConflict()                                     {}
+func (l *readingListener) OnProxyActivated(n *GlobalProxyChangeResult)           {}
+func (l *readingListener) OnApplicationsApplied(results []*AppProxyChangeResult) {}
+func (l *readingListener) OnProxyUpdated(p *Proxy)                               {}
+func (l *readingListener) OnProxyRemoved(p *Proxy)                               {}
+func (l *readingListener) OnShowProxyNameNextToIndicatorChanged(newValue bool)   {}
+func (l *readingListener) OnEnableAutoChangeByIpChanged(newValue bool)           {}
+func (l *readingListener) OnEnableUpdateCheckChanged(newValue bool)              {}
+func (l *readingListener) OnWhatToDoWhenNoIpMatchesChanged(newValue string)      {}
+func (l *readingListener) OnLogLevelChanged(newValue loggo.Level)                {}
+
+func (l *readingListener) OnProxyAdded(p *Proxy) {
+	atomic.AddInt32(&l.Added, 1)
+	l.Config.Update(func() {
+		if l.Config.GetProxyWithUuid(p.UUID) == nil {
+			panic("added proxy not found")
+		}
+	})
+}
+
+func TestConfigurationConcurrentUpdates(t *testing.T) {
+
+	const workers = 4
+	const iterations = 25
+
+	c := newTestConfiguration(t)
+	listener := &readingListener{Config: c}
+	c.AddListener(listener)
+
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+
+		wg.Add(4)
+
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < iterations; i++ {
+				c.Update(func() {
+					err, field := c.AddProxy(false, newTestProxy(c, fmt.Sprintf("Proxy %v-%v", w, i)))
+					if err != nil {
+						t.Errorf("error adding proxy (%v): %v", field, err)
+					}
+				})
+			}
+		}(w)
+
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < iterations; i++ {
+				slug := "none"
+				c.Update(func() {
+					if i%2 == 0 && len(c.Proxies) > 0 {
+						slug = c.Proxies[(w+i)%len(c.Proxies)].Slug
+					}
+				})
+				data, dbusErr := c.SetActiveProxyBySlug(slug)
+				if dbusErr != nil {
+					t.Errorf("error activating proxy %v: %v", slug, dbusErr)
+					continue
+				}
+				response := SetActiveProxyBySlugResponse{}
+				err := json.Unmarshal([]byte(data), &response)
+				if err != nil || response.Error != "" {
+					t.Errorf("error activating proxy %v: %v %v", slug, err, response.Error)
+				}
+			}
+		}(w)
+
+		go func() {
+			defer wg.Done()
+			for i := 0; i < iterations; i++ {
+				data, dbusErr := c.ListProxies(false)
+				if dbusErr != nil {
+					t.Errorf("error listing proxies: %v", dbusErr)
+					continue
+				}
+				response := ListProxiesResponse{}
+				err := json.Unmarshal([]byte(data), &response)
+				if err != nil || response.Error != "" {
+					t.Errorf("error listing proxies: %v %v", err, response.Error)
+				}
+			}
+		}()
+
+		go func() {
+			defer wg.Done()
+			for i := 0; i < iterations; i++ {
+				c.Update(func() {
+					c.SetShowCurrentProxyNameNextToIndicator(i%2 == 0)
+				})
+			}
+		}()
+
+	}
+	wg.Wait()
+
+	c.Update(func() {
+		if len(c.Proxies) != workers*iterations {
+			t.Errorf("%v proxies, expected %v", len(c.Proxies), workers*iterations)
+		}
+	})
+	if added := atomic.LoadInt32(&listener.Added); added != workers*iterations {
+		t.Errorf("%v proxies notified, expected %v", added, workers*iterations)
+	}
+
+}
@@ -0,0 +1,400 @@
+package proxychangerlib
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"regexp"
+	"sort"
+
+	"github.com/juju/loggo"
+	"github.com/okelet/goutils"
+	"github.com/pkg/errors"
+)
+
+// Published JSON Schema of the configuration file (see proxychanger.schema.json)
+const CONFIG_SCHEMA_URL = "https://raw.githubusercontent.com/okelet/proxychanger/master/proxychanger.schema.json"
+
+// Problem found validating a configuration file; Path is a JSON path, like $.proxies[0].matching_ips[1]
+type ConfigProblem struct {
+	Path    string
+	Message string
+}
+
+func (p *ConfigProblem) String() string {
+	return fmt.Sprintf("%v: %v", p.Path, p.Message)
+}
+
+// Top level keys of the configuration file
+var CONFIG_KEYS = []string{
+	"$schema", "version", "indicator_already_run", "show_current_proxy_name_next_to_indicator", "log_level",
+	"enable_update_check", "time_between_update_checks", "enable_auto_change_by_ip", "what_to_do_when_no_ip_matches",
+	"time_between_ips_checks", "auth_proxy_port", "proxy_change_script", "proxy_deactivate_script",
+	"proxy_activate_script", "excluded_interfaces_regexps", "secret_store", "disabled_applications",
+	"custom_applications", "proxies", "active_proxy", "encryption",
+}
+
+// Keys of the proxies of the configuration file
+var CONFIG_PROXY_KEYS = []string{
+	"uuid", "slug", "name", "protocol", "address", "port", "username", "password", "exceptions", "matching_ips",
+	"activate_script", "included_applications", "excluded_applications", "credential_safe", "scheme_endpoints",
+	"application_overrides", "encrypted_password",
+}
+
+type configValidator struct {
+	Problems []*ConfigProblem
+	// Ids of the registered applications and of the custom applications of the file
+	ApplicationsIds []string
+}
+
+func (v *configValidator) add(path string, message string) {
+	v.Problems = append(v.Problems, &ConfigProblem{path, message})
+}
+
+// Validates the configuration file, returning all the problems found instead of stopping at the first one.
+// The error is only returned if the file can't be read or is not valid JSON.
+func ValidateConfigurationFile(filename string) ([]*ConfigProblem, error) {
+
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, errors.Wrapf(err, MyGettextv("Error reading configuration file %v", filename))
+	}
+
+	var root interface{}
+	err = json.Unmarshal(data, &root)
+	if err != nil {
+		return nil, errors.Wrapf(err, MyGettextv("Invalid JSON in configuration file %v", filename))
+	}
+
+	v := &configValidator{Problems: []*ConfigProblem{}}
+	for _, a := range ProxifiedApplications {
+		v.ApplicationsIds = append(v.ApplicationsIds, a.GetId())
+	}
+	for _, a := range LoadCustomProxySettersFromDir(CUSTOM_APPS_DIR) {
+		v.ApplicationsIds = goutils.AddStringToList(v.ApplicationsIds, a.GetId())
+	}
+
+	m, ok := root.(map[string]interface{})
+	if !ok {
+		v.add("$", MyGettextv("must be an object"))
+		return v.Problems, nil
+	}
+
+	// The custom applications are validated by the same code that loads them
+	if list, ok := v.list(m, "$", "custom_applications"); ok {
+		objects := true
+		for i, item := range list {
+			if _, ok := item.(map[string]interface{}); !ok {
+				v.add(fmt.Sprintf("$.custom_applications[%v]", i), MyGettextv("must be an object"))
+				objects = false
+			}
+		}
+		helper, err := goutils.NewMapHelperFromJsonFile(filename, true)
+		if err != nil {
+			return nil, errors.Wrapf(err, MyGettextv("Error loading configuration"))
+		}
+		for i, h := range helper.GetListOfHelpers("custom_applications") {
+			if !objects {
+				// The positions of the helpers don't match the ones in the file
+				break
+			}
+			path := fmt.Sprintf("$.custom_applications[%v]", i)
+			a, err := NewCustomProxySetterFromMap(h, "")
+			if err != nil {
+				v.add(path, err.Error())
+				continue
+			}
+			v.ApplicationsIds = goutils.AddStringToList(v.ApplicationsIds, a.GetId())
+		}
+	}
+
+	version := 0
+	if n, ok := v.integer(m, "$", "version"); ok {
+		version = n
+		if version > CONFIG_VERSION {
+			v.add("$.version", MyGettextv("version %v is newer than the supported one (%v)", version, CONFIG_VERSION))
+		} else if version < 0 {
+			v.add("$.version", MyGettextv("must be greater or equal than 0"))
+		}
+	}
+
+	for _, key := range sortedKeys(m) {
+		if !goutils.ListContainsString(CONFIG_KEYS, key) && !IsLegacyConfigKey(key, version) {
+			v.add("$."+key, MyGettextv("unknown key"))
+		}
+	}
+
+	for _, key := range []string{"indicator_already_run", "show_current_proxy_name_next_to_indicator", "show_current_proxy_next_icon", "enable_update_check", "enable_auto_change_by_ip"} {
+		v.boolean(m, "$", key)
+	}
+	for _, key := range []string{"proxy_change_script", "proxy_deactivate_script", "proxy_activate_script", "$schema"} {
+		v.str(m, "$", key)
+	}
+	for _, key := range []string{"time_between_update_checks", "time_between_ips_checks"} {
+		if n, ok := v.integer(m, "$", key); ok && n <= 0 {
+			v.add("$."+key, MyGettextv("must be greater than 0"))
+		}
+	}
+	if n, ok := v.integer(m, "$", "auth_proxy_port"); ok {
+		v.port("$.auth_proxy_port", n)
+	}
+
+	if s, ok := v.str(m, "$", "log_level"); ok {
+		if _, ok := loggo.ParseLevel(s); !ok {
+			v.add("$.log_level", MyGettextv("invalid log level %v", s))
+		}
+	}
+	if s, ok := v.str(m, "$", "what_to_do_when_no_ip_matches"); ok && s != KEEP_CURRENT_PROXY && s != DEACTIVATE_PROXY {
+		v.add("$.what_to_do_when_no_ip_matches", MyGettextv("must be %v or %v", KEEP_CURRENT_PROXY, DEACTIVATE_PROXY))
+	}
+
+	v.strings(m, "$", "excluded_interfaces_regexps", func(itemPath string, s string) {
+		if _, err := regexp.Compile(s); err != nil {
+			v.add(itemPath, MyGettextv("invalid regular expression: %v", err))
+		}
+	})
+
+	v.applications(m, "$", "disabled_applications")
+
+	if secretStore, ok := v.object(m, "$", "secret_store"); ok {
+		if s, ok := v.str(secretStore, "$.secret_store", "type"); ok && !goutils.ListContainsString(SECRET_STORES, s) {
+			v.add("$.secret_store.type", MyGettextv("invalid secret store %v; valid values are %v", s, SECRET_STORES))
+		}
+	}
+
+	// Exported files can have the passwords encrypted (see EncryptPasswords)
+	encryption, encrypted := v.object(m, "$", "encryption")
+	if encrypted {
+		if s, ok := v.str(encryption, "$.encryption", "kdf"); !ok || s != EXPORT_KDF_SCRYPT {
+			v.add("$.encryption.kdf", MyGettextv("must be %v", EXPORT_KDF_SCRYPT))
+		}
+		if s, ok := v.str(encryption, "$.encryption", "cipher"); !ok || s != EXPORT_CIPHER_AES_GCM {
+			v.add("$.encryption.cipher", MyGettextv("must be %v", EXPORT_CIPHER_AES_GCM))
+		}
+		if s, ok := v.str(encryption, "$.encryption", "salt"); !ok || s == "" {
+			v.add("$.encryption.salt", MyGettextv("is required"))
+		}
+		for _, key := range []string{"n", "r", "p"} {
+			if n, ok := v.integer(encryption, "$.encryption", key); ok && n <= 0 {
+				v.add("$.encryption."+key, MyGettextv("must be greater than 0"))
+			}
+		}
+	}
+
+	uuids := map[string]string{}
+	slugs := map[string]string{}
+	names := map[string]string{}
+	if list, ok := v.list(m, "$", "proxies"); ok {
+		for i, item := range list {
+			path := fmt.Sprintf("$.proxies[%v]", i)
+			p, ok := item.(map[string]interface{})
+			if !ok {
+				v.add(path, MyGettextv("must be an object"))
+				continue
+			}
+			v.proxy(p, path)
+			if _, ok := p["encrypted_password"]; ok && !encrypted {
+				v.add(path+".encrypted_password", MyGettextv("requires the encryption settings"))
+			}
+			for _, u := range []struct {
+				Key  string
+				Seen map[string]string
+			}{{"uuid", uuids}, {"slug", slugs}, {"name", names}} {
+				if s, ok := v.str(p, path, u.Key); ok && s != "" {
+					if previous, ok := u.Seen[s]; ok {
+						v.add(path+"."+u.Key, MyGettextv("duplicated %v %v (also in %v)", u.Key, s, previous))
+					} else {
+						u.Seen[s] = path
+					}
+				}
+			}
+		}
+	}
+
+	if s, ok := v.str(m, "$", "active_proxy"); ok && s != "" {
+		if _, ok := uuids[s]; !ok {
+			v.add("$.active_proxy", MyGettextv("proxy with UUID %v not found", s))
+		}
+	}
+
+	return v.Problems, nil
+
+}
+
+func (v *configValidator) proxy(p map[string]interface{}, path string) {
+
+	for _, key := range sortedKeys(p) {
+		if !goutils.ListContainsString(CONFIG_PROXY_KEYS, key) {
+			v.add(path+"."+key, MyGettextv("unknown key"))
+		}
+	}
+
+	// The uuid, slug and name are checked with the rest of proxies
+	for _, key := range []string{"protocol", "address", "username", "password", "encrypted_password", "activate_script"} {
+		v.str(p, path, key)
+	}
+	v.boolean(p, path, "credential_safe")
+	if n, ok := v.integer(p, path, "port"); ok {
+		v.port(path+".port", n)
+	}
+	v.strings(p, path, "exceptions", nil)
+
+	v.strings(p, path, "matching_ips", func(itemPath string, s string) {
+		if _, _, err := net.ParseCIDR(s); err != nil {
+			v.add(itemPath, MyGettextv("invalid CIDR %v", s))
+		}
+	})
+
+	v.applications(p, path, "included_applications")
+	v.applications(p, path, "excluded_applications")
+
+	if list, ok := v.list(p, path, "scheme_endpoints"); ok {
+		for i, item := range list {
+			endpointPath := fmt.Sprintf("%v.scheme_endpoints[%v]", path, i)
+			e, ok := item.(map[string]interface{})
+			if !ok {
+				v.add(endpointPath, MyGettextv("must be an object"))
+				continue
+			}
+			if s, ok := v.str(e, endpointPath, "scheme"); !ok || !goutils.ListContainsString(PROXY_SCHEMES, s) {
+				v.add(endpointPath+".scheme", MyGettextv("must be one of %v", PROXY_SCHEMES))
+			}
+			v.str(e, endpointPath, "protocol")
+			if s, ok := v.str(e, endpointPath, "address"); !ok || s == "" {
+				v.add(endpointPath+".address", MyGettextv("address can not be empty"))
+			}
+			if n, ok := v.integer(e, endpointPath, "port"); ok {
+				v.port(endpointPath+".port", n)
+			}
+		}
+	}
+
+	if list, ok := v.list(p, path, "application_overrides"); ok {
+		for i, item := range list {
+			overridePath := fmt.Sprintf("%v.application_overrides[%v]", path, i)
+			o, ok := item.(map[string]interface{})
+			if !ok {
+				v.add(overridePath, MyGettextv("must be an object"))
+				continue
+			}
+			if s, ok := v.str(o, overridePath, "application"); !ok || s == "" {
+				v.add(overridePath+".application", MyGettextv("application can not be empty"))
+			} else if !goutils.ListContainsString(v.ApplicationsIds, s) {
+				v.add(overridePath+".application", MyGettextv("unknown application id %v", s))
+			}
+			v.str(o, overridePath, "address")
+			if n, ok := v.integer(o, overridePath, "port"); ok {
+				v.port(overridePath+".port", n)
+			}
+			v.strings(o, overridePath, "exceptions", nil)
+		}
+	}
+
+}
+
+func (v *configValidator) applications(m map[string]interface{}, path string, key string) {
+	v.strings(m, path, key, func(itemPath string, id string) {
+		if !goutils.ListContainsString(v.ApplicationsIds, id) {
+			v.add(itemPath, MyGettextv("unknown application id %v", id))
+		}
+	})
+}
+
+func (v *configValidator) port(path string, n int) {
+	if n <= 0 || n >= 65535 {
+		v.add(path, MyGettextv("port must be between 1 and 65534"))
+	}
+}
+
+// The getters return false if the key doesn't exist or if it has another type (reporting the problem)
+
+func (v *configValidator) str(m map[string]interface{}, path string, key string) (string, bool) {
+	value, exists := m[key]
+	if !exists {
+		return "", false
+	}
+	s, ok := value.(string)
+	if !ok {
+		v.add(path+"."+key, MyGettextv("must be a string"))
+	}
+	return s, ok
+}
+
+func (v *configValidator) boolean(m map[string]interface{}, path string, key string) (bool, bool) {
+	value, exists := m[key]
+	if !exists {
+		return false, false
+	}
+	b, ok := value.(bool)
+	if !ok {
+		v.add(path+"."+key, MyGettextv("must be a boolean"))
+	}
+	return b, ok
+}
+
+func (v *configValidator) integer(m map[string]interface{}, path string, key string) (int, bool) {
+	value, exists := m[key]
+	if !exists {
+		return 0, false
+	}
+	f, ok := value.(float64)
+	if !ok || f != float64(int(f)) {
+		v.add(path+"."+key, MyGettextv("must be an integer"))
+		return 0, false
+	}
+	return int(f), true
+}
+
+func (v *configValidator) object(m map[string]interface{}, path string, key string) (map[string]interface{}, bool) {
+	value, exists := m[key]
+	if !exists {
+		return nil, false
+	}
+	o, ok := value.(map[string]interface{})
+	if !ok {
+		v.add(path+"."+key, MyGettextv("must be an object"))
+	}
+	return o, ok
+}
+
+func (v *configValidator) list(m map[string]interface{}, path string, key string) ([]interface{}, bool) {
+	value, exists := m[key]
+	if !exists {
+		return nil, false
+	}
+	l, ok := value.([]interface{})
+	if !ok {
+		v.add(path+"."+key, MyGettextv("must be a list"))
+	}
+	return l, ok
+}
+
+// Checks that the items of the list are strings, calling check (if not nil) with the path and value of each one
+func (v *configValidator) strings(m map[string]interface{}, path string, key string, check func(itemPath string, s string)) {
+	l, ok := v.list(m, path, key)
+	if !ok {
+		return
+	}
+	for i, item := range l {
+		itemPath := fmt.Sprintf("%v.%v[%v]", path, key, i)
+		s, ok := item.(string)
+		if !ok {
+			v.add(itemPath, MyGettextv("must be a string"))
+			continue
+		}
+		if check != nil {
+			check(itemPath, s)
+		}
+	}
+}
+
+// Returns the keys of the object sorted, so the problems are always reported in the same order
+func sortedKeys(m map[string]interface{}) []string {
+	keys := []string{}
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,259 @@
+package proxychangerlib
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/okelet/goutils"
+	"github.com/pkg/errors"
+)
+
+// Time to wait after the last change of the configuration file before reloading it; editors usually write
+// files in several steps
+const CONFIG_WATCH_DELAY = 1 * time.Second
+
+// Starts watching the configuration file for external changes. The directory of the file is watched instead
+// of the file itself, because the file is replaced (renamed) every time it is saved.
+func (c *Configuration) StartWatching() error {
+
+	if c.watcher != nil {
+		return nil
+	}
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return errors.Wrap(err, MyGettextv("Error creating the configuration file watcher"))
+	}
+
+	err = watcher.Add(filepath.Dir(c.Filename))
+	if err != nil {
+		watcher.Close()
+		return errors.Wrapf(err, MyGettextv("Error watching the directory %v", filepath.Dir(c.Filename)))
+	}
+
+	c.watcher = watcher
+	go c.watchLoop(watcher, filepath.Base(c.Filename))
+	Log.Infof("Watching configuration file %v for changes", c.Filename)
+	return nil
+
+}
+
+// Stops watching the configuration file
+func (c *Configuration) StopWatching() {
+	if c.watcher != nil {
+		c.watcher.Close()
+		c.watcher = nil
+	}
+}
+
+func (c *Configuration) watchLoop(watcher *fsnotify.Watcher, name string) {
+	var timer *time.Timer
+	for {
+		select {
+		case event, ok := <-watcher.Events:
+			if !ok {
+				if timer != nil {
+					timer.Stop()
+				}
+				return
+			}
+			if filepath.Base(event.Name) != name {
+				continue
+			}
+			Log.Debugf("Configuration file event: %v", event)
+			if timer != nil {
+				timer.Stop()
+			}
+			timer = time.AfterFunc(CONFIG_WATCH_DELAY, func() {
+				c.Update(c.CheckFileChanged)
+			})
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return
+			}
+			Log.Errorf("Error watching configuration file: %v", err)
+		}
+	}
+}
+
+// Checks if the configuration file has been changed by other program, and reloads it if the configuration
+// has not been changed in memory; if both have changed, the listeners are notified of the conflict, and the
+// configuration is not saved until the conflict is solved (see Reload and ForceSave).
+// Must be called holding the lock of the configuration.
+func (c *Configuration) CheckFileChanged() {
+
+	diskHash, err := GetFileHash(c.Filename)
+	if err != nil {
+		Log.Errorf("Error reading configuration file %v: %v", c.Filename, err)
+		return
+	}
+	if diskHash == c.lastDiskHash {
+		// Saved by us, or no real change
+		return
+	}
+
+	modified, err := c.IsModified()
+	if err != nil {
+		Log.Errorf("Error checking if the configuration has been modified: %v", err)
+		return
+	}
+	if modified {
+		Log.Warningf("Configuration file %v changed, but there are changes not saved; not reloading", c.Filename)
+		c.NotifyListeners(func(l ConfigListener) {
+			l.OnConfigConflict()
+		})
+		return
+	}
+
+	Log.Infof("Configuration file %v changed; reloading", c.Filename)
+	err = c.Reload()
+	if err != nil {
+		Log.Errorf("Error reloading configuration: %v", err)
+	}
+
+}
+
+// Reloads the configuration from its file, discarding the changes not saved. The active proxy is kept if it
+// still exists in the file (applying it again if its settings have changed); otherwise, it is deactivated.
+func (c *Configuration) Reload() error {
+
+	var previousUuid string
+	var previousHash string
+	if c.ActiveProxy != nil {
+		previousUuid = c.ActiveProxy.UUID
+		data, err := c.ActiveProxy.ToMap(c, false)
+		if err != nil {
+			return errors.Wrapf(err, "Error exporting proxy %v", c.ActiveProxy.Name)
+		}
+		previousHash, err = GetMapHelperHash(data)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Avoid deactivating the proxy while loading; the active proxy from the file is ignored below
+	previousProxy := c.ActiveProxy
+	c.ActiveProxy = nil
+	err := c.Load(c.Filename, false, false, false)
+	if err != nil {
+		c.ActiveProxy = previousProxy
+		return err
+	}
+	fileUuid := ""
+	if c.ActiveProxy != nil {
+		fileUuid = c.ActiveProxy.UUID
+	}
+	c.ActiveProxy = nil
+
+	err = c.UpdateSavedState()
+	if err != nil {
+		return err
+	}
+
+	if previousUuid == "" {
+		if fileUuid != "" {
+			return c.Save("Keeping no proxy active after reload")
+		}
+		return nil
+	}
+
+	p := c.GetProxyWithUuid(previousUuid)
+	if p == nil {
+		Log.Infof("Active proxy removed from the configuration file; deactivating")
+		c.ActiveProxy = previousProxy
+		_, err = c.SetActiveProxy(nil, MyGettextv("Active proxy removed from the configuration"), true)
+		return err
+	}
+
+	data, err := p.ToMap(c, false)
+	if err != nil {
+		return errors.Wrapf(err, "Error exporting proxy %v", p.Name)
+	}
+	currentHash, err := GetMapHelperHash(data)
+	if err != nil {
+		return err
+	}
+	if currentHash != previousHash {
+		Log.Infof("Active proxy %v changed in the configuration file; applying it again", p.Name)
+		_, err = c.SetActiveProxy(p, MyGettextv("Configuration reloaded"), fileUuid != p.UUID)
+		return err
+	}
+	c.ActiveProxy = p
+	if fileUuid != p.UUID {
+		return c.Save("Keeping active proxy after reload")
+	}
+	return nil
+
+}
+
+// Returns true if the configuration has changes not saved to its file
+func (c *Configuration) IsModified() (bool, error) {
+	data, err := c.ToMap(false)
+	if err != nil {
+		return false, errors.Wrap(err, "Error exporting configuration")
+	}
+	hash, err := GetMapHelperHash(data)
+	if err != nil {
+		return false, err
+	}
+	return hash != c.lastSavedHash, nil
+}
+
+// Returns true if the configuration file has been changed by other program since it was loaded or saved
+func (c *Configuration) IsFileChanged() (bool, error) {
+	diskHash, err := GetFileHash(c.Filename)
+	if err != nil {
+		return false, errors.Wrapf(err, MyGettextv("Error reading configuration file %v", c.Filename))
+	}
+	return diskHash != c.lastDiskHash, nil
+}
+
+// Remembers the current contents of the configuration file and of the configuration in memory, after loading
+// or saving it
+func (c *Configuration) UpdateSavedState() error {
+	diskHash, err := GetFileHash(c.Filename)
+	if err != nil {
+		return errors.Wrapf(err, MyGettextv("Error reading configuration file %v", c.Filename))
+	}
+	data, err := c.ToMap(false)
+	if err != nil {
+		return errors.Wrap(err, "Error exporting configuration")
+	}
+	savedHash, err := GetMapHelperHash(data)
+	if err != nil {
+		return err
+	}
+	c.lastDiskHash = diskHash
+	c.lastSavedHash = savedHash
+	return nil
+}
+
+// Returns the SHA-256 of the contents of the file, or an empty string if the file doesn't exist
+func GetFileHash(filename string) (string, error) {
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return "", nil
+		}
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// Returns the SHA-256 of the JSON representation of the helper; the keys of the maps are sorted, so the
+// same data always has the same hash
+func GetMapHelperHash(h *goutils.MapHelper) (string, error) {
+	data, err := json.Marshal(h.Data)
+	if err != nil {
+		return "", errors.Wrap(err, "Error serializing configuration")
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
@@ -3,17 +3,20 @@ package proxychangerlib
 import (
 	"fmt"
 	"os"
+	"os/user"
 	"path"
 	"path/filepath"
 
 	"github.com/pkg/errors"
 	lumberjack "gopkg.in/natefinch/lumberjack.v2"
 
-	"github.com/gotk3/gotk3/glib"
 	"github.com/juju/loggo"
 )
 
 var ApplicationAlreadyRunningError error
+var ConfigurationConflictError error
+var PassphraseRequiredError error
+var InvalidPassphraseError error
 
 const DBUS_PATH = "/com/github/okelet/proxychanger"
 const DBUS_INTERFACE = "com.github.okelet.proxychanger"
@@ -28,10 +31,18 @@ const ICON_NAME = "proxychanger"
 const GETTEXT_DOMAIN = "proxychanger"
 
 var HOME_DIR string
+
+// Base directory of the user configuration files ($XDG_CONFIG_HOME or ~/.config)
+var CONFIG_DIR string
 var APP_DIR string
 var LOCALE_DIR string
 var AUTOSTART_DIR string
 var AUTOSTART_FILE string
+var CUSTOM_APPS_DIR string
+var PLUGINS_DIR string
+
+// File that changes every time a proxy is activated or deactivated; read by the shell hooks
+var ACTIVE_PROXY_STATE_FILE string
 
 const LOG_FILENAME = "proxychanger.log"
 
@@ -55,7 +66,7 @@ func InitConstants() error {
 
 	DEFAULT_EXCLUDED_INTERFACES_REGEXPS = []string{"^lo$", "^virbr[0-9]+$", "virbr[0-9]+-nic", "docker[0-9]+"}
 
-	HOME_DIR = glib.GetHomeDir()
+	HOME_DIR = GetHomeDir()
 	if HOME_DIR == "" {
 		return errors.New("Empty user home dir")
 	}
@@ -66,7 +77,11 @@ func InitConstants() error {
 	LOG_PATH = path.Join(APP_DIR, LOG_FILENAME)
 
 	LOCALE_DIR = path.Join(APP_DIR, "locale")
-	AUTOSTART_DIR = path.Join(glib.GetUserConfigDir(), "autostart")
+	CUSTOM_APPS_DIR = path.Join(APP_DIR, "apps.d")
+	PLUGINS_DIR = path.Join(APP_DIR, "plugins")
+	ACTIVE_PROXY_STATE_FILE = path.Join(APP_DIR, "active_proxy")
+	CONFIG_DIR = GetUserConfigDir()
+	AUTOSTART_DIR = path.Join(CONFIG_DIR, "autostart")
 	AUTOSTART_FILE = path.Join(AUTOSTART_DIR, "proxychanger.desktop")
 
 	Log = loggo.GetLogger("com.github.okelet.proxychanger")
@@ -85,11 +100,36 @@ func InitConstants() error {
 
 	// Errors
 	ApplicationAlreadyRunningError = errors.New(MyGettextv("Application already running"))
+	ConfigurationConflictError = errors.New(MyGettextv("The configuration file has been changed by other program"))
+	PassphraseRequiredError = errors.New(MyGettextv("The file has encrypted passwords; a passphrase is required"))
+	InvalidPassphraseError = errors.New(MyGettextv("Invalid passphrase"))
 
 	return nil
 
 }
 
+// Returns the home directory of the user, from $HOME or, if empty, from the user database
+func GetHomeDir() string {
+	home := os.Getenv("HOME")
+	if home != "" {
+		return home
+	}
+	u, err := user.Current()
+	if err != nil {
+		return ""
+	}
+	return u.HomeDir
+}
+
+// Returns the base directory of the user configuration files, following the XDG base directory specification
+func GetUserConfigDir() string {
+	configDir := os.Getenv("XDG_CONFIG_HOME")
+	if configDir != "" && filepath.IsAbs(configDir) {
+		return configDir
+	}
+	return path.Join(GetHomeDir(), ".config")
+}
+
 // Add a new writer to log to the error output
 func AddErrorOutputLogging() {
 	loggo.RegisterWriter("stderr", loggo.NewSimpleWriter(os.Stderr, func(entry loggo.Entry) string {
@@ -0,0 +1,105 @@
+package proxychangerlib
+
+import (
+	"bytes"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/okelet/goutils"
+	"github.com/pkg/errors"
+)
+
+// Helper that changes the system files, run as root using pkexec; it is installed with the polkit policy
+// com.github.okelet.proxychanger.policy
+const PRIVILEGED_HELPER_PATH = "/usr/libexec/proxychanger/proxychanger-helper"
+
+// Writes a file owned by root (it must be allowed by the helper), using the privileged helper unless this
+// process is already running as root; the helper decides the mode of the new files, and perm is only used
+// in the latter case
+func WriteSystemFile(filename string, data []byte, perm os.FileMode) error {
+	if os.Geteuid() == 0 {
+		err := os.MkdirAll(filepath.Dir(filename), 0755)
+		if err != nil {
+			return errors.Wrapf(err, MyGettextv("Error creating directory %v", filepath.Dir(filename)))
+		}
+		return SafeWriteFile(filename, data, perm)
+	}
+	return RunPrivilegedHelper(data, "write", filename)
+}
+
+// Writes a system file holding credentials, only readable by root; see WriteSystemFile
+func WriteSystemCredentialsFile(filename string, data []byte) error {
+	if os.Geteuid() == 0 {
+		err := os.MkdirAll(filepath.Dir(filename), 0755)
+		if err != nil {
+			return errors.Wrapf(err, MyGettextv("Error creating directory %v", filepath.Dir(filename)))
+		}
+		return SafeWriteCredentialsFile(filename, data)
+	}
+	return RunPrivilegedHelper(data, "write-credentials", filename)
+}
+
+func RemoveSystemFile(filename string) error {
+	if os.Geteuid() == 0 {
+		err := os.Remove(filename)
+		if err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		return nil
+	}
+	return RunPrivilegedHelper(nil, "remove", filename)
+}
+
+// Reloads the systemd configuration and restarts the service
+func RestartSystemService(service string) error {
+	if os.Geteuid() == 0 {
+		for _, args := range [][]string{{"daemon-reload"}, {"restart", service}} {
+			err, _, exitCode, outBuff, errBuff := goutils.RunCommandAndWait("", nil, "systemctl", args, map[string]string{})
+			if err != nil {
+				return errors.New(MyGettextv("Error running command %v (%v): %v", "systemctl "+strings.Join(args, " "), exitCode, goutils.CombineStdErrOutput(outBuff, errBuff)))
+			}
+		}
+		return nil
+	}
+	return RunPrivilegedHelper(nil, "restart", service)
+}
+
+// Returns if the system files can be changed, because the process runs as root or the helper is installed
+func IsPrivilegedHelperAvailable() bool {
+	if os.Geteuid() == 0 {
+		return true
+	}
+	exists, err := goutils.FileExists(PRIVILEGED_HELPER_PATH)
+	return err == nil && exists
+}
+
+func RunPrivilegedHelper(stdin []byte, args ...string) error {
+
+	exists, err := goutils.FileExists(PRIVILEGED_HELPER_PATH)
+	if err != nil {
+		return errors.Wrapf(err, MyGettextv("Error checking if file %v exists", PRIVILEGED_HELPER_PATH))
+	}
+	if !exists {
+		return errors.New(MyGettextv("The privileged helper %v is not installed", PRIVILEGED_HELPER_PATH))
+	}
+
+	pkexecPath, err := exec.LookPath("pkexec")
+	if err != nil {
+		return errors.New(MyGettextv("Command %v not found", "pkexec"))
+	}
+
+	Log.Debugf("Running privileged helper with arguments %v...", args)
+	err, _, exitCode, outBuff, errBuff := goutils.RunCommandAndWait("", bytes.NewReader(stdin), pkexecPath, append([]string{PRIVILEGED_HELPER_PATH}, args...), map[string]string{})
+	if err != nil {
+		// pkexec exits with 126 when the authorization is dismissed or denied
+		if exitCode == 126 || exitCode == 127 {
+			return errors.New(MyGettextv("Not authorized to change the system settings"))
+		}
+		return errors.New(MyGettextv("Error running command %v (%v): %v", PRIVILEGED_HELPER_PATH+" "+strings.Join(args, " "), exitCode, goutils.CombineStdErrOutput(outBuff, errBuff)))
+	}
+
+	return nil
+
+}
@@ -1,40 +1,177 @@
 package proxychangerlib
 
 import (
+	"fmt"
 	"net"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
 
-	"github.com/gotk3/gotk3/glib"
-	"github.com/gotk3/gotk3/gtk"
 	"github.com/okelet/goutils"
 	"github.com/pkg/errors"
 )
 
+// Schemes that can have their own proxy endpoint
+const PROXY_SCHEME_HTTP = "http"
+const PROXY_SCHEME_HTTPS = "https"
+const PROXY_SCHEME_FTP = "ftp"
+const PROXY_SCHEME_SOCKS = "socks"
+
+var PROXY_SCHEMES = []string{PROXY_SCHEME_HTTP, PROXY_SCHEME_HTTPS, PROXY_SCHEME_FTP, PROXY_SCHEME_SOCKS}
+
 type Proxy struct {
 	*goutils.Proxy
-	Slug                string
-	Name                string
-	MatchingIps         []string
-	RadioMenuItem       *gtk.RadioMenuItem
-	RadioMenuItemHandle glib.SignalHandle
+	Slug        string
+	Name        string
+	MatchingIps []string
 	// Script to run when this proxy is activated
 	ActivateScript string
+	// If not empty, the proxy is only applied to these applications
+	IncludedApplicationsIds []string
+	// The proxy is never applied to these applications
+	ExcludedApplicationsIds []string
+	// Settings that replace the proxy ones in some applications, by application id
+	ApplicationOverrides map[string]*ProxyApplicationOverride
+	// Proxies used for some schemes instead of the main one, by scheme (see PROXY_SCHEMES)
+	SchemeEndpoints map[string]*ProxyEndpoint
+	// If true, the password is not written in plain text in the applications settings; the native secret
+	// mechanism of each application is used if available, and a local endpoint that adds the credentials if not
+	CredentialSafe bool
+	// Loaded from the system configuration; it can't be changed or deleted, and it is not saved
+	Locked bool
+	// Set in the copies that use the local endpoints, so the password is never given to the applications
+	passwordWithheld bool
+}
+
+// Proxy used for a scheme; it uses the username and password of the main proxy
+type ProxyEndpoint struct {
+	Protocol string
+	Address  string
+	Port     int
+}
+
+func NewProxyEndpointFromMap(h *goutils.MapHelper) *ProxyEndpoint {
+	return &ProxyEndpoint{
+		Protocol: h.GetString("protocol", ""),
+		Address:  h.GetString("address", ""),
+		Port:     h.GetInt("port", 0),
+	}
+}
+
+// Parses an endpoint in the format [protocol://]address:port; the protocol defaults to socks5 for the
+// SOCKS scheme and http for the rest
+func ParseProxyEndpoint(scheme string, text string) (*ProxyEndpoint, error) {
+	e := ProxyEndpoint{Protocol: "http"}
+	if scheme == PROXY_SCHEME_SOCKS {
+		e.Protocol = "socks5"
+	}
+	if i := strings.Index(text, "://"); i >= 0 {
+		e.Protocol = text[:i]
+		text = text[i+3:]
+	}
+	host, port, err := net.SplitHostPort(strings.TrimSuffix(text, "/"))
+	if err != nil {
+		return nil, errors.New(MyGettextv("Invalid %v proxy %v: %v", scheme, text, err))
+	}
+	e.Address = host
+	e.Port, err = strconv.Atoi(port)
+	if err != nil || e.Port <= 0 || e.Port >= 65535 {
+		return nil, errors.New(MyGettextv("Invalid %v proxy port %v", scheme, port))
+	}
+	return &e, nil
+}
+
+func (e *ProxyEndpoint) String() string {
+	return fmt.Sprintf("%v://%v", e.Protocol, net.JoinHostPort(e.Address, strconv.Itoa(e.Port)))
+}
+
+func (e *ProxyEndpoint) ToMap(scheme string) *goutils.MapHelper {
+	h := goutils.NewEmptyMapHelper()
+	h.SetString("scheme", scheme)
+	h.SetString("protocol", e.Protocol)
+	h.SetString("address", e.Address)
+	h.SetInt("port", e.Port)
+	return h
+}
+
+// Proxy settings used only in an application; empty values keep the proxy ones
+type ProxyApplicationOverride struct {
+	Address    string
+	Port       int
+	Exceptions []string
+}
+
+func NewProxyApplicationOverrideFromMap(h *goutils.MapHelper) *ProxyApplicationOverride {
+	o := ProxyApplicationOverride{
+		Address: h.GetString("address", ""),
+		Port:    h.GetInt("port", 0),
+	}
+	if h.Exists("exceptions") {
+		o.Exceptions = h.GetListOfStrings("exceptions", []string{})
+	}
+	return &o
+}
+
+func (o *ProxyApplicationOverride) IsEmpty() bool {
+	return o.Address == "" && o.Port == 0 && o.Exceptions == nil
+}
+
+func (o *ProxyApplicationOverride) ToMap(applicationId string) *goutils.MapHelper {
+	h := goutils.NewEmptyMapHelper()
+	h.SetString("application", applicationId)
+	if o.Address != "" {
+		h.SetString("address", o.Address)
+	}
+	if o.Port != 0 {
+		h.SetInt("port", o.Port)
+	}
+	if o.Exceptions != nil {
+		h.SetListOfStrings("exceptions", o.Exceptions)
+	}
+	return h
 }
 
 func NewEmptyProxy(passwordManager goutils.ProxyPasswordManager) *Proxy {
 	p := Proxy{
-		Proxy:       goutils.NewEmptyProxy(passwordManager),
-		MatchingIps: []string{},
+		Proxy:                   goutils.NewEmptyProxy(passwordManager),
+		MatchingIps:             []string{},
+		IncludedApplicationsIds: []string{},
+		ExcludedApplicationsIds: []string{},
+		ApplicationOverrides:    map[string]*ProxyApplicationOverride{},
+		SchemeEndpoints:         map[string]*ProxyEndpoint{},
 	}
 	return &p
 }
 
 func NewProxyFromMap(c *Configuration, h *goutils.MapHelper, loadPasswordFromMap bool) (*Proxy, error) {
 	p := Proxy{
-		Proxy:          goutils.NewProxyFromMap(h, c, loadPasswordFromMap),
-		Slug:           h.GetString("slug", ""),
-		Name:           h.GetString("name", ""),
-		MatchingIps:    h.GetListOfStrings("matching_ips", []string{}),
-		ActivateScript: h.GetString("activate_script", ""),
+		Proxy:                   goutils.NewProxyFromMap(h, c, loadPasswordFromMap),
+		Slug:                    h.GetString("slug", ""),
+		Name:                    h.GetString("name", ""),
+		MatchingIps:             h.GetListOfStrings("matching_ips", []string{}),
+		ActivateScript:          h.GetString("activate_script", ""),
+		IncludedApplicationsIds: h.GetListOfStrings("included_applications", []string{}),
+		ExcludedApplicationsIds: h.GetListOfStrings("excluded_applications", []string{}),
+		ApplicationOverrides:    map[string]*ProxyApplicationOverride{},
+		SchemeEndpoints:         map[string]*ProxyEndpoint{},
+		CredentialSafe:          h.GetBoolean("credential_safe", false),
+	}
+	for _, eh := range h.GetListOfHelpers("scheme_endpoints") {
+		scheme := eh.GetString("scheme", "")
+		if !goutils.ListContainsString(PROXY_SCHEMES, scheme) {
+			Log.Errorf("Ignoring endpoint with invalid scheme %v in proxy %v", scheme, p.Name)
+			continue
+		}
+		p.SchemeEndpoints[scheme] = NewProxyEndpointFromMap(eh)
+	}
+	for _, oh := range h.GetListOfHelpers("application_overrides") {
+		applicationId := oh.GetString("application", "")
+		if applicationId == "" {
+			Log.Errorf("Ignoring application override without application in proxy %v", p.Name)
+			continue
+		}
+		p.ApplicationOverrides[applicationId] = NewProxyApplicationOverrideFromMap(oh)
 	}
 	if p.Name == "" || c.IsNameAlreadyInUse(p.Name, &p) {
 		p.Name = c.CreateUniqueName(p.Name, &p)
@@ -47,9 +184,13 @@ func NewProxyFromMap(c *Configuration, h *goutils.MapHelper, loadPasswordFromMap
 
 func NewImportedProxy(c *Configuration, importedProxy *goutils.Proxy, name, slug string) *Proxy {
 	p := Proxy{
-		Proxy: importedProxy,
-		Slug:  slug,
-		Name:  name,
+		Proxy:                   importedProxy,
+		Slug:                    slug,
+		Name:                    name,
+		IncludedApplicationsIds: []string{},
+		ExcludedApplicationsIds: []string{},
+		ApplicationOverrides:    map[string]*ProxyApplicationOverride{},
+		SchemeEndpoints:         map[string]*ProxyEndpoint{},
 	}
 	if p.Slug == "" && p.Name != "" {
 		p.Slug = c.CreateUniqueSlug(p.Name, nil)
@@ -57,6 +198,22 @@ func NewImportedProxy(c *Configuration, importedProxy *goutils.Proxy, name, slug
 	return &p
 }
 
+// Replaces the settings of the proxy with the ones of the other proxy, keeping its UUID
+func (p *Proxy) CopyFrom(other *Proxy) {
+	uuid := p.UUID
+	*p.Proxy = *other.Proxy
+	p.UUID = uuid
+	p.Slug = other.Slug
+	p.Name = other.Name
+	p.MatchingIps = other.MatchingIps
+	p.ActivateScript = other.ActivateScript
+	p.IncludedApplicationsIds = other.IncludedApplicationsIds
+	p.ExcludedApplicationsIds = other.ExcludedApplicationsIds
+	p.ApplicationOverrides = other.ApplicationOverrides
+	p.SchemeEndpoints = other.SchemeEndpoints
+	p.CredentialSafe = other.CredentialSafe
+}
+
 func (p *Proxy) ToMap(c *Configuration, includePassword bool) (*goutils.MapHelper, error) {
 	h, err := p.Proxy.ToMap(false)
 	if err != nil {
@@ -80,9 +237,175 @@ func (p *Proxy) ToMap(c *Configuration, includePassword bool) (*goutils.MapHelpe
 	if p.ActivateScript != "" {
 		h.SetString("activate_script", p.ActivateScript)
 	}
+	if len(p.IncludedApplicationsIds) > 0 {
+		h.SetListOfStrings("included_applications", p.IncludedApplicationsIds)
+	}
+	if len(p.ExcludedApplicationsIds) > 0 {
+		h.SetListOfStrings("excluded_applications", p.ExcludedApplicationsIds)
+	}
+	if p.CredentialSafe {
+		h.SetBoolean("credential_safe", true)
+	}
+	if len(p.SchemeEndpoints) > 0 {
+		endpoints := []*goutils.MapHelper{}
+		for _, scheme := range PROXY_SCHEMES {
+			if e, ok := p.SchemeEndpoints[scheme]; ok {
+				endpoints = append(endpoints, e.ToMap(scheme))
+			}
+		}
+		h.SetListOfHelpers("scheme_endpoints", endpoints)
+	}
+	if len(p.ApplicationOverrides) > 0 {
+		applicationIds := []string{}
+		for applicationId := range p.ApplicationOverrides {
+			applicationIds = append(applicationIds, applicationId)
+		}
+		sort.Strings(applicationIds)
+		overrides := []*goutils.MapHelper{}
+		for _, applicationId := range applicationIds {
+			overrides = append(overrides, p.ApplicationOverrides[applicationId].ToMap(applicationId))
+		}
+		h.SetListOfHelpers("application_overrides", overrides)
+	}
 	return h, nil
 }
 
+// Returns the endpoint of the scheme; HTTP, HTTPS and FTP fall back to the main proxy, but SOCKS does not
+// (nil is returned if not defined)
+func (p *Proxy) GetSchemeEndpoint(scheme string) *ProxyEndpoint {
+	if e, ok := p.SchemeEndpoints[scheme]; ok {
+		return e
+	}
+	if scheme == PROXY_SCHEME_SOCKS {
+		return nil
+	}
+	return &ProxyEndpoint{Protocol: p.Protocol, Address: p.Address, Port: p.Port}
+}
+
+// Returns the URL of the proxy for the scheme, including the credentials of the main proxy; an empty
+// string is returned for SOCKS if not defined
+func (p *Proxy) GetSchemeUrl(scheme string, includePassword bool) (string, error) {
+	if _, ok := p.SchemeEndpoints[scheme]; !ok {
+		if scheme == PROXY_SCHEME_SOCKS {
+			return "", nil
+		}
+		return p.ToUrl(includePassword)
+	}
+	e := p.GetSchemeEndpoint(scheme)
+	u := url.URL{Scheme: e.Protocol, Host: net.JoinHostPort(e.Address, strconv.Itoa(e.Port))}
+	if p.Username != "" {
+		var password string
+		if includePassword {
+			var err error
+			password, err = p.GetPassword()
+			if err != nil {
+				return "", err
+			}
+		}
+		if password != "" {
+			u.User = url.UserPassword(p.Username, password)
+		} else {
+			u.User = url.User(p.Username)
+		}
+	}
+	return u.String(), nil
+}
+
+// Returns the URL of the proxy for the scheme without credentials; an empty string is returned for SOCKS
+// if not defined
+func (p *Proxy) GetSchemeSimpleUrl(scheme string) string {
+	if _, ok := p.SchemeEndpoints[scheme]; !ok {
+		if scheme == PROXY_SCHEME_SOCKS {
+			return ""
+		}
+		return p.ToSimpleUrl()
+	}
+	return p.SchemeEndpoints[scheme].String()
+}
+
+// Returns if this proxy must be applied to the application with the id, according to the lists of included
+// and excluded applications
+func (p *Proxy) AppliesToApplication(applicationId string) bool {
+	if len(p.IncludedApplicationsIds) > 0 && !goutils.ListContainsString(p.IncludedApplicationsIds, applicationId) {
+		return false
+	}
+	return !goutils.ListContainsString(p.ExcludedApplicationsIds, applicationId)
+}
+
+// Returns the proxy to apply to the application with the id; if the application has overrides, a copy
+// of the proxy with the overridden settings is returned
+func (p *Proxy) ForApplication(applicationId string) *Proxy {
+	o, ok := p.ApplicationOverrides[applicationId]
+	if !ok || o.IsEmpty() {
+		return p
+	}
+	baseProxy := *p.Proxy
+	if o.Address != "" {
+		baseProxy.Address = o.Address
+	}
+	if o.Port != 0 {
+		baseProxy.Port = o.Port
+	}
+	if o.Exceptions != nil {
+		baseProxy.Exceptions = o.Exceptions
+	}
+	overriddenProxy := *p
+	overriddenProxy.Proxy = &baseProxy
+	return &overriddenProxy
+}
+
+// Returns if the password of this proxy must not be written in plain text in the applications settings
+func (p *Proxy) NeedsCredentialSafeMode() bool {
+	return p.CredentialSafe && p.Username != ""
+}
+
+// Returns if the proxy has a password, so the files where it is written must be only readable by the user
+func (p *Proxy) HasPassword() (bool, error) {
+	if p.Username == "" {
+		return false, nil
+	}
+	password, err := p.GetPassword()
+	if err != nil {
+		return false, err
+	}
+	return password != "", nil
+}
+
+// Returns the password of the proxy, or an empty one if it is withheld (see ForLocalEndpoints)
+func (p *Proxy) GetPassword() (string, error) {
+	if p.passwordWithheld {
+		return "", nil
+	}
+	return p.Proxy.GetPassword()
+}
+
+// Returns a copy of the proxy that uses the local endpoints (by scheme) instead of the real ones, and
+// without credentials. The SOCKS endpoint can't be used without credentials, so it is removed, returning
+// a warning
+func (p *Proxy) ForLocalEndpoints(endpoints map[string]*ProxyEndpoint) (*Proxy, string) {
+	baseProxy := *p.Proxy
+	baseProxy.Username = ""
+	if e, ok := endpoints[PROXY_SCHEME_HTTP]; ok {
+		baseProxy.Protocol = e.Protocol
+		baseProxy.Address = e.Address
+		baseProxy.Port = e.Port
+	}
+	localProxy := *p
+	localProxy.Proxy = &baseProxy
+	localProxy.passwordWithheld = true
+	localProxy.SchemeEndpoints = map[string]*ProxyEndpoint{}
+	for scheme, e := range endpoints {
+		if scheme != PROXY_SCHEME_HTTP {
+			localProxy.SchemeEndpoints[scheme] = e
+		}
+	}
+	var warning string
+	if _, ok := p.SchemeEndpoints[PROXY_SCHEME_SOCKS]; ok {
+		warning = MyGettextv("The SOCKS endpoint of proxy %v is not applied, as the local endpoints only support HTTP", p.Name)
+	}
+	return &localProxy, warning
+}
+
 func (p *Proxy) MatchesIps(ips []string) bool {
 	parsedIps := []net.IP{}
 	for _, i := range ips {
@@ -0,0 +1,51 @@
+package proxychangerlib
+
+import (
+	"testing"
+)
+
+func TestForLocalEndpointsWithholdsPassword(t *testing.T) {
+	c := newTestConfiguration(t)
+	p := newTestProxy(c, "Proxy")
+	p.Username = "user"
+	err := c.SetPassword(p.UUID, "secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	p.SchemeEndpoints[PROXY_SCHEME_SOCKS] = &ProxyEndpoint{Protocol: "socks5", Address: "proxy.example.com", Port: 1080}
+
+	localProxy, warning := p.ForLocalEndpoints(map[string]*ProxyEndpoint{
+		PROXY_SCHEME_HTTP:  {Protocol: "http", Address: "127.0.0.1", Port: 53128},
+		PROXY_SCHEME_HTTPS: {Protocol: "http", Address: "127.0.0.1", Port: 53129},
+	})
+	if warning == "" {
+		t.Error("no warning for the SOCKS endpoint")
+	}
+	if _, ok := localProxy.SchemeEndpoints[PROXY_SCHEME_SOCKS]; ok {
+		t.Error("SOCKS endpoint kept")
+	}
+
+	password, err := localProxy.GetPassword()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if password != "" {
+		t.Errorf("local proxy returns the password %q", password)
+	}
+	data, err := NewProxyTemplateData(localProxy)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if data.Password != "" || data.Url != "http://127.0.0.1:53128" {
+		t.Errorf("template data has the password: %v %v", data.Password, data.Url)
+	}
+
+	// The original proxy is not changed
+	password, err = p.GetPassword()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if password != "secret" {
+		t.Errorf("proxy returns the password %q", password)
+	}
+}
@@ -0,0 +1,162 @@
+package proxychangerlib
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/okelet/goutils"
+	"github.com/pkg/errors"
+)
+
+// Delimiters of the block managed by this application in the shell rc files; the rest of the file is never
+// modified
+const RC_BLOCK_BEGIN = "# BEGIN proxychanger"
+const RC_BLOCK_END = "# END proxychanger"
+
+// Lines written at the end of the rc files by the versions that didn't use the managed block
+var RC_LEGACY_PROXY_LINE_REGEXP = regexp.MustCompile("(?i)^(export |set -gx )?(http|ftp|https|all|no)_proxy([= ]|$)")
+
+// Replaces the content of the managed block of the file with the lines; the block is added at the end of the
+// file if it doesn't exist yet. An empty list leaves the block empty, so the legacy lines (see MigrateRcFileLines),
+// when migrateLegacyLines is true, are only removed the first time. If credentials is true (the lines have a
+// password), the file is made only readable by the user.
+func UpdateRcFileBlock(filename string, blockLines []string, migrateLegacyLines bool, credentials bool) error {
+
+	data, err := ioutil.ReadFile(filename)
+	if err != nil && !os.IsNotExist(err) {
+		return errors.Wrapf(err, MyGettextv("Error reading file %v", filename))
+	}
+
+	newData, changed, err := RenderRcFileBlock(filename, data, blockLines, migrateLegacyLines)
+	if err != nil || !changed {
+		return err
+	}
+
+	if credentials {
+		return SafeWriteCredentialsFile(filename, newData)
+	}
+	return SafeWriteFile(filename, newData, 0644)
+
+}
+
+// Returns the content of the file (data) with the managed block replaced by the lines, and if it has changed;
+// see UpdateRcFileBlock
+func RenderRcFileBlock(filename string, data []byte, blockLines []string, migrateLegacyLines bool) ([]byte, bool, error) {
+
+	lines := []string{}
+	if len(data) > 0 {
+		lines = strings.Split(strings.TrimSuffix(string(data), "\n"), "\n")
+	}
+
+	begin, end := -1, -1
+	for i, line := range lines {
+		if strings.TrimSpace(line) == RC_BLOCK_BEGIN && begin == -1 {
+			begin = i
+		} else if strings.TrimSpace(line) == RC_BLOCK_END && begin != -1 {
+			end = i
+			break
+		}
+	}
+	if begin != -1 && end == -1 {
+		return nil, false, errors.New(MyGettextv("The file %v has the line \"%v\" but not the line \"%v\"; fix it manually", filename, RC_BLOCK_BEGIN, RC_BLOCK_END))
+	}
+
+	block := append(append([]string{RC_BLOCK_BEGIN}, blockLines...), RC_BLOCK_END)
+
+	var newLines []string
+	if begin != -1 {
+		newLines = append(append(append([]string{}, lines[:begin]...), block...), lines[end+1:]...)
+	} else {
+		migratedLines := lines
+		if migrateLegacyLines {
+			migratedLines = MigrateRcFileLines(lines)
+		}
+		if len(blockLines) == 0 && len(migratedLines) == len(lines) {
+			// Nothing to remove, and nothing to add
+			return data, false, nil
+		}
+		if len(migratedLines) > 0 {
+			migratedLines = append(migratedLines, "")
+		}
+		newLines = append(migratedLines, block...)
+	}
+
+	newData := []byte(strings.Join(newLines, "\n") + "\n")
+	return newData, !bytes.Equal(data, newData), nil
+
+}
+
+// Removes the proxy lines that older versions added at the end of the file (and the blank lines after them)
+func MigrateRcFileLines(lines []string) []string {
+	last := len(lines)
+	for last > 0 && strings.TrimSpace(lines[last-1]) == "" {
+		last--
+	}
+	legacyStart := last
+	for legacyStart > 0 && RC_LEGACY_PROXY_LINE_REGEXP.MatchString(lines[legacyStart-1]) {
+		legacyStart--
+	}
+	if legacyStart == last {
+		return lines
+	}
+	Log.Infof("Removing %v proxy lines written by a previous version", last-legacyStart)
+	for legacyStart > 0 && strings.TrimSpace(lines[legacyStart-1]) == "" {
+		legacyStart--
+	}
+	return lines[:legacyStart]
+}
+
+// Sets the proxy in the managed block of a shell startup file, using the syntax of the shell (see SHELL_SYNTAX_*);
+// the file is backed up the first time
+func ApplyProxyToRcFile(a ProxifiedApplication, p *Proxy, file string, syntax string, migrateLegacyLines bool) *AppProxyChangeResult {
+
+	var err error
+	blockLines := []string{}
+	credentials := false
+
+	if p != nil {
+		variables, err := ProxyEnvironmentVariables(p)
+		if err != nil {
+			return &AppProxyChangeResult{a, "", "", MyGettextv("Error generating proxy URL: %v", err)}
+		}
+		blockLines = ShellExportLines(syntax, variables)
+		credentials, err = p.HasPassword()
+		if err != nil {
+			return &AppProxyChangeResult{a, "", "", MyGettextv("Error getting proxy password: %v", err)}
+		}
+	}
+
+	fileExists, err := goutils.FileExists(file)
+	if err != nil {
+		return &AppProxyChangeResult{a, "", "", MyGettextv("Error checking if file %v exists: %v", file, err)}
+	} else if fileExists {
+		fileBackup := file + ".proxychanger_backup"
+		fileBackupExists, err := goutils.FileExists(fileBackup)
+		if err != nil {
+			return &AppProxyChangeResult{a, "", "", MyGettextv("Error checking if file %v exists: %v", fileBackup, err)}
+		}
+		if !fileBackupExists {
+			err := goutils.CopyFile(file, fileBackup)
+			if err != nil {
+				return &AppProxyChangeResult{a, "", "", MyGettextv("Error backing up file %v to %v: %v", file, fileBackup, err)}
+			}
+		}
+	} else if p != nil {
+		err = os.MkdirAll(path.Dir(file), 0755)
+		if err != nil {
+			return &AppProxyChangeResult{a, "", "", MyGettextv("Error creating directory %v: %v", path.Dir(file), err)}
+		}
+	}
+
+	err = UpdateRcFileBlock(file, blockLines, migrateLegacyLines, credentials)
+	if err != nil {
+		return &AppProxyChangeResult{a, "", "", MyGettextv("Error writing the file %v: %v", file, err)}
+	}
+
+	return &AppProxyChangeResult{a, "", "", ""}
+
+}
@@ -0,0 +1,124 @@
+package proxychangerlib
+
+import (
+	"reflect"
+	"sort"
+
+	"github.com/pkg/errors"
+)
+
+// Types of secret stores that can be selected in the configuration
+const SECRET_STORE_KEYRING = "keyring"
+const SECRET_STORE_PASS = "pass"
+const SECRET_STORE_FILE = "file"
+const SECRET_STORE_ENV = "env"
+const SECRET_STORE_COMMAND = "command"
+
+const DEFAULT_SECRET_STORE = SECRET_STORE_KEYRING
+
+var SECRET_STORES = []string{SECRET_STORE_KEYRING, SECRET_STORE_PASS, SECRET_STORE_FILE, SECRET_STORE_ENV, SECRET_STORE_COMMAND}
+
+// Storage for the proxies passwords, identified by the proxy UUID
+type SecretStore interface {
+	// Returns the type of the store (one of SECRET_STORES)
+	GetType() string
+	// Returns the password, or an empty string if not found
+	Get(uuid string) (string, error)
+	Set(uuid string, password string) error
+	// Deletes the password; deleting a password that doesn't exist is not an error
+	Delete(uuid string) error
+}
+
+// Creates the secret store of the type, configured with the options (specific for each type)
+func NewSecretStore(storeType string, options map[string]string) (SecretStore, error) {
+	if options == nil {
+		options = map[string]string{}
+	}
+	switch storeType {
+	case "", SECRET_STORE_KEYRING:
+		return NewKeyringSecretStore(), nil
+	case SECRET_STORE_PASS:
+		return NewPassSecretStore(options), nil
+	case SECRET_STORE_FILE:
+		return NewFileSecretStore(options)
+	case SECRET_STORE_ENV:
+		return NewEnvSecretStore(options), nil
+	case SECRET_STORE_COMMAND:
+		return NewCommandSecretStore(options)
+	default:
+		return nil, errors.New(MyGettextv("Invalid secret store %v; valid values are %v", storeType, SECRET_STORES))
+	}
+}
+
+// Returns if both stores save the passwords in the same place, so migrating from one to the other would
+// delete the passwords just copied
+func IsSameSecretStore(a SecretStore, b SecretStore) bool {
+	if a.GetType() != b.GetType() {
+		return false
+	}
+	// The file is the same even if the keys used to encrypt it are different
+	if fa, ok := a.(*FileSecretStore); ok {
+		if fb, ok := b.(*FileSecretStore); ok {
+			return fa.File == fb.File
+		}
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// Copies the passwords of the uuids from the store from to the store to, deleting them from the
+// original one once all of them can be read from the new one; returns the number of passwords migrated
+func MigrateSecrets(from SecretStore, to SecretStore, uuids []string) (int, error) {
+	migrated, err := CopySecrets(from, to, uuids)
+	if err != nil {
+		return 0, err
+	}
+	DeleteSecrets(from, uuids)
+	return migrated, nil
+}
+
+// Copies the passwords of the uuids from the store from to the store to, and verifies that all of them can
+// be read from the new one; nothing is deleted from the original store. Returns the number of passwords copied
+func CopySecrets(from SecretStore, to SecretStore, uuids []string) (int, error) {
+	if IsSameSecretStore(from, to) {
+		return 0, errors.New(MyGettextv("The passwords are already in secret store %v", to.GetType()))
+	}
+	sort.Strings(uuids)
+	passwords := map[string]string{}
+	for _, uuid := range uuids {
+		password, err := from.Get(uuid)
+		if err != nil {
+			return 0, errors.Wrapf(err, MyGettextv("Error getting password %v from store %v", uuid, from.GetType()))
+		}
+		if password == "" {
+			continue
+		}
+		err = to.Set(uuid, password)
+		if err != nil {
+			return 0, errors.Wrapf(err, MyGettextv("Error setting password %v in store %v", uuid, to.GetType()))
+		}
+		passwords[uuid] = password
+	}
+	for _, uuid := range uuids {
+		if password, ok := passwords[uuid]; ok {
+			newPassword, err := to.Get(uuid)
+			if err != nil {
+				return 0, errors.Wrapf(err, MyGettextv("Error verifying password %v in store %v", uuid, to.GetType()))
+			}
+			if newPassword != password {
+				return 0, errors.New(MyGettextv("Password %v read from store %v doesn't match the migrated one", uuid, to.GetType()))
+			}
+		}
+	}
+	return len(passwords), nil
+}
+
+// Deletes the passwords of the uuids from the store; the errors are only logged, as the passwords are
+// already in other store
+func DeleteSecrets(store SecretStore, uuids []string) {
+	for _, uuid := range uuids {
+		err := store.Delete(uuid)
+		if err != nil {
+			Log.Errorf("Error deleting password %v from store %v: %v", uuid, store.GetType(), err)
+		}
+	}
+}
@@ -0,0 +1,65 @@
+package proxychangerlib
+
+import (
+	"strings"
+
+	"github.com/okelet/goutils"
+	"github.com/pkg/errors"
+)
+
+// Uses external commands (run with bash) to manage the passwords; the UUID is passed in the environment
+// variable PC_SECRET_UUID, and the password is written to the standard input of the set command and
+// read from the standard output of the get command
+type CommandSecretStore struct {
+	GetCommand    string
+	SetCommand    string
+	DeleteCommand string
+}
+
+func NewCommandSecretStore(options map[string]string) (*CommandSecretStore, error) {
+	s := CommandSecretStore{
+		GetCommand:    options["get"],
+		SetCommand:    options["set"],
+		DeleteCommand: options["delete"],
+	}
+	if s.GetCommand == "" {
+		return nil, errors.New(MyGettextv("The command to get the passwords is required"))
+	}
+	return &s, nil
+}
+
+func (s *CommandSecretStore) GetType() string {
+	return SECRET_STORE_COMMAND
+}
+
+func (s *CommandSecretStore) Run(command string, uuid string, stdin string) (string, error) {
+	err, _, exitCode, outBuff, errBuff := goutils.RunCommandAndWait("", strings.NewReader(stdin), "bash", []string{"-c", command}, map[string]string{"PC_SECRET_UUID": uuid})
+	if err != nil {
+		return "", errors.New(MyGettextv("Error running command %v (%v): %v", command, exitCode, goutils.CombineStdErrOutput(outBuff, errBuff)))
+	}
+	return outBuff, nil
+}
+
+func (s *CommandSecretStore) Get(uuid string) (string, error) {
+	out, err := s.Run(s.GetCommand, uuid, "")
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimRight(out, "\r\n"), nil
+}
+
+func (s *CommandSecretStore) Set(uuid string, password string) error {
+	if s.SetCommand == "" {
+		return errors.New(MyGettextv("No command configured to set the passwords"))
+	}
+	_, err := s.Run(s.SetCommand, uuid, password)
+	return err
+}
+
+func (s *CommandSecretStore) Delete(uuid string) error {
+	if s.DeleteCommand == "" {
+		return nil
+	}
+	_, err := s.Run(s.DeleteCommand, uuid, "")
+	return err
+}
@@ -0,0 +1,47 @@
+package proxychangerlib
+
+import (
+	"os"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const DEFAULT_ENV_SECRET_STORE_PREFIX = "PROXYCHANGER_PASSWORD_"
+
+// Reads the passwords from environment variables named prefix + UUID (uppercase, with underscores instead
+// of dashes); the store is read only, passwords must be set outside proxychanger
+type EnvSecretStore struct {
+	Prefix string
+}
+
+func NewEnvSecretStore(options map[string]string) *EnvSecretStore {
+	s := EnvSecretStore{Prefix: options["prefix"]}
+	if s.Prefix == "" {
+		s.Prefix = DEFAULT_ENV_SECRET_STORE_PREFIX
+	}
+	return &s
+}
+
+func (s *EnvSecretStore) GetType() string {
+	return SECRET_STORE_ENV
+}
+
+func (s *EnvSecretStore) GetVariable(uuid string) string {
+	return s.Prefix + strings.ToUpper(strings.Replace(uuid, "-", "_", -1))
+}
+
+func (s *EnvSecretStore) Get(uuid string) (string, error) {
+	return os.Getenv(s.GetVariable(uuid)), nil
+}
+
+func (s *EnvSecretStore) Set(uuid string, password string) error {
+	if os.Getenv(s.GetVariable(uuid)) == password {
+		return nil
+	}
+	return errors.New(MyGettextv("Passwords can't be changed when they are read from the environment; set the variable %v instead", s.GetVariable(uuid)))
+}
+
+func (s *EnvSecretStore) Delete(uuid string) error {
+	return nil
+}
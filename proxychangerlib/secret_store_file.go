@@ -0,0 +1,147 @@
+package proxychangerlib
+
+import (
+	"encoding/json"
+	"os"
+	"os/exec"
+	"path"
+	"strings"
+
+	"github.com/okelet/goutils"
+	"github.com/pkg/errors"
+)
+
+const FILE_SECRET_STORE_AGE = "age"
+const FILE_SECRET_STORE_GPG = "gpg"
+
+// Stores the passwords in a JSON object (UUID to password) encrypted with age or GPG; the file is
+// decrypted every time a password is read, so the identity/key is never stored by proxychanger
+type FileSecretStore struct {
+	// age or gpg
+	Tool string
+	// Encrypted file; defaults to APP_DIR/secrets.age or APP_DIR/secrets.gpg
+	File string
+	// age recipient or GPG key id/email used to encrypt the file
+	Recipient string
+	// age identity file used to decrypt the file (GPG uses its agent)
+	Identity string
+}
+
+func NewFileSecretStore(options map[string]string) (*FileSecretStore, error) {
+	s := FileSecretStore{
+		Tool:      options["tool"],
+		File:      options["file"],
+		Recipient: options["recipient"],
+		Identity:  options["identity"],
+	}
+	if s.Tool == "" {
+		s.Tool = FILE_SECRET_STORE_AGE
+	}
+	if s.Tool != FILE_SECRET_STORE_AGE && s.Tool != FILE_SECRET_STORE_GPG {
+		return nil, errors.New(MyGettextv("Invalid encryption tool %v; valid values are %v and %v", s.Tool, FILE_SECRET_STORE_AGE, FILE_SECRET_STORE_GPG))
+	}
+	if s.Recipient == "" {
+		return nil, errors.New(MyGettextv("The recipient of the encrypted secrets file is required"))
+	}
+	if s.Tool == FILE_SECRET_STORE_AGE && s.Identity == "" {
+		return nil, errors.New(MyGettextv("The age identity file is required"))
+	}
+	if s.File == "" {
+		s.File = path.Join(APP_DIR, "secrets."+s.Tool)
+	}
+	s.File = ExpandHomeDir(s.File)
+	s.Identity = ExpandHomeDir(s.Identity)
+	return &s, nil
+}
+
+func (s *FileSecretStore) GetType() string {
+	return SECRET_STORE_FILE
+}
+
+func (s *FileSecretStore) Run(stdin string, args ...string) (string, error) {
+	_, err := exec.LookPath(s.Tool)
+	if err != nil {
+		return "", errors.New(MyGettextv("Command %v not found", s.Tool))
+	}
+	err, _, exitCode, outBuff, errBuff := goutils.RunCommandAndWait("", strings.NewReader(stdin), s.Tool, args, map[string]string{})
+	if err != nil {
+		return "", errors.New(MyGettextv("Error running command %v (%v): %v", s.Tool, exitCode, goutils.CombineStdErrOutput(outBuff, errBuff)))
+	}
+	return outBuff, nil
+}
+
+// Decrypts the file; returns an empty map if it doesn't exist
+func (s *FileSecretStore) Load() (map[string]string, error) {
+	secrets := map[string]string{}
+	exists, err := goutils.FileExists(s.File)
+	if err != nil {
+		return nil, errors.Wrapf(err, MyGettextv("Error checking if file %v exists", s.File))
+	}
+	if !exists {
+		return secrets, nil
+	}
+	var out string
+	if s.Tool == FILE_SECRET_STORE_AGE {
+		out, err = s.Run("", "--decrypt", "--identity", s.Identity, s.File)
+	} else {
+		out, err = s.Run("", "--quiet", "--batch", "--decrypt", s.File)
+	}
+	if err != nil {
+		return nil, err
+	}
+	err = json.Unmarshal([]byte(out), &secrets)
+	if err != nil {
+		return nil, errors.Wrapf(err, MyGettextv("Error parsing decrypted file %v", s.File))
+	}
+	return secrets, nil
+}
+
+// Encrypts the secrets to a temporary file, that replaces the current one atomically
+func (s *FileSecretStore) Save(secrets map[string]string) error {
+	data, err := json.Marshal(secrets)
+	if err != nil {
+		return errors.Wrap(err, "Error marshaling secrets")
+	}
+	return SafeSaveFile(s.File, CREDENTIALS_FILE_MODE, true, func(tmpFile string) error {
+		// The tools create the output file themselves
+		err := os.Remove(tmpFile)
+		if err != nil {
+			return err
+		}
+		if s.Tool == FILE_SECRET_STORE_AGE {
+			_, err = s.Run(string(data), "--encrypt", "--recipient", s.Recipient, "--output", tmpFile)
+		} else {
+			_, err = s.Run(string(data), "--quiet", "--batch", "--yes", "--encrypt", "--recipient", s.Recipient, "--output", tmpFile)
+		}
+		return err
+	})
+}
+
+func (s *FileSecretStore) Get(uuid string) (string, error) {
+	secrets, err := s.Load()
+	if err != nil {
+		return "", err
+	}
+	return secrets[uuid], nil
+}
+
+func (s *FileSecretStore) Set(uuid string, password string) error {
+	secrets, err := s.Load()
+	if err != nil {
+		return err
+	}
+	secrets[uuid] = password
+	return s.Save(secrets)
+}
+
+func (s *FileSecretStore) Delete(uuid string) error {
+	secrets, err := s.Load()
+	if err != nil {
+		return err
+	}
+	if _, ok := secrets[uuid]; !ok {
+		return nil
+	}
+	delete(secrets, uuid)
+	return s.Save(secrets)
+}
@@ -0,0 +1,39 @@
+package proxychangerlib
+
+import (
+	"github.com/zalando/go-keyring"
+)
+
+// Stores the passwords in the Secret Service keyring (Gnome Keyring, KWallet...)
+type KeyringSecretStore struct {
+}
+
+func NewKeyringSecretStore() *KeyringSecretStore {
+	return &KeyringSecretStore{}
+}
+
+func (s *KeyringSecretStore) GetType() string {
+	return SECRET_STORE_KEYRING
+}
+
+func (s *KeyringSecretStore) Get(uuid string) (string, error) {
+	password, err := keyring.Get(APP_ID, uuid)
+	if err != nil && err != keyring.ErrNotFound {
+		return "", err
+	} else {
+		return password, nil
+	}
+}
+
+func (s *KeyringSecretStore) Set(uuid string, password string) error {
+	return keyring.Set(APP_ID, uuid, password)
+}
+
+func (s *KeyringSecretStore) Delete(uuid string) error {
+	err := keyring.Delete(APP_ID, uuid)
+	if err != nil && err != keyring.ErrNotFound {
+		return err
+	} else {
+		return nil
+	}
+}
@@ -0,0 +1,70 @@
+package proxychangerlib
+
+import (
+	"fmt"
+	"os/exec"
+	"strings"
+
+	"github.com/okelet/goutils"
+	"github.com/pkg/errors"
+)
+
+const DEFAULT_PASS_SECRET_STORE_PREFIX = "proxychanger"
+
+// Stores the passwords using pass (https://www.passwordstore.org), in the entries prefix/uuid
+type PassSecretStore struct {
+	Prefix string
+}
+
+func NewPassSecretStore(options map[string]string) *PassSecretStore {
+	s := PassSecretStore{Prefix: options["prefix"]}
+	if s.Prefix == "" {
+		s.Prefix = DEFAULT_PASS_SECRET_STORE_PREFIX
+	}
+	return &s
+}
+
+func (s *PassSecretStore) GetType() string {
+	return SECRET_STORE_PASS
+}
+
+func (s *PassSecretStore) GetEntry(uuid string) string {
+	return fmt.Sprintf("%v/%v", s.Prefix, uuid)
+}
+
+func (s *PassSecretStore) Run(stdin string, args ...string) (string, error) {
+	_, err := exec.LookPath("pass")
+	if err != nil {
+		return "", errors.New(MyGettextv("Command %v not found", "pass"))
+	}
+	err, _, exitCode, outBuff, errBuff := goutils.RunCommandAndWait("", strings.NewReader(stdin), "pass", args, map[string]string{})
+	if err != nil {
+		return "", errors.New(MyGettextv("Error running command %v (%v): %v", "pass "+args[0], exitCode, goutils.CombineStdErrOutput(outBuff, errBuff)))
+	}
+	return outBuff, nil
+}
+
+func (s *PassSecretStore) Get(uuid string) (string, error) {
+	out, err := s.Run("", "show", s.GetEntry(uuid))
+	if err != nil {
+		if strings.Contains(err.Error(), "is not in the password store") {
+			return "", nil
+		}
+		return "", err
+	}
+	// The password is the first line of the entry
+	return strings.SplitN(out, "\n", 2)[0], nil
+}
+
+func (s *PassSecretStore) Set(uuid string, password string) error {
+	_, err := s.Run(password+"\n", "insert", "--multiline", "--force", s.GetEntry(uuid))
+	return err
+}
+
+func (s *PassSecretStore) Delete(uuid string) error {
+	_, err := s.Run("", "rm", "--force", s.GetEntry(uuid))
+	if err != nil && strings.Contains(err.Error(), "is not in the password store") {
+		return nil
+	}
+	return err
+}
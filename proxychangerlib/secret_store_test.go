@@ -0,0 +1,144 @@
+package proxychangerlib
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+)
+
+// Store that loses the passwords saved in it
+type forgetfulSecretStore struct {
+	*memorySecretStore
+}
+
+func (s *forgetfulSecretStore) Get(uuid string) (string, error) {
+	return "", nil
+}
+
+func TestIsSameSecretStore(t *testing.T) {
+	if !IsSameSecretStore(NewEnvSecretStore(map[string]string{}), NewEnvSecretStore(map[string]string{"prefix": DEFAULT_ENV_SECRET_STORE_PREFIX})) {
+		t.Error("env stores with the default prefix are not the same")
+	}
+	if IsSameSecretStore(NewPassSecretStore(map[string]string{"prefix": "a/"}), NewPassSecretStore(map[string]string{"prefix": "b/"})) {
+		t.Error("pass stores with different prefixes are the same")
+	}
+	a, err := NewFileSecretStore(map[string]string{"tool": FILE_SECRET_STORE_GPG, "file": "/tmp/secrets", "recipient": "a"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := NewFileSecretStore(map[string]string{"tool": FILE_SECRET_STORE_GPG, "file": "/tmp/secrets", "recipient": "b"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !IsSameSecretStore(a, b) {
+		t.Error("file stores with the same file are not the same")
+	}
+}
+
+func TestMigrateSecretsToSameStore(t *testing.T) {
+	from := newMemorySecretStore()
+	from.Set("uuid", "password")
+	_, err := MigrateSecrets(from, from, []string{"uuid"})
+	if err == nil {
+		t.Fatal("expected an error migrating to the same store")
+	}
+	if password, _ := from.Get("uuid"); password != "password" {
+		t.Errorf("password changed to %q", password)
+	}
+}
+
+func TestMigrateSecretsKeepsPasswordsWhenNotVerified(t *testing.T) {
+	from := newMemorySecretStore()
+	from.Set("uuid1", "password1")
+	from.Set("uuid2", "password2")
+	to := &forgetfulSecretStore{newMemorySecretStore()}
+	_, err := MigrateSecrets(from, to, []string{"uuid1", "uuid2"})
+	if err == nil {
+		t.Fatal("expected an error verifying the passwords")
+	}
+	for uuid, expected := range map[string]string{"uuid1": "password1", "uuid2": "password2"} {
+		if password, _ := from.Get(uuid); password != expected {
+			t.Errorf("password %v changed to %q", uuid, password)
+		}
+	}
+}
+
+func TestMigrateSecrets(t *testing.T) {
+	from := newMemorySecretStore()
+	from.Set("uuid1", "password1")
+	to := newMemorySecretStore()
+	migrated, err := MigrateSecrets(from, to, []string{"uuid1", "uuid2"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if migrated != 1 {
+		t.Errorf("%v passwords migrated, expected 1", migrated)
+	}
+	if password, _ := to.Get("uuid1"); password != "password1" {
+		t.Errorf("migrated password is %q", password)
+	}
+	if password, _ := from.Get("uuid1"); password != "" {
+		t.Errorf("password not deleted from the original store")
+	}
+}
+
+// Returns the options of a command secret store that keeps the passwords in files of the directory
+func commandSecretStoreOptions(dir string) map[string]string {
+	return map[string]string{
+		"get":    fmt.Sprintf(`cat "%v/$PC_SECRET_UUID" 2>/dev/null || true`, dir),
+		"set":    fmt.Sprintf(`cat > "%v/$PC_SECRET_UUID"`, dir),
+		"delete": fmt.Sprintf(`rm -f "%v/$PC_SECRET_UUID"`, dir),
+	}
+}
+
+func TestMigrateSecretStore(t *testing.T) {
+	c := newTestConfiguration(t)
+	p := newTestProxy(c, "Proxy")
+	c.Proxies = append(c.Proxies, p)
+	c.SetPassword(p.UUID, "password")
+	oldStore := c.SecretStore
+
+	migrated, err := c.MigrateSecretStore(SECRET_STORE_COMMAND, commandSecretStoreOptions(tempDir(t)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if migrated != 1 || c.SecretStoreType != SECRET_STORE_COMMAND {
+		t.Errorf("%v passwords migrated to store %v", migrated, c.SecretStoreType)
+	}
+	if password, _ := c.GetPassword(p.UUID); password != "password" {
+		t.Errorf("migrated password is %q", password)
+	}
+	if password, _ := oldStore.Get(p.UUID); password != "" {
+		t.Errorf("password not deleted from the original store")
+	}
+}
+
+func TestMigrateSecretStoreKeepsPasswordsWhenNotSaved(t *testing.T) {
+	c := newTestConfiguration(t)
+	p := newTestProxy(c, "Proxy")
+	c.Proxies = append(c.Proxies, p)
+	c.SetPassword(p.UUID, "password")
+	oldStore := c.SecretStore
+	// The configuration can't be saved in a missing directory
+	c.Filename = filepath.Join(tempDir(t), "missing", "proxies.json")
+
+	newDir := tempDir(t)
+	_, err := c.MigrateSecretStore(SECRET_STORE_COMMAND, commandSecretStoreOptions(newDir))
+	if err == nil {
+		t.Fatal("expected an error saving the configuration")
+	}
+	if c.SecretStore != oldStore || c.SecretStoreType != SECRET_STORE_ENV {
+		t.Errorf("secret store changed to %v", c.SecretStoreType)
+	}
+	if password, _ := oldStore.Get(p.UUID); password != "password" {
+		t.Errorf("password in the original store changed to %q", password)
+	}
+	files, err := ioutil.ReadDir(newDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) > 0 {
+		t.Errorf("%v passwords left in the new store", len(files))
+	}
+}
@@ -0,0 +1,287 @@
+package proxychangerlib
+
+import (
+	"strings"
+
+	"github.com/godbus/dbus"
+	"github.com/juju/loggo"
+	"github.com/okelet/goutils"
+	"github.com/okelet/goutils/updatechecker"
+	"github.com/pkg/errors"
+)
+
+// Receives the notifications of the service, to show them to the user
+type ServiceNotifier interface {
+	OnServiceNotification(title string, text string)
+}
+
+// Logic shared by the indicator and the daemon, independent of the GUI: the initial proxy import, the automatic
+// change of the proxy according to the IPs and the update checks. The configuration must be already locked, so
+// it is exported in D-Bus.
+type Service struct {
+	Config             *Configuration
+	CurrentVersion     string
+	TestMode           bool
+	NewVersionDetected string
+	CmdLogLevelSet     bool
+	FirstRun           bool
+	// If set, the proxies are not imported the first time, but discovered, so the user can choose which ones to add
+	FirstRunWizard    bool
+	DiscoveredProxies []*DiscoveredProxy
+
+	CheckUpdatesThread *updatechecker.CheckUpdatesThread
+	CheckIpsThread     *CheckIpsThread
+
+	SessionBus *dbus.Conn
+	Notifier   ServiceNotifier
+}
+
+func NewService(sessionBus *dbus.Conn, config *Configuration, currentVersion string, cmdLogLevelSet bool, testMode bool) *Service {
+
+	s := Service{}
+
+	s.Config = config
+	s.CurrentVersion = currentVersion
+	s.TestMode = testMode
+	s.CmdLogLevelSet = cmdLogLevelSet
+	s.SessionBus = sessionBus
+
+	var timeBetweenIpChecks, timeBetweenUpdateChecks int
+	s.Config.Update(func() {
+		s.Config.ServeAuthProxies = true
+		timeBetweenIpChecks = s.Config.TimeBetweenIpChecks
+		timeBetweenUpdateChecks = s.Config.TimeBetweenUpdateChecks
+	})
+
+	s.CheckIpsThread = NewCheckIpsThread(timeBetweenIpChecks, s.Config)
+
+	if s.TestMode {
+		s.CheckUpdatesThread = updatechecker.NewCheckUpdatesThread(30, "okelet", "proxychanger", "master", true)
+	} else {
+		s.CheckUpdatesThread = updatechecker.NewCheckUpdatesThread(timeBetweenUpdateChecks, "okelet", "proxychanger", s.CurrentVersion, true)
+	}
+
+	return &s
+
+}
+
+func (s *Service) Start(setProxyNow bool) error {
+
+	var err error
+
+	s.Config.AddListener(s)
+
+	var enableAutoChangeByIp, enableUpdateCheck bool
+	s.Config.Update(func() {
+		s.importProxy(setProxyNow)
+		enableAutoChangeByIp = s.Config.EnableAutoChangeByIp
+		enableUpdateCheck = s.Config.EnableUpdateCheck
+	})
+
+	err = s.Config.StartWatching()
+	if err != nil {
+		Log.Errorf("Error watching configuration file: %v", err)
+	}
+
+	// Don not wait to cron to check the ips, check them now
+	s.CheckIpsThread.AddListener(s)
+	if enableAutoChangeByIp {
+		s.CheckIpsThread.Check()
+		err = s.CheckIpsThread.Start()
+		if err != nil {
+			return errors.Wrap(err, MyGettextv("Error starting IP check thread"))
+		}
+	}
+
+	s.CheckUpdatesThread.AddListener(s)
+	if enableUpdateCheck {
+		if s.TestMode {
+			s.CheckUpdatesThread.Check()
+		}
+		err = s.CheckUpdatesThread.Start()
+		if err != nil {
+			return errors.Wrap(err, MyGettextv("Error starting update check thread"))
+		}
+	}
+
+	return nil
+
+}
+
+// Imports the proxy of the desktop or the environment the first time (or discovers the proxies of all the
+// applications, in wizard mode), and sets the active proxy
+func (s *Service) importProxy(setProxyNow bool) {
+
+	// FIXME: try to import both, and detect if they are different
+	var p *Proxy
+	s.FirstRun = !s.Config.IndicatorAlreadyRun
+	if s.FirstRun && s.FirstRunWizard {
+		s.DiscoveredProxies = []*DiscoveredProxy{}
+		for _, d := range s.Config.GetDiscoveredProxies() {
+			if d.Existing == "" {
+				s.DiscoveredProxies = append(s.DiscoveredProxies, d)
+			}
+		}
+		Log.Infof("Discovered %v proxies", len(s.DiscoveredProxies))
+		s.Config.IndicatorAlreadyRun = true
+		s.Config.Save(MyGettextv("Initial indicator configuration"))
+	} else if s.FirstRun {
+		gnomeProxy, err := goutils.GetGnomeProxy(s.Config)
+		if err != nil {
+			Log.Errorf("Error loading gnome proxy: %v", err)
+		} else if gnomeProxy != nil {
+			Log.Infof("Importing gnome proxy %v", gnomeProxy.ToSimpleUrl())
+			p = NewImportedProxy(s.Config, gnomeProxy, MyGettextv("Gnome imported"), "")
+			s.Config.AddProxy(false, p)
+		} else {
+			envProxy, err := goutils.GetEnvironmentProxy(s.Config)
+			if err != nil {
+				Log.Errorf("Error loading environment proxy: %v", err)
+			} else if envProxy != nil {
+				Log.Infof("Importing environment proxy %v", envProxy.ToSimpleUrl())
+				p = NewImportedProxy(s.Config, envProxy, MyGettextv("Environment imported"), "")
+				s.Config.AddProxy(false, p)
+			}
+		}
+		s.Config.IndicatorAlreadyRun = true
+		s.Config.Save(MyGettextv("Initial indicator configuration and proxy import"))
+	}
+
+	if setProxyNow {
+		// If just imported
+		if p != nil {
+			s.Config.SetActiveProxy(p, MyGettextv("Startup"), true)
+		} else {
+			s.Config.SetActiveProxy(s.Config.ActiveProxy, MyGettextv("Startup"), false)
+		}
+	}
+
+}
+
+func (s *Service) Stop() {
+	s.Config.StopWatching()
+	s.CheckIpsThread.Stop()
+	s.CheckUpdatesThread.Stop()
+	s.Config.Update(s.Config.StopAuthProxies)
+}
+
+// Logs the notification, and sends it to the notifier, if any
+func (s *Service) ShowNotification(title string, text string) {
+	Log.Infof("%v: %v", title, strings.Replace(text, "\n\n\n", " ", -1))
+	if s.Notifier != nil {
+		s.Notifier.OnServiceNotification(title, text)
+	}
+}
+
+func (s *Service) OnConfigLoaded() {
+}
+
+func (s *Service) OnConfigConflict() {
+	s.ShowNotification(MyGettextv("Configuration changed"), MyGettextv("The configuration file %v has been changed by other program, but there are changes not saved; the changes will not be saved until the conflict is solved.", s.Config.Filename))
+}
+
+func (s *Service) OnProxyActivated(n *GlobalProxyChangeResult) {
+	if n.Proxy != nil {
+		if n.Reason != "" && n.GetNumberOfErrors() > 0 {
+			s.ShowNotification(MyGettextv("Proxy activated"), MyGettextv("Proxy %v has been activated (%v).\n\n\n%v errors occurred.", n.Proxy.Name, goutils.EnsureFirstSentenceLetterLowercase(n.Reason), n.GetNumberOfErrors()))
+		} else if n.Reason != "" {
+			s.ShowNotification(MyGettextv("Proxy activated"), MyGettextv("Proxy %v has been activated (%v).", n.Proxy.Name, goutils.EnsureFirstSentenceLetterLowercase(n.Reason)))
+		} else if n.GetNumberOfErrors() > 0 {
+			s.ShowNotification(MyGettextv("Proxy activated"), MyGettextv("Proxy %v has been activated.\n\n\n%v errors occurred.", n.Proxy.Name, n.GetNumberOfErrors()))
+		} else {
+			s.ShowNotification(MyGettextv("Proxy activated"), MyGettextv("Proxy %v has been activated.", n.Proxy.Name))
+		}
+	} else {
+		if n.Reason != "" && n.GetNumberOfErrors() > 0 {
+			s.ShowNotification(MyGettextv("Proxy deactivated"), MyGettextv("Proxy has been deactivated (%v).\n\n\n%v errors occurred.", goutils.EnsureFirstSentenceLetterLowercase(n.Reason), n.GetNumberOfErrors()))
+		} else if n.Reason != "" {
+			s.ShowNotification(MyGettextv("Proxy deactivated"), MyGettextv("Proxy has been deactivated (%v).", goutils.EnsureFirstSentenceLetterLowercase(n.Reason)))
+		} else if n.GetNumberOfErrors() > 0 {
+			s.ShowNotification(MyGettextv("Proxy deactivated"), MyGettextv("Proxy has been deactivated.\n\n\n%v errors occurred.", n.GetNumberOfErrors()))
+		} else {
+			s.ShowNotification(MyGettextv("Proxy deactivated"), MyGettextv("Proxy has been deactivated."))
+		}
+	}
+}
+
+func (s *Service) OnApplicationsApplied(results []*AppProxyChangeResult) {
+	errorsCount := 0
+	for _, r := range results {
+		if r.SkippedMessage == "" && r.ErrorMessage != "" {
+			errorsCount += 1
+		}
+	}
+	if errorsCount > 0 {
+		s.ShowNotification(MyGettextv("Proxy applied"), MyGettextv("Proxy has been applied to %v applications.\n\n\n%v errors occurred.", len(results), errorsCount))
+	} else {
+		s.ShowNotification(MyGettextv("Proxy applied"), MyGettextv("Proxy has been applied to %v applications.", len(results)))
+	}
+}
+
+func (s *Service) OnProxyAdded(p *Proxy) {
+}
+
+func (s *Service) OnProxyUpdated(p *Proxy) {
+}
+
+func (s *Service) OnProxyRemoved(p *Proxy) {
+}
+
+func (s *Service) OnShowProxyNameNextToIndicatorChanged(newValue bool) {
+}
+
+func (s *Service) OnEnableAutoChangeByIpChanged(newValue bool) {
+	if newValue {
+		Log.Debugf("Starting IP check thread...")
+		err := s.CheckIpsThread.Start()
+		if err != nil {
+			s.ShowNotification(
+				MyGettextv("Error"),
+				MyGettextv("Error starting IP check thread: %v", err),
+			)
+		}
+	} else {
+		Log.Debugf("Stopping IP check thread...")
+		s.CheckIpsThread.Stop()
+	}
+}
+
+func (s *Service) OnEnableUpdateCheckChanged(newValue bool) {
+	if newValue {
+		Log.Debugf("Starting update check thread...")
+		err := s.CheckUpdatesThread.Start()
+		if err != nil {
+			s.ShowNotification(
+				MyGettextv("Error"),
+				MyGettextv("Error staring update check thread: %v", err),
+			)
+		}
+	} else {
+		Log.Debugf("Stopping update check thread...")
+		s.CheckUpdatesThread.Stop()
+	}
+}
+
+func (s *Service) OnWhatToDoWhenNoIpMatchesChanged(newValue string) {
+}
+
+func (s *Service) OnLogLevelChanged(newValue loggo.Level) {
+	if !s.CmdLogLevelSet {
+		Log.SetLogLevel(newValue)
+	}
+}
+
+func (s *Service) OnIpsChanged(ips []string) {
+	Log.Tracef("New IPs notification received: %v", ips)
+	s.Config.Update(func() {
+		s.Config.SetProxyForIps(ips)
+	})
+}
+
+func (s *Service) OnNewVersionDetecetd(newVersion string) {
+	s.NewVersionDetected = newVersion
+	s.ShowNotification(
+		MyGettextv("New version detected"),
+		MyGettextv("Version %v has been released.", newVersion),
+	)
+}
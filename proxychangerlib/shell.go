@@ -0,0 +1,303 @@
+package proxychangerlib
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"os/user"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/okelet/goutils"
+)
+
+// Syntax of the shells
+const SHELL_SYNTAX_POSIX = "posix"
+const SHELL_SYNTAX_FISH = "fish"
+
+// Shells supported by the env and hook commands
+var SHELLS = []string{"bash", "zsh", "fish"}
+
+type EnvironmentVariable struct {
+	Name  string
+	Value string
+}
+
+// Returns the environment variables that set the proxy, in lower and upper case; all_proxy is only returned if
+// the proxy has a SOCKS endpoint, and no_proxy if it has exceptions
+func ProxyEnvironmentVariables(p *Proxy) ([]*EnvironmentVariable, error) {
+	urls := map[string]string{}
+	for _, scheme := range PROXY_SCHEMES {
+		url, err := p.GetSchemeUrl(scheme, true)
+		if err != nil {
+			return nil, err
+		}
+		urls[scheme] = url
+	}
+	variables := []*EnvironmentVariable{}
+	for _, upper := range []bool{false, true} {
+		for _, v := range []struct {
+			Name  string
+			Value string
+		}{
+			{"http_proxy", urls[PROXY_SCHEME_HTTP]},
+			{"https_proxy", urls[PROXY_SCHEME_HTTPS]},
+			{"ftp_proxy", urls[PROXY_SCHEME_FTP]},
+		} {
+			name := v.Name
+			if upper {
+				name = strings.ToUpper(name)
+			}
+			variables = append(variables, &EnvironmentVariable{name, v.Value})
+		}
+	}
+	if urls[PROXY_SCHEME_SOCKS] != "" {
+		variables = append(variables, &EnvironmentVariable{"all_proxy", urls[PROXY_SCHEME_SOCKS]})
+		variables = append(variables, &EnvironmentVariable{"ALL_PROXY", urls[PROXY_SCHEME_SOCKS]})
+	}
+	if len(p.Exceptions) > 0 {
+		variables = append(variables, &EnvironmentVariable{"no_proxy", strings.Join(p.Exceptions, ",")})
+		variables = append(variables, &EnvironmentVariable{"NO_PROXY", strings.Join(p.Exceptions, ",")})
+	}
+	return variables, nil
+}
+
+// Returns the lines that export the variables in the shell syntax (see SHELL_SYNTAX_*)
+func ShellExportLines(syntax string, variables []*EnvironmentVariable) []string {
+	lines := []string{}
+	for _, v := range variables {
+		if syntax == SHELL_SYNTAX_FISH {
+			lines = append(lines, fmt.Sprintf("set -gx %v %v", v.Name, ShellQuote(syntax, v.Value)))
+		} else {
+			lines = append(lines, fmt.Sprintf("export %v=%v", v.Name, ShellQuote(syntax, v.Value)))
+		}
+	}
+	return lines
+}
+
+// Returns the lines that remove the variables in the shell syntax (see SHELL_SYNTAX_*)
+func ShellUnsetLines(syntax string, names []string) []string {
+	lines := []string{}
+	if syntax == SHELL_SYNTAX_FISH {
+		for _, name := range names {
+			lines = append(lines, fmt.Sprintf("set -e %v", name))
+		}
+	} else if len(names) > 0 {
+		lines = append(lines, fmt.Sprintf("unset %v", strings.Join(names, " ")))
+	}
+	return lines
+}
+
+// Returns the syntax of the shell, that defaults to the login shell if empty
+func GetShellSyntax(shell string) string {
+	if shell == "" {
+		shell = GetLoginShell()
+	}
+	if shell == "fish" {
+		return SHELL_SYNTAX_FISH
+	}
+	return SHELL_SYNTAX_POSIX
+}
+
+// Returns the lines that set the variables and remove the rest of proxy variables, so no variable of the
+// previous proxy is kept
+func ShellEnvironmentLines(syntax string, variables []*EnvironmentVariable) []string {
+	names := []string{}
+	for _, v := range variables {
+		names = append(names, v.Name)
+	}
+	unsetNames := []string{}
+	for _, name := range ProxyEnvironmentVariableNames() {
+		if !goutils.ListContainsString(names, name) {
+			unsetNames = append(unsetNames, name)
+		}
+	}
+	return append(ShellUnsetLines(syntax, unsetNames), ShellExportLines(syntax, variables)...)
+}
+
+// Returns the code that, before each prompt, updates the proxy variables if the active proxy has changed,
+// checking the file ACTIVE_PROXY_STATE_FILE; executable is the path of this application
+func ShellHook(shell string, executable string) string {
+	syntax := GetShellSyntax(shell)
+	stateFile := ShellQuote(syntax, ACTIVE_PROXY_STATE_FILE)
+	command := fmt.Sprintf("%v env --shell %v", ShellQuote(syntax, executable), shell)
+	var lines []string
+	switch shell {
+	case "fish":
+		lines = []string{
+			"function _proxychanger_hook --on-event fish_prompt",
+			"    set -l state \"\"",
+			"    if test -r " + stateFile,
+			"        read -z state < " + stateFile,
+			"    end",
+			"    if test \"$state\" != \"$_proxychanger_state\"",
+			"        set -g _proxychanger_state $state",
+			"        " + command + " | source",
+			"    end",
+			"end",
+		}
+	default:
+		lines = []string{
+			"_proxychanger_hook() {",
+			"    local state=\"\"",
+			"    [ -r " + stateFile + " ] && state=\"$(< " + stateFile + ")\"",
+			"    if [ \"$state\" != \"${_PROXYCHANGER_STATE-}\" ]; then",
+			"        _PROXYCHANGER_STATE=\"$state\"",
+			"        eval \"$(" + command + ")\"",
+			"    fi",
+			"}",
+		}
+		if shell == "zsh" {
+			lines = append(lines,
+				"autoload -Uz add-zsh-hook",
+				"add-zsh-hook precmd _proxychanger_hook",
+			)
+		} else {
+			lines = append(lines,
+				"case \";${PROMPT_COMMAND-};\" in",
+				"    *\";_proxychanger_hook;\"*) ;;",
+				"    *) PROMPT_COMMAND=\"_proxychanger_hook${PROMPT_COMMAND:+;$PROMPT_COMMAND}\" ;;",
+				"esac",
+			)
+		}
+	}
+	return strings.Join(lines, "\n") + "\n"
+}
+
+// Quotes the value using single quotes, so no character is interpreted by the shell
+func ShellQuote(syntax string, value string) string {
+	if syntax == SHELL_SYNTAX_FISH {
+		// Inside single quotes, fish only interprets the backslash and the single quote
+		return "'" + strings.NewReplacer("\\", "\\\\", "'", "\\'").Replace(value) + "'"
+	}
+	return "'" + strings.Replace(value, "'", "'\\''", -1) + "'"
+}
+
+// Names of the environment variables that set the proxy
+func ProxyEnvironmentVariableNames() []string {
+	names := []string{}
+	for _, name := range []string{"http_proxy", "https_proxy", "ftp_proxy", "all_proxy", "no_proxy"} {
+		names = append(names, name, strings.ToUpper(name))
+	}
+	return names
+}
+
+// Returns the login shell of the user (the base name, like bash or zsh), from the passwd database; if not
+// found, the SHELL environment variable is used
+func GetLoginShell() string {
+	var entry string
+	u, err := user.Current()
+	if err == nil {
+		err, _, _, outBuff, _ := goutils.RunCommandAndWait("", nil, "getent", []string{"passwd", u.Username}, map[string]string{})
+		if err == nil {
+			entry = strings.TrimSpace(outBuff)
+		} else {
+			data, err := ioutil.ReadFile("/etc/passwd")
+			if err == nil {
+				for _, line := range strings.Split(string(data), "\n") {
+					if strings.HasPrefix(line, u.Username+":") {
+						entry = line
+						break
+					}
+				}
+			}
+		}
+	}
+	fields := strings.Split(entry, ":")
+	if len(fields) == 7 && fields[6] != "" {
+		return path.Base(fields[6])
+	}
+	if os.Getenv("SHELL") != "" {
+		return path.Base(os.Getenv("SHELL"))
+	}
+	return ""
+}
+
+// Returns the environment (in the format of os.Environ) to run a command using the proxy variables: the proxy
+// variables of environ are replaced, and the proxy is also set for Java (JAVA_TOOL_OPTIONS) and Git
+// (GIT_CONFIG_*); with no variables, the command runs without proxy
+func CommandEnvironment(variables []*EnvironmentVariable, environ []string) []string {
+
+	values := map[string]string{}
+	for _, v := range variables {
+		values[v.Name] = v.Value
+	}
+
+	env := []string{}
+	javaToolOptions := ""
+	gitConfigCount := 0
+	for _, e := range environ {
+		parts := strings.SplitN(e, "=", 2)
+		if goutils.ListContainsString(ProxyEnvironmentVariableNames(), parts[0]) {
+			continue
+		}
+		if len(parts) == 2 {
+			if parts[0] == "JAVA_TOOL_OPTIONS" {
+				javaToolOptions = parts[1]
+				continue
+			}
+			if parts[0] == "GIT_CONFIG_COUNT" {
+				gitConfigCount, _ = strconv.Atoi(parts[1])
+				continue
+			}
+		}
+		env = append(env, e)
+	}
+	for _, v := range variables {
+		env = append(env, v.Name+"="+v.Value)
+	}
+
+	javaOptions := JavaProxyOptions(values)
+	if javaToolOptions != "" {
+		javaOptions = append([]string{javaToolOptions}, javaOptions...)
+	}
+	if len(javaOptions) > 0 {
+		env = append(env, "JAVA_TOOL_OPTIONS="+strings.Join(javaOptions, " "))
+	}
+
+	// An empty http.proxy disables the proxy configured in the Git settings
+	env = append(env,
+		fmt.Sprintf("GIT_CONFIG_KEY_%v=http.proxy", gitConfigCount),
+		fmt.Sprintf("GIT_CONFIG_VALUE_%v=%v", gitConfigCount, values["http_proxy"]),
+		fmt.Sprintf("GIT_CONFIG_COUNT=%v", gitConfigCount+1),
+	)
+
+	return env
+
+}
+
+// Returns the Java system properties that set the proxy; Java doesn't use the credentials of the URLs
+func JavaProxyOptions(values map[string]string) []string {
+	options := []string{}
+	for _, v := range []struct {
+		Variable string
+		Prefix   string
+	}{{"http_proxy", "http.proxy"}, {"https_proxy", "https.proxy"}, {"ftp_proxy", "ftp.proxy"}, {"all_proxy", "socksProxy"}} {
+		if values[v.Variable] == "" {
+			continue
+		}
+		u, err := url.Parse(values[v.Variable])
+		if err != nil || u.Hostname() == "" {
+			continue
+		}
+		options = append(options, fmt.Sprintf("-D%vHost=%v", v.Prefix, u.Hostname()))
+		if u.Port() != "" {
+			options = append(options, fmt.Sprintf("-D%vPort=%v", v.Prefix, u.Port()))
+		}
+	}
+	if values["no_proxy"] != "" {
+		hosts := []string{}
+		for _, h := range strings.Split(values["no_proxy"], ",") {
+			// Java uses wildcards instead of domain suffixes
+			if strings.HasPrefix(h, ".") {
+				h = "*" + h
+			}
+			hosts = append(hosts, h)
+		}
+		options = append(options, fmt.Sprintf("-Dhttp.nonProxyHosts=%v", strings.Join(hosts, "|")))
+		options = append(options, fmt.Sprintf("-Dftp.nonProxyHosts=%v", strings.Join(hosts, "|")))
+	}
+	return options
+}
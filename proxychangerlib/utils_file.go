@@ -0,0 +1,179 @@
+package proxychangerlib
+
+import (
+	"bufio"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+
+	"github.com/pkg/errors"
+)
+
+// Permissions of the new files holding credentials
+const CREDENTIALS_FILE_MODE = 0600
+
+const MAX_SYMLINKS = 40
+
+// Writes the data to a file atomically; see SafeSaveFile.
+func SafeWriteFile(filename string, data []byte, perm os.FileMode) error {
+	return SafeSaveFile(filename, perm, false, func(tmpFilename string) error {
+		return ioutil.WriteFile(tmpFilename, data, perm)
+	})
+}
+
+// Writes the data to a file holding credentials atomically; see SafeSaveFile.
+func SafeWriteCredentialsFile(filename string, data []byte) error {
+	return SafeSaveFile(filename, CREDENTIALS_FILE_MODE, true, func(tmpFilename string) error {
+		return ioutil.WriteFile(tmpFilename, data, CREDENTIALS_FILE_MODE)
+	})
+}
+
+// Replaces a file atomically: save writes the new content to a temporary file in the same directory, that is
+// synced to disk and renamed over the original one, so a crash or a full disk never leaves the file truncated.
+// Symbolic links are followed, so the target is replaced and the link kept. Existing files keep their mode and
+// owner; new files are created with perm (minus the umask). If credentials is true, the mode is never looser
+// than perm nor than the mode of the existing file.
+// When the temporary file can not be created (for example, a writable file in a directory owned by root) or
+// the owner can not be kept, the file is overwritten in place.
+func SafeSaveFile(filename string, perm os.FileMode, credentials bool, save func(tmpFilename string) error) error {
+
+	target, err := ResolveSymlinks(filename)
+	if err != nil {
+		return err
+	}
+
+	info, err := os.Stat(target)
+	if err != nil && !os.IsNotExist(err) {
+		return errors.Wrapf(err, MyGettextv("Error checking if file %v exists", target))
+	}
+	exists := err == nil
+
+	mode := perm &^ GetUmask()
+	if exists {
+		mode = info.Mode().Perm()
+		if credentials {
+			mode = mode & perm
+		}
+	}
+
+	tmpFile, err := ioutil.TempFile(filepath.Dir(target), "."+filepath.Base(target)+".tmp")
+	if err != nil {
+		if exists && os.IsPermission(err) {
+			Log.Warningf("Unable to create a temporary file to replace %v (%v); overwriting it in place", target, err)
+			return SaveFileInPlace(target, mode, save)
+		}
+		return errors.Wrapf(err, MyGettextv("Error creating temporary file for %v", target))
+	}
+	tmpFilename := tmpFile.Name()
+	tmpFile.Close()
+
+	if exists {
+		if stat, ok := info.Sys().(*syscall.Stat_t); ok && (int(stat.Uid) != os.Getuid() || int(stat.Gid) != os.Getgid()) {
+			err = os.Chown(tmpFilename, int(stat.Uid), int(stat.Gid))
+			if err != nil {
+				os.Remove(tmpFilename)
+				Log.Warningf("Unable to keep the owner of %v (%v); overwriting it in place", target, err)
+				return SaveFileInPlace(target, mode, save)
+			}
+		}
+	}
+
+	err = save(tmpFilename)
+	if err == nil {
+		err = os.Chmod(tmpFilename, mode)
+	}
+	if err == nil {
+		err = SyncFile(tmpFilename)
+	}
+	if err == nil {
+		err = os.Rename(tmpFilename, target)
+	}
+	if err != nil {
+		os.Remove(tmpFilename)
+		return err
+	}
+
+	err = SyncFile(filepath.Dir(target))
+	if err != nil {
+		Log.Debugf("Error syncing directory %v: %v", filepath.Dir(target), err)
+	}
+
+	return nil
+
+}
+
+// Saves the file directly, keeping its owner; the mode is only tightened
+func SaveFileInPlace(filename string, mode os.FileMode, save func(filename string) error) error {
+	info, err := os.Stat(filename)
+	if err != nil {
+		return errors.Wrapf(err, MyGettextv("Error checking if file %v exists", filename))
+	}
+	if info.Mode().Perm()&^mode != 0 {
+		err = os.Chmod(filename, mode)
+		if err != nil {
+			return errors.Wrapf(err, MyGettextv("Error changing permissions of file %v", filename))
+		}
+	}
+	err = save(filename)
+	if err == nil {
+		err = SyncFile(filename)
+	}
+	return err
+}
+
+func SyncFile(filename string) error {
+	f, err := os.Open(filename)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return f.Sync()
+}
+
+// Returns the final target of a file, following the symbolic links even if the target doesn't exist yet
+func ResolveSymlinks(filename string) (string, error) {
+	for i := 0; i < MAX_SYMLINKS; i++ {
+		info, err := os.Lstat(filename)
+		if os.IsNotExist(err) {
+			return filename, nil
+		}
+		if err != nil {
+			return "", errors.Wrapf(err, MyGettextv("Error checking if file %v exists", filename))
+		}
+		if info.Mode()&os.ModeSymlink == 0 {
+			return filename, nil
+		}
+		target, err := os.Readlink(filename)
+		if err != nil {
+			return "", errors.Wrapf(err, MyGettextv("Error reading link %v", filename))
+		}
+		if !filepath.IsAbs(target) {
+			target = filepath.Join(filepath.Dir(filename), target)
+		}
+		filename = target
+	}
+	return "", errors.New(MyGettextv("Too many levels of symbolic links in %v", filename))
+}
+
+// Returns the umask of the process, read from /proc to avoid changing it; defaults to 022
+func GetUmask() os.FileMode {
+	f, err := os.Open("/proc/self/status")
+	if err != nil {
+		return 0022
+	}
+	defer f.Close()
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.HasPrefix(line, "Umask:") {
+			umask, err := strconv.ParseUint(strings.TrimSpace(strings.TrimPrefix(line, "Umask:")), 8, 32)
+			if err == nil {
+				return os.FileMode(umask)
+			}
+		}
+	}
+	return 0022
+}
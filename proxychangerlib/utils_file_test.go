@@ -0,0 +1,204 @@
+package proxychangerlib
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+func tempDir(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "proxychanger-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		os.Chmod(dir, 0755)
+		os.RemoveAll(dir)
+	})
+	return dir
+}
+
+func writeFile(t *testing.T, filename string, data string, mode os.FileMode) {
+	err := ioutil.WriteFile(filename, []byte(data), mode)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The mode of WriteFile is filtered by the umask
+	err = os.Chmod(filename, mode)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func readFile(t *testing.T, filename string) string {
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(data)
+}
+
+func fileMode(t *testing.T, filename string) os.FileMode {
+	info, err := os.Stat(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return info.Mode().Perm()
+}
+
+// Returns the temporary files left in the directory
+func tmpFiles(t *testing.T, dir string) []string {
+	entries, err := ioutil.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	files := []string{}
+	for _, e := range entries {
+		if strings.Contains(e.Name(), ".tmp") {
+			files = append(files, e.Name())
+		}
+	}
+	return files
+}
+
+func TestSafeSaveFileFailingSaveKeepsOriginal(t *testing.T) {
+	dir := tempDir(t)
+	filename := filepath.Join(dir, "settings.json")
+	writeFile(t, filename, "original", 0644)
+
+	err := SafeSaveFile(filename, 0644, false, func(tmpFilename string) error {
+		err := ioutil.WriteFile(tmpFilename, []byte("partial"), 0644)
+		if err != nil {
+			return err
+		}
+		return errors.New("disk full")
+	})
+	if err == nil {
+		t.Fatal("expected the error of the save function")
+	}
+	if data := readFile(t, filename); data != "original" {
+		t.Errorf("original file changed to %q", data)
+	}
+	if files := tmpFiles(t, dir); len(files) > 0 {
+		t.Errorf("temporary files left: %v", files)
+	}
+}
+
+func TestSafeWriteFileKeepsSymlink(t *testing.T) {
+	dir := tempDir(t)
+	target := filepath.Join(dir, "dotfiles", "bashrc")
+	err := os.Mkdir(filepath.Dir(target), 0755)
+	if err != nil {
+		t.Fatal(err)
+	}
+	writeFile(t, target, "original", 0644)
+	link := filepath.Join(dir, ".bashrc")
+	err = os.Symlink(filepath.Join("dotfiles", "bashrc"), link)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = SafeWriteFile(link, []byte("changed"), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	info, err := os.Lstat(link)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Mode()&os.ModeSymlink == 0 {
+		t.Errorf("%v is not a symbolic link anymore", link)
+	}
+	if data := readFile(t, target); data != "changed" {
+		t.Errorf("target of the link has %q", data)
+	}
+	if files := tmpFiles(t, filepath.Dir(target)); len(files) > 0 {
+		t.Errorf("temporary files left: %v", files)
+	}
+}
+
+func TestSafeWriteFileKeepsModeAndOwner(t *testing.T) {
+	dir := tempDir(t)
+	filename := filepath.Join(dir, "settings")
+	writeFile(t, filename, "original", 0640)
+	// Changing the owner needs root
+	uid, gid := os.Getuid(), os.Getgid()
+	if os.Geteuid() == 0 {
+		uid, gid = 65534, 65534
+		err := os.Chown(filename, uid, gid)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	err := SafeWriteFile(filename, []byte("changed"), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if data := readFile(t, filename); data != "changed" {
+		t.Errorf("file has %q", data)
+	}
+	if mode := fileMode(t, filename); mode != 0640 {
+		t.Errorf("mode changed to %o", mode)
+	}
+	info, err := os.Stat(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	stat := info.Sys().(*syscall.Stat_t)
+	if int(stat.Uid) != uid || int(stat.Gid) != gid {
+		t.Errorf("owner changed to %v:%v", stat.Uid, stat.Gid)
+	}
+}
+
+func TestSafeWriteCredentialsFileTightensMode(t *testing.T) {
+	dir := tempDir(t)
+	filename := filepath.Join(dir, "settings")
+	writeFile(t, filename, "original", 0644)
+
+	err := SafeWriteCredentialsFile(filename, []byte("password"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mode := fileMode(t, filename); mode != CREDENTIALS_FILE_MODE {
+		t.Errorf("mode is %o", mode)
+	}
+
+	newFilename := filepath.Join(dir, "new")
+	err = SafeWriteCredentialsFile(newFilename, []byte("password"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mode := fileMode(t, newFilename); mode&^CREDENTIALS_FILE_MODE != 0 {
+		t.Errorf("mode of the new file is %o", mode)
+	}
+}
+
+func TestSafeSaveFileInPlaceWhenDirectoryNotWritable(t *testing.T) {
+	if os.Geteuid() == 0 {
+		t.Skip("root can always write in the directory")
+	}
+	dir := tempDir(t)
+	filename := filepath.Join(dir, "settings")
+	writeFile(t, filename, "original", 0644)
+	err := os.Chmod(dir, 0555)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = SafeWriteCredentialsFile(filename, []byte("changed"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if data := readFile(t, filename); data != "changed" {
+		t.Errorf("file has %q", data)
+	}
+	if mode := fileMode(t, filename); mode != CREDENTIALS_FILE_MODE {
+		t.Errorf("mode is %o", mode)
+	}
+}
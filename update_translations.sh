@@ -9,9 +9,9 @@ POT_FILE=${LOCALE_DIR}/${DOMAIN}.pot
 CODE_POT_FILE=${LOCALE_DIR}/proxychangercode.pot
 GLADE_POT_FILE=${LOCALE_DIR}/proxychangerglade.pot
 
-go-xgettext -k MyGettextv -o ${CODE_POT_FILE} main.go proxychangerlib/*.go
+go-xgettext -k MyGettextv -o ${CODE_POT_FILE} main.go proxychangerlib/*.go proxychangergui/*.go
 
-xgettext -d ${DOMAIN} -L glade -o ${GLADE_POT_FILE} proxychangerlib/assets/*.glade
+xgettext -d ${DOMAIN} -L glade -o ${GLADE_POT_FILE} proxychangergui/assets/*.glade
 
 msgcat -o ${POT_FILE} --use-first -t utf-8 ${GLADE_POT_FILE} ${CODE_POT_FILE}
 